@@ -4,9 +4,11 @@ package mocks
 
 import (
 	context "context"
+	iter "iter"
 
-	sql "github.com/gofreego/database/sql"
 	mock "github.com/stretchr/testify/mock"
+
+	sql "github.com/gofreego/database/sql"
 )
 
 // Database is an autogenerated mock type for the Database type
@@ -15,7 +17,7 @@ type Database struct {
 }
 
 // BeginTransaction provides a mock function with given fields: ctx, options
-func (_m *Database) BeginTransaction(ctx context.Context, options ...sql.Options) (sql.Transaction, error) {
+func (_m *Database) BeginTransaction(ctx context.Context, options ...sql.Options) (sql.Tx, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -29,16 +31,16 @@ func (_m *Database) BeginTransaction(ctx context.Context, options ...sql.Options
 		panic("no return value specified for BeginTransaction")
 	}
 
-	var r0 sql.Transaction
+	var r0 sql.Tx
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ...sql.Options) (sql.Transaction, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ...sql.Options) (sql.Tx, error)); ok {
 		return rf(ctx, options...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ...sql.Options) sql.Transaction); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ...sql.Options) sql.Tx); ok {
 		r0 = rf(ctx, options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(sql.Transaction)
+			r0 = ret.Get(0).(sql.Tx)
 		}
 	}
 
@@ -51,6 +53,41 @@ func (_m *Database) BeginTransaction(ctx context.Context, options ...sql.Options
 	return r0, r1
 }
 
+// BulkLoad provides a mock function with given fields: ctx, table, columns, source, options
+func (_m *Database) BulkLoad(ctx context.Context, table *sql.Table, columns []string, source iter.Seq[sql.Record], options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, table, columns, source)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkLoad")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []string, iter.Seq[sql.Record], ...sql.Options) (int64, error)); ok {
+		return rf(ctx, table, columns, source, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []string, iter.Seq[sql.Record], ...sql.Options) int64); ok {
+		r0 = rf(ctx, table, columns, source, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Table, []string, iter.Seq[sql.Record], ...sql.Options) error); ok {
+		r1 = rf(ctx, table, columns, source, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields: ctx
 func (_m *Database) Close(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -139,6 +176,113 @@ func (_m *Database) DeleteByID(ctx context.Context, record sql.Record, options .
 	return r0, r1
 }
 
+// DeleteByIDs provides a mock function with given fields: ctx, table, ids, options
+func (_m *Database) DeleteByIDs(ctx context.Context, table *sql.Table, ids []int64, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, table, ids)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByIDs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []int64, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, table, ids, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []int64, ...sql.Options) int64); ok {
+		r0 = rf(ctx, table, ids, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Table, []int64, ...sql.Options) error); ok {
+		r1 = rf(ctx, table, ids, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteReturning provides a mock function with given fields: ctx, condition, values, records, options
+func (_m *Database) DeleteReturning(ctx context.Context, condition *sql.Condition, values []interface{}, records sql.Records, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, condition, values, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReturning")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Condition, []interface{}, sql.Records, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, condition, values, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Condition, []interface{}, sql.Records, ...sql.Options) int64); ok {
+		r0 = rf(ctx, condition, values, records, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Condition, []interface{}, sql.Records, ...sql.Options) error); ok {
+		r1 = rf(ctx, condition, values, records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Explain provides a mock function with given fields: ctx, filter, values, records, options
+func (_m *Database) Explain(ctx context.Context, filter *sql.Filter, values []interface{}, records sql.Records, options ...sql.Options) (*sql.QueryPlan, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, filter, values, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Explain")
+	}
+
+	var r0 *sql.QueryPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Filter, []interface{}, sql.Records, ...sql.Options) (*sql.QueryPlan, error)); ok {
+		return rf(ctx, filter, values, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Filter, []interface{}, sql.Records, ...sql.Options) *sql.QueryPlan); ok {
+		r0 = rf(ctx, filter, values, records, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.QueryPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Filter, []interface{}, sql.Records, ...sql.Options) error); ok {
+		r1 = rf(ctx, filter, values, records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: ctx, filter, values, record, options
 func (_m *Database) Get(ctx context.Context, filter *sql.Filter, values []interface{}, record sql.Records, options ...sql.Options) error {
 	_va := make([]interface{}, len(options))
@@ -189,6 +333,43 @@ func (_m *Database) GetByID(ctx context.Context, record sql.Record, options ...s
 	return r0
 }
 
+// GetByIDs provides a mock function with given fields: ctx, ids, records, options
+func (_m *Database) GetByIDs(ctx context.Context, ids []int64, records sql.Records, options ...sql.Options) ([]int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ids, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, sql.Records, ...sql.Options) ([]int64, error)); ok {
+		return rf(ctx, ids, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, sql.Records, ...sql.Options) []int64); ok {
+		r0 = rf(ctx, ids, records, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, sql.Records, ...sql.Options) error); ok {
+		r1 = rf(ctx, ids, records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: ctx, record, options
 func (_m *Database) Insert(ctx context.Context, record sql.Record, options ...sql.Options) error {
 	_va := make([]interface{}, len(options))
@@ -267,6 +448,31 @@ func (_m *Database) Ping(ctx context.Context) error {
 	return r0
 }
 
+// RunInTransaction provides a mock function with given fields: ctx, fn, options
+func (_m *Database) RunInTransaction(ctx context.Context, fn func(context.Context, sql.Tx) error, options ...sql.Options) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, fn)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunInTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context, sql.Tx) error, ...sql.Options) error); ok {
+		r0 = rf(ctx, fn, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RunSP provides a mock function with given fields: ctx, spName, values, result, options
 func (_m *Database) RunSP(ctx context.Context, spName string, values []interface{}, result sql.SPResult, options ...sql.Options) error {
 	_va := make([]interface{}, len(options))
@@ -362,6 +568,61 @@ func (_m *Database) SoftDeleteByID(ctx context.Context, record sql.Record, optio
 	return r0, r1
 }
 
+// SoftDeleteByIDs provides a mock function with given fields: ctx, table, ids, options
+func (_m *Database) SoftDeleteByIDs(ctx context.Context, table *sql.Table, ids []int64, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, table, ids)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteByIDs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []int64, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, table, ids, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []int64, ...sql.Options) int64); ok {
+		r0 = rf(ctx, table, ids, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Table, []int64, ...sql.Options) error); ok {
+		r1 = rf(ctx, table, ids, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ToSQL provides a mock function with no fields
+func (_m *Database) ToSQL() sql.Renderer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToSQL")
+	}
+
+	var r0 sql.Renderer
+	if rf, ok := ret.Get(0).(func() sql.Renderer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sql.Renderer)
+		}
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, table, updates, condition, values, options
 func (_m *Database) Update(ctx context.Context, table *sql.Table, updates *sql.Updates, condition *sql.Condition, values []interface{}, options ...sql.Options) (int64, error) {
 	_va := make([]interface{}, len(options))
@@ -432,6 +693,78 @@ func (_m *Database) UpdateByID(ctx context.Context, record sql.Record, options .
 	return r0, r1
 }
 
+// UpdateManyByID provides a mock function with given fields: ctx, records, options
+func (_m *Database) UpdateManyByID(ctx context.Context, records []sql.Record, options ...sql.Options) ([]int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManyByID")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.Record, ...sql.Options) ([]int64, error)); ok {
+		return rf(ctx, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.Record, ...sql.Options) []int64); ok {
+		r0 = rf(ctx, records, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []sql.Record, ...sql.Options) error); ok {
+		r1 = rf(ctx, records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateReturning provides a mock function with given fields: ctx, updates, condition, values, records, options
+func (_m *Database) UpdateReturning(ctx context.Context, updates *sql.Updates, condition *sql.Condition, values []interface{}, records sql.Records, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, updates, condition, values, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReturning")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Updates, *sql.Condition, []interface{}, sql.Records, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, updates, condition, values, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Updates, *sql.Condition, []interface{}, sql.Records, ...sql.Options) int64); ok {
+		r0 = rf(ctx, updates, condition, values, records, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Updates, *sql.Condition, []interface{}, sql.Records, ...sql.Options) error); ok {
+		r1 = rf(ctx, updates, condition, values, records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Upsert provides a mock function with given fields: ctx, record, options
 func (_m *Database) Upsert(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
 	_va := make([]interface{}, len(options))
@@ -467,6 +800,48 @@ func (_m *Database) Upsert(ctx context.Context, record sql.Record, options ...sq
 	return r0, r1
 }
 
+// UpsertMany provides a mock function with given fields: ctx, records, options
+func (_m *Database) UpsertMany(ctx context.Context, records []sql.Record, options ...sql.Options) (int64, int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertMany")
+	}
+
+	var r0 int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.Record, ...sql.Options) (int64, int64, error)); ok {
+		return rf(ctx, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.Record, ...sql.Options) int64); ok {
+		r0 = rf(ctx, records, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []sql.Record, ...sql.Options) int64); ok {
+		r1 = rf(ctx, records, options...)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []sql.Record, ...sql.Options) error); ok {
+		r2 = rf(ctx, records, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewDatabase creates a new instance of Database. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDatabase(t interface {
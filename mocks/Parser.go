@@ -12,6 +12,78 @@ type Parser struct {
 	mock.Mock
 }
 
+// Dialect provides a mock function with no fields
+func (_m *Parser) Dialect() sql.Dialect {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dialect")
+	}
+
+	var r0 sql.Dialect
+	if rf, ok := ret.Get(0).(func() sql.Dialect); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(sql.Dialect)
+	}
+
+	return r0
+}
+
+// InsertReturnsIDs provides a mock function with no fields
+func (_m *Parser) InsertReturnsIDs() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertReturnsIDs")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MaxParams provides a mock function with no fields
+func (_m *Parser) MaxParams() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxParams")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// MaxRows provides a mock function with no fields
+func (_m *Parser) MaxRows() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxRows")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // ParseDeleteByIDQuery provides a mock function with given fields: record
 func (_m *Parser) ParseDeleteByIDQuery(record sql.Record) (string, error) {
 	ret := _m.Called(record)
@@ -41,7 +113,7 @@ func (_m *Parser) ParseDeleteByIDQuery(record sql.Record) (string, error) {
 }
 
 // ParseDeleteQuery provides a mock function with given fields: table, condition
-func (_m *Parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []int, error) {
+func (_m *Parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []sql.Param, error) {
 	ret := _m.Called(table, condition)
 
 	if len(ret) == 0 {
@@ -49,9 +121,9 @@ func (_m *Parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (
 	}
 
 	var r0 string
-	var r1 []int
+	var r1 []sql.Param
 	var r2 error
-	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Condition) (string, []int, error)); ok {
+	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Condition) (string, []sql.Param, error)); ok {
 		return rf(table, condition)
 	}
 	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Condition) string); ok {
@@ -60,11 +132,11 @@ func (_m *Parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(*sql.Table, *sql.Condition) []int); ok {
+	if rf, ok := ret.Get(1).(func(*sql.Table, *sql.Condition) []sql.Param); ok {
 		r1 = rf(table, condition)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).([]int)
+			r1 = ret.Get(1).([]sql.Param)
 		}
 	}
 
@@ -77,8 +149,82 @@ func (_m *Parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (
 	return r0, r1, r2
 }
 
+// ParseDeleteReturningQuery provides a mock function with given fields: condition, records
+func (_m *Parser) ParseDeleteReturningQuery(condition *sql.Condition, records sql.Records) (string, []sql.Param, error) {
+	ret := _m.Called(condition, records)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseDeleteReturningQuery")
+	}
+
+	var r0 string
+	var r1 []sql.Param
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*sql.Condition, sql.Records) (string, []sql.Param, error)); ok {
+		return rf(condition, records)
+	}
+	if rf, ok := ret.Get(0).(func(*sql.Condition, sql.Records) string); ok {
+		r0 = rf(condition, records)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*sql.Condition, sql.Records) []sql.Param); ok {
+		r1 = rf(condition, records)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]sql.Param)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*sql.Condition, sql.Records) error); ok {
+		r2 = rf(condition, records)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ParseExplainQuery provides a mock function with given fields: filter, records
+func (_m *Parser) ParseExplainQuery(filter *sql.Filter, records sql.Records) (string, []sql.Param, error) {
+	ret := _m.Called(filter, records)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseExplainQuery")
+	}
+
+	var r0 string
+	var r1 []sql.Param
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*sql.Filter, sql.Records) (string, []sql.Param, error)); ok {
+		return rf(filter, records)
+	}
+	if rf, ok := ret.Get(0).(func(*sql.Filter, sql.Records) string); ok {
+		r0 = rf(filter, records)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*sql.Filter, sql.Records) []sql.Param); ok {
+		r1 = rf(filter, records)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]sql.Param)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*sql.Filter, sql.Records) error); ok {
+		r2 = rf(filter, records)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // ParseGetByFilterQuery provides a mock function with given fields: filter, records
-func (_m *Parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records) (string, []int, error) {
+func (_m *Parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records) (string, []sql.Param, error) {
 	ret := _m.Called(filter, records)
 
 	if len(ret) == 0 {
@@ -86,9 +232,9 @@ func (_m *Parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records)
 	}
 
 	var r0 string
-	var r1 []int
+	var r1 []sql.Param
 	var r2 error
-	if rf, ok := ret.Get(0).(func(*sql.Filter, sql.Records) (string, []int, error)); ok {
+	if rf, ok := ret.Get(0).(func(*sql.Filter, sql.Records) (string, []sql.Param, error)); ok {
 		return rf(filter, records)
 	}
 	if rf, ok := ret.Get(0).(func(*sql.Filter, sql.Records) string); ok {
@@ -97,11 +243,11 @@ func (_m *Parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records)
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(*sql.Filter, sql.Records) []int); ok {
+	if rf, ok := ret.Get(1).(func(*sql.Filter, sql.Records) []sql.Param); ok {
 		r1 = rf(filter, records)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).([]int)
+			r1 = ret.Get(1).([]sql.Param)
 		}
 	}
 
@@ -114,9 +260,9 @@ func (_m *Parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records)
 	return r0, r1, r2
 }
 
-// ParseGetByIDQuery provides a mock function with given fields: record
-func (_m *Parser) ParseGetByIDQuery(record sql.Record) (string, error) {
-	ret := _m.Called(record)
+// ParseGetByIDQuery provides a mock function with given fields: record, lock
+func (_m *Parser) ParseGetByIDQuery(record sql.Record, lock sql.LockMode) (string, error) {
+	ret := _m.Called(record, lock)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ParseGetByIDQuery")
@@ -124,17 +270,17 @@ func (_m *Parser) ParseGetByIDQuery(record sql.Record) (string, error) {
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(sql.Record) (string, error)); ok {
-		return rf(record)
+	if rf, ok := ret.Get(0).(func(sql.Record, sql.LockMode) (string, error)); ok {
+		return rf(record, lock)
 	}
-	if rf, ok := ret.Get(0).(func(sql.Record) string); ok {
-		r0 = rf(record)
+	if rf, ok := ret.Get(0).(func(sql.Record, sql.LockMode) string); ok {
+		r0 = rf(record, lock)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(sql.Record) error); ok {
-		r1 = rf(record)
+	if rf, ok := ret.Get(1).(func(sql.Record, sql.LockMode) error); ok {
+		r1 = rf(record, lock)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -142,6 +288,50 @@ func (_m *Parser) ParseGetByIDQuery(record sql.Record) (string, error) {
 	return r0, r1
 }
 
+// ParseInsertIgnoreQuery provides a mock function with given fields: conflict, records
+func (_m *Parser) ParseInsertIgnoreQuery(conflict *sql.Conflict, records ...sql.Record) (string, []interface{}, error) {
+	_va := make([]interface{}, len(records))
+	for _i := range records {
+		_va[_i] = records[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, conflict)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseInsertIgnoreQuery")
+	}
+
+	var r0 string
+	var r1 []interface{}
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*sql.Conflict, ...sql.Record) (string, []interface{}, error)); ok {
+		return rf(conflict, records...)
+	}
+	if rf, ok := ret.Get(0).(func(*sql.Conflict, ...sql.Record) string); ok {
+		r0 = rf(conflict, records...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*sql.Conflict, ...sql.Record) []interface{}); ok {
+		r1 = rf(conflict, records...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*sql.Conflict, ...sql.Record) error); ok {
+		r2 = rf(conflict, records...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // ParseInsertQuery provides a mock function with given fields: record
 func (_m *Parser) ParseInsertQuery(record ...sql.Record) (string, []interface{}, error) {
 	_va := make([]interface{}, len(record))
@@ -185,6 +375,135 @@ func (_m *Parser) ParseInsertQuery(record ...sql.Record) (string, []interface{},
 	return r0, r1, r2
 }
 
+// ParseInsertReturningIDsQuery provides a mock function with given fields: records
+func (_m *Parser) ParseInsertReturningIDsQuery(records ...sql.Record) (string, []interface{}, error) {
+	_va := make([]interface{}, len(records))
+	for _i := range records {
+		_va[_i] = records[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseInsertReturningIDsQuery")
+	}
+
+	var r0 string
+	var r1 []interface{}
+	var r2 error
+	if rf, ok := ret.Get(0).(func(...sql.Record) (string, []interface{}, error)); ok {
+		return rf(records...)
+	}
+	if rf, ok := ret.Get(0).(func(...sql.Record) string); ok {
+		r0 = rf(records...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(...sql.Record) []interface{}); ok {
+		r1 = rf(records...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(...sql.Record) error); ok {
+		r2 = rf(records...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ParseQueryPlan provides a mock function with given fields: plan
+func (_m *Parser) ParseQueryPlan(plan string) (*sql.QueryPlan, error) {
+	ret := _m.Called(plan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseQueryPlan")
+	}
+
+	var r0 *sql.QueryPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*sql.QueryPlan, error)); ok {
+		return rf(plan)
+	}
+	if rf, ok := ret.Get(0).(func(string) *sql.QueryPlan); ok {
+		r0 = rf(plan)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.QueryPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(plan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ParseReleaseSavepointQuery provides a mock function with given fields: name
+func (_m *Parser) ParseReleaseSavepointQuery(name string) (string, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseReleaseSavepointQuery")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ParseRollbackToSavepointQuery provides a mock function with given fields: name
+func (_m *Parser) ParseRollbackToSavepointQuery(name string) (string, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseRollbackToSavepointQuery")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ParseSPQuery provides a mock function with given fields: spName, values
 func (_m *Parser) ParseSPQuery(spName string, values []interface{}) (string, error) {
 	ret := _m.Called(spName, values)
@@ -213,6 +532,34 @@ func (_m *Parser) ParseSPQuery(spName string, values []interface{}) (string, err
 	return r0, r1
 }
 
+// ParseSavepointQuery provides a mock function with given fields: name
+func (_m *Parser) ParseSavepointQuery(name string) (string, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseSavepointQuery")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ParseSoftDeleteByIDQuery provides a mock function with given fields: table, record
 func (_m *Parser) ParseSoftDeleteByIDQuery(table *sql.Table, record sql.Record) (string, error) {
 	ret := _m.Called(table, record)
@@ -242,7 +589,7 @@ func (_m *Parser) ParseSoftDeleteByIDQuery(table *sql.Table, record sql.Record)
 }
 
 // ParseSoftDeleteQuery provides a mock function with given fields: table, condition
-func (_m *Parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []int, error) {
+func (_m *Parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []sql.Param, error) {
 	ret := _m.Called(table, condition)
 
 	if len(ret) == 0 {
@@ -250,9 +597,9 @@ func (_m *Parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Conditio
 	}
 
 	var r0 string
-	var r1 []int
+	var r1 []sql.Param
 	var r2 error
-	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Condition) (string, []int, error)); ok {
+	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Condition) (string, []sql.Param, error)); ok {
 		return rf(table, condition)
 	}
 	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Condition) string); ok {
@@ -261,11 +608,11 @@ func (_m *Parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Conditio
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(*sql.Table, *sql.Condition) []int); ok {
+	if rf, ok := ret.Get(1).(func(*sql.Table, *sql.Condition) []sql.Param); ok {
 		r1 = rf(table, condition)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).([]int)
+			r1 = ret.Get(1).([]sql.Param)
 		}
 	}
 
@@ -278,6 +625,34 @@ func (_m *Parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Conditio
 	return r0, r1, r2
 }
 
+// ParseTransactionQuery provides a mock function with given fields: options
+func (_m *Parser) ParseTransactionQuery(options *sql.TxOptions) (string, error) {
+	ret := _m.Called(options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseTransactionQuery")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*sql.TxOptions) (string, error)); ok {
+		return rf(options)
+	}
+	if rf, ok := ret.Get(0).(func(*sql.TxOptions) string); ok {
+		r0 = rf(options)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*sql.TxOptions) error); ok {
+		r1 = rf(options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ParseUpdateByIDQuery provides a mock function with given fields: record
 func (_m *Parser) ParseUpdateByIDQuery(record sql.Record) (string, error) {
 	ret := _m.Called(record)
@@ -306,8 +681,51 @@ func (_m *Parser) ParseUpdateByIDQuery(record sql.Record) (string, error) {
 	return r0, r1
 }
 
+// ParseUpdateManyByIDQuery provides a mock function with given fields: records
+func (_m *Parser) ParseUpdateManyByIDQuery(records ...sql.Record) (string, []interface{}, error) {
+	_va := make([]interface{}, len(records))
+	for _i := range records {
+		_va[_i] = records[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseUpdateManyByIDQuery")
+	}
+
+	var r0 string
+	var r1 []interface{}
+	var r2 error
+	if rf, ok := ret.Get(0).(func(...sql.Record) (string, []interface{}, error)); ok {
+		return rf(records...)
+	}
+	if rf, ok := ret.Get(0).(func(...sql.Record) string); ok {
+		r0 = rf(records...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(...sql.Record) []interface{}); ok {
+		r1 = rf(records...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(...sql.Record) error); ok {
+		r2 = rf(records...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // ParseUpdateQuery provides a mock function with given fields: table, updates, condition
-func (_m *Parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condition *sql.Condition) (string, []int, error) {
+func (_m *Parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condition *sql.Condition) (string, []sql.Param, error) {
 	ret := _m.Called(table, updates, condition)
 
 	if len(ret) == 0 {
@@ -315,9 +733,9 @@ func (_m *Parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condi
 	}
 
 	var r0 string
-	var r1 []int
+	var r1 []sql.Param
 	var r2 error
-	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Updates, *sql.Condition) (string, []int, error)); ok {
+	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Updates, *sql.Condition) (string, []sql.Param, error)); ok {
 		return rf(table, updates, condition)
 	}
 	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Updates, *sql.Condition) string); ok {
@@ -326,11 +744,11 @@ func (_m *Parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condi
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(*sql.Table, *sql.Updates, *sql.Condition) []int); ok {
+	if rf, ok := ret.Get(1).(func(*sql.Table, *sql.Updates, *sql.Condition) []sql.Param); ok {
 		r1 = rf(table, updates, condition)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).([]int)
+			r1 = ret.Get(1).([]sql.Param)
 		}
 	}
 
@@ -343,9 +761,53 @@ func (_m *Parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condi
 	return r0, r1, r2
 }
 
-// ParseUpsertQuery provides a mock function with given fields: record
-func (_m *Parser) ParseUpsertQuery(record sql.Record) (string, []interface{}, error) {
-	ret := _m.Called(record)
+// ParseUpdateReturningQuery provides a mock function with given fields: updates, condition, records
+func (_m *Parser) ParseUpdateReturningQuery(updates *sql.Updates, condition *sql.Condition, records sql.Records) (string, []sql.Param, error) {
+	ret := _m.Called(updates, condition, records)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseUpdateReturningQuery")
+	}
+
+	var r0 string
+	var r1 []sql.Param
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*sql.Updates, *sql.Condition, sql.Records) (string, []sql.Param, error)); ok {
+		return rf(updates, condition, records)
+	}
+	if rf, ok := ret.Get(0).(func(*sql.Updates, *sql.Condition, sql.Records) string); ok {
+		r0 = rf(updates, condition, records)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*sql.Updates, *sql.Condition, sql.Records) []sql.Param); ok {
+		r1 = rf(updates, condition, records)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]sql.Param)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*sql.Updates, *sql.Condition, sql.Records) error); ok {
+		r2 = rf(updates, condition, records)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ParseUpsertQuery provides a mock function with given fields: conflict, records
+func (_m *Parser) ParseUpsertQuery(conflict *sql.Conflict, records ...sql.Record) (string, []interface{}, error) {
+	_va := make([]interface{}, len(records))
+	for _i := range records {
+		_va[_i] = records[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, conflict)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ParseUpsertQuery")
@@ -354,25 +816,25 @@ func (_m *Parser) ParseUpsertQuery(record sql.Record) (string, []interface{}, er
 	var r0 string
 	var r1 []interface{}
 	var r2 error
-	if rf, ok := ret.Get(0).(func(sql.Record) (string, []interface{}, error)); ok {
-		return rf(record)
+	if rf, ok := ret.Get(0).(func(*sql.Conflict, ...sql.Record) (string, []interface{}, error)); ok {
+		return rf(conflict, records...)
 	}
-	if rf, ok := ret.Get(0).(func(sql.Record) string); ok {
-		r0 = rf(record)
+	if rf, ok := ret.Get(0).(func(*sql.Conflict, ...sql.Record) string); ok {
+		r0 = rf(conflict, records...)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(sql.Record) []interface{}); ok {
-		r1 = rf(record)
+	if rf, ok := ret.Get(1).(func(*sql.Conflict, ...sql.Record) []interface{}); ok {
+		r1 = rf(conflict, records...)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(sql.Record) error); ok {
-		r2 = rf(record)
+	if rf, ok := ret.Get(2).(func(*sql.Conflict, ...sql.Record) error); ok {
+		r2 = rf(conflict, records...)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -380,6 +842,24 @@ func (_m *Parser) ParseUpsertQuery(record sql.Record) (string, []interface{}, er
 	return r0, r1, r2
 }
 
+// ReturnsModifiedRows provides a mock function with no fields
+func (_m *Parser) ReturnsModifiedRows() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReturnsModifiedRows")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // NewParser creates a new instance of Parser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewParser(t interface {
@@ -0,0 +1,565 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	sql "github.com/gofreego/database/sql"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Renderer is an autogenerated mock type for the Renderer type
+type Renderer struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: table, condition, values
+func (_m *Renderer) Delete(table *sql.Table, condition *sql.Condition, values []interface{}) (*sql.Query, error) {
+	ret := _m.Called(table, condition, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Condition, []interface{}) (*sql.Query, error)); ok {
+		return rf(table, condition, values)
+	}
+	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Condition, []interface{}) *sql.Query); ok {
+		r0 = rf(table, condition, values)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*sql.Table, *sql.Condition, []interface{}) error); ok {
+		r1 = rf(table, condition, values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteByID provides a mock function with given fields: record
+func (_m *Renderer) DeleteByID(record sql.Record) (*sql.Query, error) {
+	ret := _m.Called(record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByID")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(sql.Record) (*sql.Query, error)); ok {
+		return rf(record)
+	}
+	if rf, ok := ret.Get(0).(func(sql.Record) *sql.Query); ok {
+		r0 = rf(record)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(sql.Record) error); ok {
+		r1 = rf(record)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteReturning provides a mock function with given fields: condition, values, records
+func (_m *Renderer) DeleteReturning(condition *sql.Condition, values []interface{}, records sql.Records) (*sql.Query, error) {
+	ret := _m.Called(condition, values, records)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReturning")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*sql.Condition, []interface{}, sql.Records) (*sql.Query, error)); ok {
+		return rf(condition, values, records)
+	}
+	if rf, ok := ret.Get(0).(func(*sql.Condition, []interface{}, sql.Records) *sql.Query); ok {
+		r0 = rf(condition, values, records)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*sql.Condition, []interface{}, sql.Records) error); ok {
+		r1 = rf(condition, values, records)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Dialect provides a mock function with no fields
+func (_m *Renderer) Dialect() sql.Dialect {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dialect")
+	}
+
+	var r0 sql.Dialect
+	if rf, ok := ret.Get(0).(func() sql.Dialect); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(sql.Dialect)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: filter, values, records
+func (_m *Renderer) Get(filter *sql.Filter, values []interface{}, records sql.Records) (*sql.Query, error) {
+	ret := _m.Called(filter, values, records)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*sql.Filter, []interface{}, sql.Records) (*sql.Query, error)); ok {
+		return rf(filter, values, records)
+	}
+	if rf, ok := ret.Get(0).(func(*sql.Filter, []interface{}, sql.Records) *sql.Query); ok {
+		r0 = rf(filter, values, records)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*sql.Filter, []interface{}, sql.Records) error); ok {
+		r1 = rf(filter, values, records)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: record, options
+func (_m *Renderer) GetByID(record sql.Record, options ...sql.Options) (*sql.Query, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, record)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(sql.Record, ...sql.Options) (*sql.Query, error)); ok {
+		return rf(record, options...)
+	}
+	if rf, ok := ret.Get(0).(func(sql.Record, ...sql.Options) *sql.Query); ok {
+		r0 = rf(record, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(sql.Record, ...sql.Options) error); ok {
+		r1 = rf(record, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: records
+func (_m *Renderer) Insert(records ...sql.Record) (*sql.Query, error) {
+	_va := make([]interface{}, len(records))
+	for _i := range records {
+		_va[_i] = records[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...sql.Record) (*sql.Query, error)); ok {
+		return rf(records...)
+	}
+	if rf, ok := ret.Get(0).(func(...sql.Record) *sql.Query); ok {
+		r0 = rf(records...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...sql.Record) error); ok {
+		r1 = rf(records...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertIgnore provides a mock function with given fields: records, options
+func (_m *Renderer) InsertIgnore(records []sql.Record, options ...sql.Options) (*sql.Query, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertIgnore")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]sql.Record, ...sql.Options) (*sql.Query, error)); ok {
+		return rf(records, options...)
+	}
+	if rf, ok := ret.Get(0).(func([]sql.Record, ...sql.Options) *sql.Query); ok {
+		r0 = rf(records, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]sql.Record, ...sql.Options) error); ok {
+		r1 = rf(records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunSP provides a mock function with given fields: spName, values
+func (_m *Renderer) RunSP(spName string, values []interface{}) (*sql.Query, error) {
+	ret := _m.Called(spName, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunSP")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []interface{}) (*sql.Query, error)); ok {
+		return rf(spName, values)
+	}
+	if rf, ok := ret.Get(0).(func(string, []interface{}) *sql.Query); ok {
+		r0 = rf(spName, values)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []interface{}) error); ok {
+		r1 = rf(spName, values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDelete provides a mock function with given fields: table, condition, values
+func (_m *Renderer) SoftDelete(table *sql.Table, condition *sql.Condition, values []interface{}) (*sql.Query, error) {
+	ret := _m.Called(table, condition, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Condition, []interface{}) (*sql.Query, error)); ok {
+		return rf(table, condition, values)
+	}
+	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Condition, []interface{}) *sql.Query); ok {
+		r0 = rf(table, condition, values)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*sql.Table, *sql.Condition, []interface{}) error); ok {
+		r1 = rf(table, condition, values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteByID provides a mock function with given fields: record
+func (_m *Renderer) SoftDeleteByID(record sql.Record) (*sql.Query, error) {
+	ret := _m.Called(record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteByID")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(sql.Record) (*sql.Query, error)); ok {
+		return rf(record)
+	}
+	if rf, ok := ret.Get(0).(func(sql.Record) *sql.Query); ok {
+		r0 = rf(record)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(sql.Record) error); ok {
+		r1 = rf(record)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: table, updates, condition, values
+func (_m *Renderer) Update(table *sql.Table, updates *sql.Updates, condition *sql.Condition, values []interface{}) (*sql.Query, error) {
+	ret := _m.Called(table, updates, condition, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Updates, *sql.Condition, []interface{}) (*sql.Query, error)); ok {
+		return rf(table, updates, condition, values)
+	}
+	if rf, ok := ret.Get(0).(func(*sql.Table, *sql.Updates, *sql.Condition, []interface{}) *sql.Query); ok {
+		r0 = rf(table, updates, condition, values)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*sql.Table, *sql.Updates, *sql.Condition, []interface{}) error); ok {
+		r1 = rf(table, updates, condition, values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateByID provides a mock function with given fields: record
+func (_m *Renderer) UpdateByID(record sql.Record) (*sql.Query, error) {
+	ret := _m.Called(record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateByID")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(sql.Record) (*sql.Query, error)); ok {
+		return rf(record)
+	}
+	if rf, ok := ret.Get(0).(func(sql.Record) *sql.Query); ok {
+		r0 = rf(record)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(sql.Record) error); ok {
+		r1 = rf(record)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateManyByID provides a mock function with given fields: records
+func (_m *Renderer) UpdateManyByID(records ...sql.Record) (*sql.Query, error) {
+	_va := make([]interface{}, len(records))
+	for _i := range records {
+		_va[_i] = records[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManyByID")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...sql.Record) (*sql.Query, error)); ok {
+		return rf(records...)
+	}
+	if rf, ok := ret.Get(0).(func(...sql.Record) *sql.Query); ok {
+		r0 = rf(records...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...sql.Record) error); ok {
+		r1 = rf(records...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateReturning provides a mock function with given fields: updates, condition, values, records
+func (_m *Renderer) UpdateReturning(updates *sql.Updates, condition *sql.Condition, values []interface{}, records sql.Records) (*sql.Query, error) {
+	ret := _m.Called(updates, condition, values, records)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReturning")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*sql.Updates, *sql.Condition, []interface{}, sql.Records) (*sql.Query, error)); ok {
+		return rf(updates, condition, values, records)
+	}
+	if rf, ok := ret.Get(0).(func(*sql.Updates, *sql.Condition, []interface{}, sql.Records) *sql.Query); ok {
+		r0 = rf(updates, condition, values, records)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*sql.Updates, *sql.Condition, []interface{}, sql.Records) error); ok {
+		r1 = rf(updates, condition, values, records)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: record, options
+func (_m *Renderer) Upsert(record sql.Record, options ...sql.Options) (*sql.Query, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, record)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func(sql.Record, ...sql.Options) (*sql.Query, error)); ok {
+		return rf(record, options...)
+	}
+	if rf, ok := ret.Get(0).(func(sql.Record, ...sql.Options) *sql.Query); ok {
+		r0 = rf(record, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(sql.Record, ...sql.Options) error); ok {
+		r1 = rf(record, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertMany provides a mock function with given fields: records, options
+func (_m *Renderer) UpsertMany(records []sql.Record, options ...sql.Options) (*sql.Query, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertMany")
+	}
+
+	var r0 *sql.Query
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]sql.Record, ...sql.Options) (*sql.Query, error)); ok {
+		return rf(records, options...)
+	}
+	if rf, ok := ret.Get(0).(func([]sql.Record, ...sql.Options) *sql.Query); ok {
+		r0 = rf(records, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Query)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]sql.Record, ...sql.Options) error); ok {
+		r1 = rf(records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRenderer creates a new instance of Renderer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRenderer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Renderer {
+	mock := &Renderer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,947 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	sql "github.com/gofreego/database/sql"
+)
+
+// Tx is an autogenerated mock type for the Tx type
+type Tx struct {
+	mock.Mock
+}
+
+// BeginTransaction provides a mock function with given fields: ctx, options
+func (_m *Tx) BeginTransaction(ctx context.Context, options ...sql.Options) (sql.Tx, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginTransaction")
+	}
+
+	var r0 sql.Tx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...sql.Options) (sql.Tx, error)); ok {
+		return rf(ctx, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...sql.Options) sql.Tx); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sql.Tx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...sql.Options) error); ok {
+		r1 = rf(ctx, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BulkLoad provides a mock function with given fields: ctx, table, columns, source, options
+func (_m *Tx) BulkLoad(ctx context.Context, table *sql.Table, columns []string, source iter.Seq[sql.Record], options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, table, columns, source)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkLoad")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []string, iter.Seq[sql.Record], ...sql.Options) (int64, error)); ok {
+		return rf(ctx, table, columns, source, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []string, iter.Seq[sql.Record], ...sql.Options) int64); ok {
+		r0 = rf(ctx, table, columns, source, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Table, []string, iter.Seq[sql.Record], ...sql.Options) error); ok {
+		r1 = rf(ctx, table, columns, source, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Close provides a mock function with given fields: ctx
+func (_m *Tx) Close(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Commit provides a mock function with no fields
+func (_m *Tx) Commit() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, table, condition, values, options
+func (_m *Tx) Delete(ctx context.Context, table *sql.Table, condition *sql.Condition, values []interface{}, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, table, condition, values)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, *sql.Condition, []interface{}, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, table, condition, values, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, *sql.Condition, []interface{}, ...sql.Options) int64); ok {
+		r0 = rf(ctx, table, condition, values, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Table, *sql.Condition, []interface{}, ...sql.Options) error); ok {
+		r1 = rf(ctx, table, condition, values, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteByID provides a mock function with given fields: ctx, record, options
+func (_m *Tx) DeleteByID(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, record)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByID")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Record, ...sql.Options) (bool, error)); ok {
+		return rf(ctx, record, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Record, ...sql.Options) bool); ok {
+		r0 = rf(ctx, record, options...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Record, ...sql.Options) error); ok {
+		r1 = rf(ctx, record, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteByIDs provides a mock function with given fields: ctx, table, ids, options
+func (_m *Tx) DeleteByIDs(ctx context.Context, table *sql.Table, ids []int64, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, table, ids)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByIDs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []int64, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, table, ids, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []int64, ...sql.Options) int64); ok {
+		r0 = rf(ctx, table, ids, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Table, []int64, ...sql.Options) error); ok {
+		r1 = rf(ctx, table, ids, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteReturning provides a mock function with given fields: ctx, condition, values, records, options
+func (_m *Tx) DeleteReturning(ctx context.Context, condition *sql.Condition, values []interface{}, records sql.Records, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, condition, values, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReturning")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Condition, []interface{}, sql.Records, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, condition, values, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Condition, []interface{}, sql.Records, ...sql.Options) int64); ok {
+		r0 = rf(ctx, condition, values, records, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Condition, []interface{}, sql.Records, ...sql.Options) error); ok {
+		r1 = rf(ctx, condition, values, records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Explain provides a mock function with given fields: ctx, filter, values, records, options
+func (_m *Tx) Explain(ctx context.Context, filter *sql.Filter, values []interface{}, records sql.Records, options ...sql.Options) (*sql.QueryPlan, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, filter, values, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Explain")
+	}
+
+	var r0 *sql.QueryPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Filter, []interface{}, sql.Records, ...sql.Options) (*sql.QueryPlan, error)); ok {
+		return rf(ctx, filter, values, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Filter, []interface{}, sql.Records, ...sql.Options) *sql.QueryPlan); ok {
+		r0 = rf(ctx, filter, values, records, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.QueryPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Filter, []interface{}, sql.Records, ...sql.Options) error); ok {
+		r1 = rf(ctx, filter, values, records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, filter, values, record, options
+func (_m *Tx) Get(ctx context.Context, filter *sql.Filter, values []interface{}, record sql.Records, options ...sql.Options) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, filter, values, record)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Filter, []interface{}, sql.Records, ...sql.Options) error); ok {
+		r0 = rf(ctx, filter, values, record, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, record, options
+func (_m *Tx) GetByID(ctx context.Context, record sql.Record, options ...sql.Options) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, record)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Record, ...sql.Options) error); ok {
+		r0 = rf(ctx, record, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByIDs provides a mock function with given fields: ctx, ids, records, options
+func (_m *Tx) GetByIDs(ctx context.Context, ids []int64, records sql.Records, options ...sql.Options) ([]int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ids, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, sql.Records, ...sql.Options) ([]int64, error)); ok {
+		return rf(ctx, ids, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, sql.Records, ...sql.Options) []int64); ok {
+		r0 = rf(ctx, ids, records, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, sql.Records, ...sql.Options) error); ok {
+		r1 = rf(ctx, ids, records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, record, options
+func (_m *Tx) Insert(ctx context.Context, record sql.Record, options ...sql.Options) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, record)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Record, ...sql.Options) error); ok {
+		r0 = rf(ctx, record, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertMany provides a mock function with given fields: ctx, records, options
+func (_m *Tx) InsertMany(ctx context.Context, records []sql.Record, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertMany")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.Record, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.Record, ...sql.Options) int64); ok {
+		r0 = rf(ctx, records, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []sql.Record, ...sql.Options) error); ok {
+		r1 = rf(ctx, records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Ping provides a mock function with given fields: ctx
+func (_m *Tx) Ping(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Release provides a mock function with given fields: ctx, name
+func (_m *Tx) Release(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Rollback provides a mock function with no fields
+func (_m *Tx) Rollback() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RollbackTo provides a mock function with given fields: ctx, name
+func (_m *Tx) RollbackTo(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunInTransaction provides a mock function with given fields: ctx, fn, options
+func (_m *Tx) RunInTransaction(ctx context.Context, fn func(context.Context, sql.Tx) error, options ...sql.Options) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, fn)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunInTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context, sql.Tx) error, ...sql.Options) error); ok {
+		r0 = rf(ctx, fn, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunSP provides a mock function with given fields: ctx, spName, values, result, options
+func (_m *Tx) RunSP(ctx context.Context, spName string, values []interface{}, result sql.SPResult, options ...sql.Options) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, spName, values, result)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunSP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []interface{}, sql.SPResult, ...sql.Options) error); ok {
+		r0 = rf(ctx, spName, values, result, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Savepoint provides a mock function with given fields: ctx, name
+func (_m *Tx) Savepoint(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Savepoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDelete provides a mock function with given fields: ctx, table, condition, values, options
+func (_m *Tx) SoftDelete(ctx context.Context, table *sql.Table, condition *sql.Condition, values []interface{}, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, table, condition, values)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, *sql.Condition, []interface{}, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, table, condition, values, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, *sql.Condition, []interface{}, ...sql.Options) int64); ok {
+		r0 = rf(ctx, table, condition, values, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Table, *sql.Condition, []interface{}, ...sql.Options) error); ok {
+		r1 = rf(ctx, table, condition, values, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteByID provides a mock function with given fields: ctx, record, options
+func (_m *Tx) SoftDeleteByID(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, record)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteByID")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Record, ...sql.Options) (bool, error)); ok {
+		return rf(ctx, record, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Record, ...sql.Options) bool); ok {
+		r0 = rf(ctx, record, options...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Record, ...sql.Options) error); ok {
+		r1 = rf(ctx, record, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteByIDs provides a mock function with given fields: ctx, table, ids, options
+func (_m *Tx) SoftDeleteByIDs(ctx context.Context, table *sql.Table, ids []int64, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, table, ids)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteByIDs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []int64, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, table, ids, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, []int64, ...sql.Options) int64); ok {
+		r0 = rf(ctx, table, ids, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Table, []int64, ...sql.Options) error); ok {
+		r1 = rf(ctx, table, ids, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ToSQL provides a mock function with no fields
+func (_m *Tx) ToSQL() sql.Renderer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToSQL")
+	}
+
+	var r0 sql.Renderer
+	if rf, ok := ret.Get(0).(func() sql.Renderer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sql.Renderer)
+		}
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, table, updates, condition, values, options
+func (_m *Tx) Update(ctx context.Context, table *sql.Table, updates *sql.Updates, condition *sql.Condition, values []interface{}, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, table, updates, condition, values)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, *sql.Updates, *sql.Condition, []interface{}, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, table, updates, condition, values, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Table, *sql.Updates, *sql.Condition, []interface{}, ...sql.Options) int64); ok {
+		r0 = rf(ctx, table, updates, condition, values, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Table, *sql.Updates, *sql.Condition, []interface{}, ...sql.Options) error); ok {
+		r1 = rf(ctx, table, updates, condition, values, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateByID provides a mock function with given fields: ctx, record, options
+func (_m *Tx) UpdateByID(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, record)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateByID")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Record, ...sql.Options) (bool, error)); ok {
+		return rf(ctx, record, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Record, ...sql.Options) bool); ok {
+		r0 = rf(ctx, record, options...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Record, ...sql.Options) error); ok {
+		r1 = rf(ctx, record, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateManyByID provides a mock function with given fields: ctx, records, options
+func (_m *Tx) UpdateManyByID(ctx context.Context, records []sql.Record, options ...sql.Options) ([]int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManyByID")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.Record, ...sql.Options) ([]int64, error)); ok {
+		return rf(ctx, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.Record, ...sql.Options) []int64); ok {
+		r0 = rf(ctx, records, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []sql.Record, ...sql.Options) error); ok {
+		r1 = rf(ctx, records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateReturning provides a mock function with given fields: ctx, updates, condition, values, records, options
+func (_m *Tx) UpdateReturning(ctx context.Context, updates *sql.Updates, condition *sql.Condition, values []interface{}, records sql.Records, options ...sql.Options) (int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, updates, condition, values, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReturning")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Updates, *sql.Condition, []interface{}, sql.Records, ...sql.Options) (int64, error)); ok {
+		return rf(ctx, updates, condition, values, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Updates, *sql.Condition, []interface{}, sql.Records, ...sql.Options) int64); ok {
+		r0 = rf(ctx, updates, condition, values, records, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Updates, *sql.Condition, []interface{}, sql.Records, ...sql.Options) error); ok {
+		r1 = rf(ctx, updates, condition, values, records, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: ctx, record, options
+func (_m *Tx) Upsert(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, record)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Record, ...sql.Options) (bool, error)); ok {
+		return rf(ctx, record, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Record, ...sql.Options) bool); ok {
+		r0 = rf(ctx, record, options...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Record, ...sql.Options) error); ok {
+		r1 = rf(ctx, record, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertMany provides a mock function with given fields: ctx, records, options
+func (_m *Tx) UpsertMany(ctx context.Context, records []sql.Record, options ...sql.Options) (int64, int64, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, records)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertMany")
+	}
+
+	var r0 int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.Record, ...sql.Options) (int64, int64, error)); ok {
+		return rf(ctx, records, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.Record, ...sql.Options) int64); ok {
+		r0 = rf(ctx, records, options...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []sql.Record, ...sql.Options) int64); ok {
+		r1 = rf(ctx, records, options...)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []sql.Record, ...sql.Options) error); ok {
+		r2 = rf(ctx, records, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewTx creates a new instance of Tx. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTx(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Tx {
+	mock := &Tx{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
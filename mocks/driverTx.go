@@ -0,0 +1,48 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	sql "database/sql"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// driverTx is an autogenerated mock type for the driverTx type
+type driverTx struct {
+	mock.Mock
+}
+
+// DriverTx provides a mock function with no fields
+func (_m *driverTx) DriverTx() *sql.Tx {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DriverTx")
+	}
+
+	var r0 *sql.Tx
+	if rf, ok := ret.Get(0).(func() *sql.Tx); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Tx)
+		}
+	}
+
+	return r0
+}
+
+// newDriverTx creates a new instance of driverTx. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newDriverTx(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *driverTx {
+	mock := &driverTx{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
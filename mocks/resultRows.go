@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// resultRows is an autogenerated mock type for the resultRows type
+type resultRows struct {
+	mock.Mock
+}
+
+// Close provides a mock function with no fields
+func (_m *resultRows) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Err provides a mock function with no fields
+func (_m *resultRows) Err() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Err")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Next provides a mock function with no fields
+func (_m *resultRows) Next() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Next")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Scan provides a mock function with given fields: dest
+func (_m *resultRows) Scan(dest ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, dest...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Scan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...interface{}) error); ok {
+		r0 = rf(dest...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// newResultRows creates a new instance of resultRows. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newResultRows(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *resultRows {
+	mock := &resultRows{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+package sql
+
+import "slices"
+
+// Excluded is the name of the row proposed for insertion in the conditions of an upsert, as EXCLUDED of PostgreSQL.
+const Excluded = "excluded"
+
+// ExcludedColumn returns the column of the row proposed for insertion, to be used in Conflict.Where,
+// eg. to update the row only if the record is newer:
+// NewCondition("updated_at", LT, NewColumnValue(ExcludedColumn("updated_at")))
+func ExcludedColumn(column string) string {
+	return Excluded + "." + column
+}
+
+// Conflict configures how Upsert resolves a record that conflicts with an existing row.
+type Conflict struct {
+	// Columns is the unique column set the conflict is detected on, the record's IdColumn by default.
+	// On the IdColumn, a record without an id conflicts with no row, and the ids are never inserted: a record is inserted with a generated id.
+	// MySQL detects the conflicts on every unique key of the table, there the columns are only left out of the updates.
+	Columns []string
+	// Constraint is the name of the unique constraint the conflict is detected on, instead of Columns.
+	// Only PostgreSQL supports naming the constraint.
+	Constraint string
+	// Update is the columns updated on conflict with the values of the record,
+	// by default every column of the record except the id and the conflict columns.
+	Update []string
+	// DoNothing leaves the existing row unchanged on conflict.
+	DoNothing bool
+	// Where is the condition the existing row must meet to be updated, the row is left unchanged otherwise.
+	// Its fields are the columns of the existing row, use ExcludedColumn for the values of the record.
+	// Only fixed values can be used in the condition. On MySQL it can read at most one of the updated columns.
+	Where *Condition
+}
+
+// Validate checks if the conflict options are properly configured.
+// Returns an error if the options are invalid.
+func (c *Conflict) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if len(c.Columns) > 0 && c.Constraint != "" {
+		return NewInvalidQueryError("invalid conflict: columns and constraint cannot be both set")
+	}
+	if c.DoNothing && (len(c.Update) > 0 || c.Where != nil) {
+		return NewInvalidQueryError("invalid conflict: update columns and condition cannot be set with do nothing")
+	}
+	for _, column := range c.Columns {
+		if err := ValidateIdentifier(column); err != nil {
+			return err
+		}
+	}
+	if c.Constraint != "" {
+		if err := ValidateIdentifier(c.Constraint); err != nil {
+			return err
+		}
+	}
+	for _, column := range c.Update {
+		if err := ValidateIdentifier(column); err != nil {
+			return err
+		}
+	}
+	return c.Where.Validate()
+}
+
+// ConflictColumns returns the columns the conflict is detected on, the id column of the record if none are set.
+// This is used internally by the library.
+func (c *Conflict) ConflictColumns(record Record) []string {
+	if c == nil || len(c.Columns) == 0 {
+		return []string{record.IdColumn()}
+	}
+	return c.Columns
+}
+
+// UpdateColumns returns the columns updated on conflict, in the order of the record's columns if they are not set.
+// The updated columns must be columns of the record other than its id column, as they are set to the record's values.
+// This is used internally by the library.
+func (c *Conflict) UpdateColumns(record Record) ([]string, error) {
+	columns := []string{}
+	for _, column := range record.Columns() {
+		if column.Name != record.IdColumn() {
+			columns = append(columns, column.Name)
+		}
+	}
+	if c != nil && len(c.Update) > 0 {
+		for _, column := range c.Update {
+			if !slices.Contains(columns, column) {
+				return nil, NewInvalidQueryError("invalid conflict: update column %s is not a value of the record", column)
+			}
+		}
+		return c.Update, nil
+	}
+	var conflictColumns []string
+	if c != nil {
+		conflictColumns = c.Columns
+	}
+	return slices.DeleteFunc(columns, func(column string) bool {
+		return slices.Contains(conflictColumns, column)
+	}), nil
+}
@@ -0,0 +1,85 @@
+package sql
+
+import (
+	"reflect"
+	"testing"
+)
+
+// conflictRecord is a record with the id, name, email and updated_at columns
+type conflictRecord struct{}
+
+func (r *conflictRecord) ID() int64        { return 1 }
+func (r *conflictRecord) IdColumn() string { return "id" }
+func (r *conflictRecord) SetID(id int64)   {}
+func (r *conflictRecord) Table() *Table    { return NewTable("users") }
+func (r *conflictRecord) Columns() []*Field {
+	return []*Field{NewField("id"), NewField("name"), NewField("email"), NewField("updated_at")}
+}
+func (r *conflictRecord) Values() []any           { return []any{"name", "email", 1} }
+func (r *conflictRecord) Scan(row Row) error      { return nil }
+func (r *conflictRecord) SetDeleted(deleted bool) {}
+
+func TestConflict_UpdateColumns(t *testing.T) {
+	tests := []struct {
+		name     string
+		conflict *Conflict
+		want     []string
+		wantErr  bool
+	}{
+		{"nil conflict", nil, []string{"name", "email", "updated_at"}, false},
+		{"conflict columns are not updated", &Conflict{Columns: []string{"email"}}, []string{"name", "updated_at"}, false},
+		{"update columns", &Conflict{Columns: []string{"email"}, Update: []string{"updated_at", "email"}}, []string{"updated_at", "email"}, false},
+		{"id column", &Conflict{Update: []string{"id"}}, nil, true},
+		{"unknown column", &Conflict{Update: []string{"score"}}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.conflict.UpdateColumns(&conflictRecord{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateColumns() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConflict_ConflictColumns(t *testing.T) {
+	record := &conflictRecord{}
+	if got := (*Conflict)(nil).ConflictColumns(record); !reflect.DeepEqual(got, []string{"id"}) {
+		t.Errorf("ConflictColumns() = %v, want [id]", got)
+	}
+	if got := (&Conflict{Columns: []string{"email"}}).ConflictColumns(record); !reflect.DeepEqual(got, []string{"email"}) {
+		t.Errorf("ConflictColumns() = %v, want [email]", got)
+	}
+}
+
+func TestConflict_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		conflict *Conflict
+		wantErr  bool
+	}{
+		{"nil conflict", nil, false},
+		{"columns", &Conflict{Columns: []string{"email"}}, false},
+		{"constraint", &Conflict{Constraint: "users_email_key"}, false},
+		{"condition", &Conflict{Where: NewCondition("updated_at", LT, NewColumnValue(ExcludedColumn("updated_at")))}, false},
+		{"columns and constraint", &Conflict{Columns: []string{"email"}, Constraint: "users_email_key"}, true},
+		{"do nothing with update columns", &Conflict{DoNothing: true, Update: []string{"name"}}, true},
+		{"do nothing with condition", &Conflict{DoNothing: true, Where: NewCondition("name", EQ, NewValue("a"))}, true},
+		{"invalid column", &Conflict{Columns: []string{"LOWER(email)"}}, true},
+		{"invalid update column", &Conflict{Update: []string{"name; DROP TABLE users"}}, true},
+		{"invalid condition", &Conflict{Where: NewCondition("name", EQ, nil)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.conflict.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package sql
 
 import (
 	"context"
+	"iter"
 	"reflect"
+	"strings"
 )
 
 // Database defines the interface for database operations.
@@ -17,36 +19,78 @@ type Database interface {
 	Close(ctx context.Context) error
 
 	// Insert adds a single record to the database.
-	// The record's ID will be set to the last inserted ID.
+	// The record's ID will be set to the ID of its IdColumn returned by the insert on PostgreSQL and MSSQL,
+	// on MySQL to the last inserted ID of the connection that ran the insert, so concurrent inserts get their own IDs.
+	// With Options.OnConflictDoNothing a record conflicting with an existing row is skipped, its ID is left unset.
 	// Returns an error if the operation fails.
 	Insert(ctx context.Context, record Record, options ...Options) error
 
-	// InsertMany adds multiple records to the database in a single operation.
-	// Returns the number of rows affected and an error if the operation fails.
+	// InsertMany adds multiple records to the database and sets the ID of every record, in the order of the records.
+	// The IDs are returned by the insert on PostgreSQL and MSSQL, on MySQL they are consecutive from the first inserted ID,
+	// which requires auto_increment_increment to be 1.
+	// The records are inserted in batches within the database's parameter and row limits, see Options.BatchSize,
+	// use Options.Atomic or Options.Transaction to insert all the records or none.
+	// Returns the number of rows affected by all the batches and an error if the operation fails.
+	// With Options.OnConflictDoNothing the records conflicting with existing rows are skipped and only the inserted rows are counted,
+	// the IDs of a batch are set only if none of its records is skipped.
 	// Returns 0, nil if no records are provided.
 	InsertMany(ctx context.Context, records []Record, options ...Options) (int64, error)
 
 	// Upsert inserts a record if it doesn't exist, or updates it if it does.
-	// Returns true if a new record was inserted, false if an existing record was updated.
+	// Use Options.Conflict to set the unique columns or constraint of the conflict, the columns updated,
+	// to leave the existing row unchanged or to update it only if a condition is met.
+	// Returns true if a new record was inserted, false if an existing record was updated or left unchanged.
+	// The record's ID is set to the ID of the inserted or updated row.
 	// Returns an error if the operation fails.
 	Upsert(ctx context.Context, record Record, options ...Options) (bool, error)
 
+	// UpsertMany inserts the records that don't exist and updates the ones that do, with one statement per batch,
+	// see Upsert for Options.Conflict and Options.BatchSize, Options.Atomic and Options.Transaction for the batches.
+	// The records of a batch should not conflict with the same row.
+	// Returns the number of records inserted and updated.
+	// The IDs of the records are set on PostgreSQL and MSSQL, on PostgreSQL only if no record of the batch leaves its row unchanged.
+	// On MySQL the IDs are not set, and the counts are derived from the rows affected,
+	// so they are exact unless some records leave their rows unchanged.
+	// Returns 0, 0, nil if no records are provided.
+	UpsertMany(ctx context.Context, records []Record, options ...Options) (int64, int64, error)
+
 	// GetByID retrieves a single record by its ID.
 	// The record parameter should have the ID set, and the method will populate other fields.
 	// Returns sql.ErrNoRecordFound if no record exists with the given ID.
 	GetByID(ctx context.Context, record Record, options ...Options) error
 
+	// GetByIDs retrieves the records with the given IDs.
+	// Large ID lists are queried in batches within the database's parameter limits, see Options.BatchSize.
+	// The ID column is the IdColumn of records.Table() if set, otherwise the one returned by records.IdColumn() if the records implement it,
+	// otherwise "id".
+	// Returns the IDs that were not found, in the order they were given.
+	// Returns nil, nil if no IDs are provided.
+	GetByIDs(ctx context.Context, ids []int64, records Records, options ...Options) ([]int64, error)
+
 	// Get retrieves multiple records based on the provided filter.
 	// The filter can include conditions, grouping, sorting, limit, and offset.
 	// The values slice should contain the parameter values in the order they appear in the filter.
 	// The records parameter will be populated with the results.
 	Get(ctx context.Context, filter *Filter, values []any, record Records, options ...Options) error
 
+	// Explain returns the query plan for the query Get would run with the same arguments.
+	// The query is not executed, only planned.
+	// Options.Transaction is honoured, Options.PreparedName is ignored.
+	Explain(ctx context.Context, filter *Filter, values []any, records Records, options ...Options) (*QueryPlan, error)
+
 	// UpdateByID updates a record by its ID.
 	// The record parameter should have the ID and the fields to update set.
 	// Returns true if the record was updated, false if no record exists with the given ID.
 	UpdateByID(ctx context.Context, record Record, options ...Options) (bool, error)
 
+	// UpdateManyByID updates multiple records by their IDs, every record with its own values.
+	// The records are updated in batches with a single statement per batch, see Options.BatchSize.
+	// Returns the number of rows affected by every batch, in order.
+	// If a batch fails, the rows affected by the batches already executed are returned with the error,
+	// use Options.Transaction to update all the records or none.
+	// Returns nil, nil if no records are provided.
+	UpdateManyByID(ctx context.Context, records []Record, options ...Options) ([]int64, error)
+
 	// Update updates records based on the provided condition.
 	// The updates parameter specifies which fields to update and their new values.
 	// The condition parameter specifies which records to update.
@@ -54,11 +98,25 @@ type Database interface {
 	// Returns the number of rows affected and an error if the operation fails.
 	Update(ctx context.Context, table *Table, updates *Updates, condition *Condition, values []any, options ...Options) (int64, error)
 
+	// UpdateReturning updates the rows of the records' table matching the condition and scans the updated rows into the records,
+	// with the columns of the records. The values are resolved as in Update.
+	// PostgreSQL returns the rows with RETURNING and MSSQL with OUTPUT INSERTED, which fails on tables with triggers.
+	// MySQL selects and locks the rows matching the condition after the update, in Options.Transaction or a transaction of its own,
+	// so the condition cannot read the updated columns there.
+	// Returns the number of rows updated and an error if the operation fails.
+	UpdateReturning(ctx context.Context, updates *Updates, condition *Condition, values []any, records Records, options ...Options) (int64, error)
+
 	// SoftDeleteByID marks a record as deleted by setting its deleted field to true.
 	// The table must have a deleted field for this operation to work.
 	// Returns true if the record was soft deleted, false if no record exists with the given ID.
 	SoftDeleteByID(ctx context.Context, record Record, options ...Options) (bool, error)
 
+	// SoftDeleteByIDs marks the rows of the table with the given IDs as deleted, the ID column is table.IdColumn, "id" by default.
+	// Large ID lists are soft deleted in batches within the database's parameter limits, see Options.BatchSize.
+	// Returns the number of rows affected by all the batches, if a batch fails the rows affected so far are returned with the error.
+	// Returns 0, nil if no IDs are provided.
+	SoftDeleteByIDs(ctx context.Context, table *Table, ids []int64, options ...Options) (int64, error)
+
 	// SoftDelete marks records as deleted based on the provided condition.
 	// The table must have a deleted field for this operation to work.
 	// The condition parameter specifies which records to soft delete.
@@ -70,14 +128,53 @@ type Database interface {
 	// Returns true if the record was deleted, false if no record exists with the given ID.
 	DeleteByID(ctx context.Context, record Record, options ...Options) (bool, error)
 
+	// DeleteByIDs permanently removes the rows of the table with the given IDs, the ID column is table.IdColumn, "id" by default.
+	// Large ID lists are deleted in batches within the database's parameter limits, see Options.BatchSize.
+	// Returns the number of rows affected by all the batches, if a batch fails the rows affected so far are returned with the error.
+	// Returns 0, nil if no IDs are provided.
+	DeleteByIDs(ctx context.Context, table *Table, ids []int64, options ...Options) (int64, error)
+
 	// Delete permanently removes records based on the provided condition.
 	// The condition parameter specifies which records to delete.
 	// The values slice should contain the parameter values in the order they appear in the condition.
 	// Returns the number of rows affected and an error if the operation fails.
 	Delete(ctx context.Context, table *Table, condition *Condition, values []any, options ...Options) (int64, error)
 
+	// DeleteReturning deletes the rows of the records' table matching the condition and scans the deleted rows into the records,
+	// with the columns of the records. The values are resolved as in Delete.
+	// PostgreSQL returns the rows with RETURNING and MSSQL with OUTPUT DELETED, which fails on tables with triggers.
+	// MySQL selects and locks the rows matching the condition before the delete, in Options.Transaction or a transaction of its own.
+	// Returns the number of rows deleted and an error if the operation fails.
+	DeleteReturning(ctx context.Context, condition *Condition, values []any, records Records, options ...Options) (int64, error)
+
+	// BulkLoad loads the records of the source into the columns of the table with the database's native bulk load,
+	// COPY on PostgreSQL, LOAD DATA LOCAL INFILE on MySQL and the bulk copy protocol on MSSQL.
+	// The values of every record are loaded into the columns in order, the columns are usually all but the ID column.
+	// The rows are loaded in Options.Transaction if provided, otherwise in a transaction of their own, so either all of them are loaded or none.
+	// Options.Progress is called with the number of rows sent, see Options.Progress.
+	// MySQL requires local_infile to be enabled on the server.
+	// Returns the number of rows loaded.
+	BulkLoad(ctx context.Context, table *Table, columns []string, source iter.Seq[Record], options ...Options) (int64, error)
+
 	RunSP(ctx context.Context, spName string, values []any, result SPResult, options ...Options) error
-	BeginTransaction(ctx context.Context, options ...Options) (Transaction, error)
+	// BeginTransaction begins a transaction with Options.TxOptions and returns its handle, see Tx.
+	// Returns an error if the database does not support the options.
+	BeginTransaction(ctx context.Context, options ...Options) (Tx, error)
+
+	// RunInTransaction runs fn in a new transaction begun with Options.TxOptions, which is committed if fn returns nil and rolled back if fn returns an error or panics.
+	// The panic is propagated once the transaction is rolled back.
+	// The operations of tx run in the transaction, see Transaction.
+	// With Options.Retry the whole of fn is run again in a new transaction when it or the commit fails on a serialization failure or a deadlock,
+	// detected from the error codes of the driver, so fn must be safe to run more than once.
+	// The context passed to fn carries tx, the operations called with it run in the transaction, see WithTransaction.
+	// If Options.Transaction or the transaction of the context is set, fn runs in a savepoint of it, which is released if fn returns nil
+	// and rolled back to if fn returns an error or panics, the savepoint is not retried.
+	// Returns the error of fn or of the commit, the last one if every attempt failed.
+	RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx Tx) error, options ...Options) error
+
+	// ToSQL returns a renderer for the database's dialect.
+	// It renders the query and arguments for an operation without executing it.
+	ToSQL() Renderer
 }
 
 // Row represents a single row from a database query result.
@@ -88,6 +185,8 @@ type Row interface {
 	Scan(dest ...any) error
 }
 
+// Transaction is a database transaction, set with Options.Transaction to run an operation in it.
+// It is a *database/sql.Tx or the Tx of BeginTransaction and RunInTransaction.
 type Transaction interface {
 	// Commit commits the transaction.
 	// Returns an error if the commit fails.
@@ -97,6 +196,24 @@ type Transaction interface {
 	Rollback() error
 }
 
+// Tx is the handle of a transaction begun by BeginTransaction and RunInTransaction, it is also a Database handle
+// running every operation in the transaction, unless another transaction is set with Options.Transaction.
+// The handle cannot begin another transaction, RunInTransaction runs in a savepoint of the transaction,
+// and Close rolls the transaction back unless it is committed, the database is not closed.
+type Tx interface {
+	Database
+	Transaction
+	// Savepoint marks the current state of the transaction with the name, SAVEPOINT on PostgreSQL and MySQL
+	// and SAVE TRANSACTION on MSSQL. Returns an error if the name is not a valid unqualified identifier.
+	Savepoint(ctx context.Context, name string) error
+	// RollbackTo rolls the transaction back to the savepoint, the savepoint can be rolled back to again.
+	// Returns an error if the savepoint does not exist.
+	RollbackTo(ctx context.Context, name string) error
+	// Release removes the savepoint and the ones set after it, keeping their changes in the transaction.
+	// MSSQL has no release, the savepoint is kept.
+	Release(ctx context.Context, name string) error
+}
+
 // Rows represents a set of rows from a database query result.
 // It extends Row with a Next method to iterate through the rows.
 type Rows interface {
@@ -179,9 +296,10 @@ type Records interface {
 // Table represents a database table with optional joins.
 // It's used for building complex queries with multiple table joins.
 type Table struct {
-	Name  string // The name of the table
-	Alias string // Optional alias for the table
-	Join  []Join // List of joins with other tables
+	Name     string // The name of the table
+	Alias    string // Optional alias for the table
+	Join     []Join // List of joins with other tables
+	IdColumn string // Optional id column of GetByIDs, DeleteByIDs and SoftDeleteByIDs, "id" by default
 }
 
 // NewTable creates a new Table instance with the given name.
@@ -193,6 +311,22 @@ func NewTable(name string) *Table {
 	}
 }
 
+// Validate validates the table name and the alias, joined tables are validated when they are parsed.
+func (t *Table) Validate() error {
+	if err := ValidateIdentifier(t.Name); err != nil {
+		return err
+	}
+	if t.Alias != "" {
+		if err := ValidateIdentifier(t.Alias); err != nil {
+			return err
+		}
+	}
+	if t.IdColumn != "" {
+		return ValidateIdentifier(t.IdColumn)
+	}
+	return nil
+}
+
 // WithInnerJoin adds an INNER JOIN to the table.
 // The on parameter specifies the join condition.
 // Returns the table instance for method chaining.
@@ -237,10 +371,23 @@ const (
 	Desc              // Descending order
 )
 
-// OrderBy represents a field and its sort order.
+// Nulls represents the placement of NULL values in the sort order.
+type Nulls int
+
+const (
+	NullsDefault Nulls = iota // The database default, postgresql sorts NULLs as the largest values, mysql and mssql as the smallest
+	NullsFirst                // NULLs before the other values
+	NullsLast                 // NULLs after the other values
+)
+
+// OrderBy represents a sort criterion and its sort order.
+// Exactly one of Field, Aggregate and Expression is set.
 type OrderBy struct {
-	Field string // The field name to sort by
-	Order Order  // The sort order (Asc or Desc)
+	Field      string // The field name or the column alias to sort by
+	Aggregate  *Field // The aggregate field to sort by, eg. CountOf(NewField("id")), the alias is not rendered
+	Expression string // The raw expression to sort by, it is not validated
+	Order      Order  // The sort order (Asc or Desc)
+	Nulls      Nulls  // The placement of NULL values, emulated on mysql and mssql
 }
 
 func NewASCOrder(field string) *OrderBy {
@@ -257,6 +404,35 @@ func NewDESCOrder(field string) *OrderBy {
 	}
 }
 
+// Validate validates the sort criterion.
+// Field names must be plain identifiers, optionally qualified as table.column,
+// use Expression to sort by anything else.
+func (o *OrderBy) Validate() error {
+	set := 0
+	for _, ok := range []bool{o.Field != "", o.Aggregate != nil, o.Expression != ""} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return NewInvalidQueryError("invalid sort: exactly one of field, aggregate and expression should be set")
+	}
+	if o.Field != "" {
+		if err := ValidateIdentifier(o.Field); err != nil {
+			return err
+		}
+	}
+	if o.Aggregate != nil {
+		if err := o.Aggregate.Validate(); err != nil {
+			return err
+		}
+	}
+	if o.Nulls < NullsDefault || o.Nulls > NullsLast {
+		return NewInvalidQueryError("invalid sort: invalid nulls placement: %d", o.Nulls)
+	}
+	return nil
+}
+
 // Sort represents a collection of sort criteria.
 type Sort struct {
 	fields []OrderBy
@@ -270,17 +446,72 @@ func NewSort() *Sort {
 }
 
 // Add adds a sort criterion to the sort collection.
+// The field can be a column name, a table qualified column name or a column alias.
 // Returns the sort instance for method chaining.
 func (o *Sort) Add(field string, order Order) *Sort {
 	o.fields = append(o.fields, OrderBy{Field: field, Order: order})
 	return o
 }
 
+// AddAggregate adds a sort criterion on an aggregate field, eg. CountOf(NewField("id")).
+// Returns the sort instance for method chaining.
+func (o *Sort) AddAggregate(field *Field, order Order) *Sort {
+	o.fields = append(o.fields, OrderBy{Aggregate: field, Order: order})
+	return o
+}
+
+// AddExpression adds a sort criterion on a raw expression, eg. "LENGTH(name)".
+// The expression is added to the query as it is, never pass user input as the expression.
+// Returns the sort instance for method chaining.
+func (o *Sort) AddExpression(expression string, order Order) *Sort {
+	o.fields = append(o.fields, OrderBy{Expression: expression, Order: order})
+	return o
+}
+
+// AddOrderBy adds the sort criterion, eg. NewDESCOrder("created_at").
+// Returns the sort instance for method chaining.
+func (o *Sort) AddOrderBy(orderBy *OrderBy) *Sort {
+	o.fields = append(o.fields, *orderBy)
+	return o
+}
+
+// NullsFirst places the NULL values of the last added criterion before the other values.
+// Returns the sort instance for method chaining.
+func (o *Sort) NullsFirst() *Sort {
+	return o.setNulls(NullsFirst)
+}
+
+// NullsLast places the NULL values of the last added criterion after the other values.
+// Returns the sort instance for method chaining.
+func (o *Sort) NullsLast() *Sort {
+	return o.setNulls(NullsLast)
+}
+
+func (o *Sort) setNulls(nulls Nulls) *Sort {
+	if len(o.fields) > 0 {
+		o.fields[len(o.fields)-1].Nulls = nulls
+	}
+	return o
+}
+
 // Fields returns all the sort criteria.
 func (o *Sort) Fields() []OrderBy {
 	return o.fields
 }
 
+// Validate validates all the sort criteria.
+func (o *Sort) Validate() error {
+	if o == nil {
+		return nil // No sort to validate
+	}
+	for i := range o.fields {
+		if err := o.fields[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Operator represents the type of comparison or logical operation.
 type Operator int
 
@@ -375,6 +606,19 @@ func (g *GroupBy) Fields() []string {
 	return g.fields
 }
 
+// Validate validates the grouping field names.
+func (g *GroupBy) Validate() error {
+	if g == nil {
+		return nil // No grouping to validate
+	}
+	for _, field := range g.fields {
+		if err := ValidateIdentifier(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Condition represents a single condition in a WHERE clause.
 // It can be a simple comparison or a complex logical operation.
 type Condition struct {
@@ -382,6 +626,7 @@ type Condition struct {
 	Value      *Value      // The value to compare against
 	Operator   Operator    // The operator to apply (e.g., EQ, NEQ, GT, etc.)
 	Conditions []Condition // Nested conditions for AND/OR operations
+	RawField   bool        // Field is an expression rendered as it is, it is not validated or quoted
 }
 
 func NewCondition(field string, operator Operator, value *Value) *Condition {
@@ -392,6 +637,17 @@ func NewCondition(field string, operator Operator, value *Value) *Condition {
 	}
 }
 
+// NewRawCondition creates a new condition on an expression, eg. "LOWER(email)".
+// The expression is added to the query as it is, never pass user input as the expression.
+func NewRawCondition(expression string, operator Operator, value *Value) *Condition {
+	return &Condition{
+		Field:    expression,
+		Operator: operator,
+		Value:    value,
+		RawField: true,
+	}
+}
+
 func (c *Condition) And(c1 *Condition) *Condition {
 	if c.Operator == AND {
 		c.Conditions = append(c.Conditions, *c1)
@@ -446,7 +702,7 @@ func (c *Condition) Validate() error {
 		if len(c.Conditions) > 0 {
 			return NewInvalidQueryError("invalid condition: conditions should be empty for operator %s, for field: %s", c.Operator.String(), c.Field)
 		}
-		return nil
+		return c.validateField()
 	case ISNULL, ISNOTNULL:
 		if c.Field == "" {
 			return NewInvalidQueryError("invalid condition: field should not be empty for operator %s", c.Operator.String())
@@ -457,7 +713,7 @@ func (c *Condition) Validate() error {
 		if len(c.Conditions) > 0 {
 			return NewInvalidQueryError("invalid condition: conditions should be empty for operator %s, for field: %s", c.Operator.String(), c.Field)
 		}
-		return nil
+		return c.validateField()
 	case EXISTS, NOTEXISTS:
 		return NewInvalidQueryError("invalid condition: EXISTS and NOT EXISTS operators not supported, field: %s, Operator: %s", c.Field, c.Operator.String())
 	default:
@@ -465,13 +721,130 @@ func (c *Condition) Validate() error {
 	}
 }
 
+// validateField validates the field name and the column the condition compares against.
+func (c *Condition) validateField() error {
+	if !c.RawField {
+		if err := ValidateIdentifier(c.Field); err != nil {
+			return err
+		}
+	}
+	if c.Value != nil && c.Value.IsColumn() {
+		if column, ok := c.Value.Value.(string); ok {
+			return ValidateIdentifier(column)
+		}
+	}
+	return nil
+}
+
 // Filter represents a complete query filter with conditions, grouping, sorting, and pagination.
 type Filter struct {
-	Condition *Condition // The main condition for the filter
-	GroupBy   *GroupBy   // Grouping fields for aggregation
-	Sort      *Sort      // Sorting fields for the result set
-	Limit     *Value     // Limit the number of records returned
-	Offset    *Value     // Offset the number of records returned
+	Condition  *Condition      // The main condition for the filter
+	GroupBy    *GroupBy        // Grouping fields for aggregation
+	Sort       *Sort           // Sorting fields for the result set
+	Limit      *Value          // Limit the number of records returned
+	Offset     *Value          // Offset the number of records returned
+	Lock       LockMode        // Row locks to take on the selected records, requires a transaction
+	Distinct   bool            // Return only distinct rows (SELECT DISTINCT)
+	DistinctOn *DistinctFields // Return the first row for each distinct value of the fields (DISTINCT ON)
+}
+
+// Validate checks if the filter options can be used together.
+// Returns an error if the filter is invalid.
+func (f *Filter) Validate() error {
+	if f == nil {
+		return nil // No filter to validate
+	}
+	if f.DistinctOn != nil {
+		if f.Distinct {
+			return NewInvalidQueryError("invalid filter: Distinct and DistinctOn cannot be used together")
+		}
+		if len(f.DistinctOn.Fields()) == 0 {
+			return NewInvalidQueryError("invalid filter: DistinctOn should have at least one field")
+		}
+		for _, field := range f.DistinctOn.Fields() {
+			if err := ValidateIdentifier(field); err != nil {
+				return err
+			}
+		}
+	}
+	if f.Lock != NoLock && (f.Distinct || f.DistinctOn != nil) {
+		return NewInvalidQueryError("invalid filter: lock mode %s cannot be used with distinct rows", f.Lock.String())
+	}
+	return nil
+}
+
+// DistinctFields represents the fields of a DISTINCT ON clause.
+// Only the first row of each set of rows with the same values for the fields is returned,
+// the first row is decided by the filter's sort.
+type DistinctFields struct {
+	fields []string
+}
+
+// DistinctOn creates a new DistinctFields instance with the specified fields.
+// For example, the latest post of every user:
+//
+//	&Filter{DistinctOn: DistinctOn("user_id"), Sort: NewSort().Add("created_at", Desc)}
+func DistinctOn(fields ...string) *DistinctFields {
+	return &DistinctFields{
+		fields: fields,
+	}
+}
+
+// Fields returns the distinct fields.
+func (d *DistinctFields) Fields() []string {
+	return d.fields
+}
+
+// Sort returns the sort a DISTINCT ON query is ordered by.
+// The distinct fields lead the sort, keeping their order and nulls placement if they are in the given sort,
+// followed by the remaining fields of the given sort.
+// This is used internally by the library.
+func (d *DistinctFields) Sort(sort *Sort) *Sort {
+	result := NewSort()
+	var rest []OrderBy
+	if sort != nil {
+		rest = sort.Fields()
+	}
+	for _, field := range d.fields {
+		criterion := OrderBy{Field: field, Order: Asc}
+		for i, orderBy := range rest {
+			if orderBy.Field == field {
+				criterion = orderBy
+				rest = append(rest[:i:i], rest[i+1:]...)
+				break
+			}
+		}
+		result.fields = append(result.fields, criterion)
+	}
+	result.fields = append(result.fields, rest...)
+	return result
+}
+
+// LockMode represents the row locks taken by a select query.
+// Locking reads must run within a transaction, the locks are held until the transaction ends.
+type LockMode int
+
+const (
+	NoLock              LockMode = iota // No row locks
+	ForUpdate                           // Lock the selected rows, wait for rows locked by other transactions
+	ForUpdateSkipLocked                 // Lock the selected rows, skip rows locked by other transactions
+	ForUpdateNoWait                     // Lock the selected rows, fail if any row is locked by another transaction
+)
+
+// String returns the string representation of the lock mode.
+func (l LockMode) String() string {
+	switch l {
+	case NoLock:
+		return "NO LOCK"
+	case ForUpdate:
+		return "FOR UPDATE"
+	case ForUpdateSkipLocked:
+		return "FOR UPDATE SKIP LOCKED"
+	case ForUpdateNoWait:
+		return "FOR UPDATE NOWAIT"
+	default:
+		return ""
+	}
 }
 
 // UpdateField represents a single field update operation.
@@ -516,6 +889,8 @@ type Field struct {
 	Alias    string
 	Distinct bool
 	Func     AggregateFunc
+	// Raw renders the name as it is, for expressions, the name is not validated or quoted.
+	Raw bool
 }
 
 func NewField(name string) *Field {
@@ -524,6 +899,38 @@ func NewField(name string) *Field {
 	}
 }
 
+// NewRawField creates a new Field for an expression, eg. "LOWER(email)".
+// The expression is added to the query as it is, never pass user input as the expression.
+func NewRawField(expression string) *Field {
+	return &Field{
+		Name: expression,
+		Raw:  true,
+	}
+}
+
+// Validate validates the field name, the alias and the aggregate function.
+// "*" and "table.*" are valid names.
+func (f *Field) Validate() error {
+	if f.Func < None || f.Func > Max {
+		return NewInvalidQueryError("invalid field: invalid aggregate function: %d", f.Func)
+	}
+	if f.Alias != "" {
+		if err := ValidateIdentifier(f.Alias); err != nil {
+			return err
+		}
+	}
+	if f.Name != "" {
+		if f.Raw || f.Name == "*" {
+			return nil
+		}
+		return ValidateIdentifier(strings.TrimSuffix(f.Name, ".*"))
+	}
+	if f.Field == nil {
+		return NewInvalidQueryError("invalid field: field should have a name or a field")
+	}
+	return f.Field.Validate()
+}
+
 func (f *Field) As(alias string) *Field {
 	f.Alias = alias
 	return f
@@ -591,8 +998,10 @@ type Join struct {
 type ValueType int
 
 const (
-	Any    ValueType = iota // Any value type
-	Column                  // Column reference
+	Any        ValueType = iota // Any value type
+	Column                      // Column reference
+	Literal                     // Trusted fixed value rendered in the query
+	Expression                  // Trusted expression rendered in the query as it is
 )
 
 // Value represents a value in a query condition or update operation.
@@ -600,9 +1009,8 @@ const (
 type Value struct {
 	// Type specifies the type of value for validation purposes
 	Type ValueType
-	// Value is used for fixed values that are not parameterized.
-	// These values will be hardcoded in the query.
-	// Use this carefully, as it may lead to SQL injection if the value is not sanitized.
+	// Value is used for fixed values that are not passed in the values slice.
+	// Fixed values are bound as query parameters, unless the value is a RawLiteral.
 	Value any
 	// Index is the index of the value in the values slice for parameterized queries.
 	// Used when Value is nil and the value comes from a parameter slice.
@@ -630,6 +1038,16 @@ func (v *Value) IsColumn() bool {
 	return v.Type == Column
 }
 
+// IsRawLiteral returns true if the value is rendered in the query instead of being bound as a parameter.
+func (v *Value) IsRawLiteral() bool {
+	return v.Type == Literal
+}
+
+// IsExpression returns true if the value is an expression rendered in the query as it is.
+func (v *Value) IsExpression() bool {
+	return v.Type == Expression
+}
+
 // IsStringValue returns true if the value is a string type.
 func (v *Value) IsStringValue() bool {
 	if v.Value == nil {
@@ -654,8 +1072,8 @@ func NewIndexedValue(index int) *Value {
 }
 
 // NewValue creates a new Value with the specified value.
-// This is used for fixed values that are not parameterized. These values will be hardcoded in the query.
-// Use this carefully, as it may lead to SQL injection if the value is not sanitized.
+// This is used for fixed values that are not passed in the values slice.
+// The value is bound as a query parameter, for IN/NOTIN and BETWEEN pass the values as a []any.
 func NewValue(value any) *Value {
 	return &Value{
 		Type:  Any,
@@ -663,8 +1081,19 @@ func NewValue(value any) *Value {
 	}
 }
 
+// RawLiteral creates a new Value that is rendered in the query instead of being bound as a parameter.
+// Strings are quoted with their single quotes escaped, other values are rendered with their default format.
+// Use this only for trusted values, eg. constants the query planner should see, never for user input.
+func RawLiteral(value any) *Value {
+	return &Value{
+		Type:  Literal,
+		Value: value,
+	}
+}
+
 // NewColumnValue creates a new Value that references a column.
 // This is used for conditions that compare one column to another.
+// The column name is validated and quoted, use NewExpressionValue for anything else.
 func NewColumnValue(column string) *Value {
 	return &Value{
 		Type:  Column,
@@ -672,6 +1101,25 @@ func NewColumnValue(column string) *Value {
 	}
 }
 
+// NewExpressionValue creates a new Value for an expression, eg. "price * quantity".
+// It can be used in updates and comparison conditions, the expression is added to the query as it is,
+// never pass user input as the expression.
+func NewExpressionValue(expression string) *Value {
+	return &Value{
+		Type:  Expression,
+		Value: expression,
+	}
+}
+
+// Param is a bound parameter of a parsed query, the parsers return the params in placeholder order.
+// It refers to a value in the values slice by its index,
+// or holds a fixed value which is bound instead of being rendered in the query.
+type Param struct {
+	Index int  // The index of the value in the values slice, used when Fixed is false
+	Value any  // The fixed value, used when Fixed is true
+	Fixed bool // Whether the param holds a fixed value
+}
+
 // GetValues extracts values from a slice based on the provided indexes.
 // It handles both scalar values and array values (for IN/NOTIN operators).
 // Assumes validation has already been applied to ensure valuesPassed has enough values.
@@ -681,18 +1129,41 @@ func GetValues(indexs []int, values []any) []any {
 	}
 	result := make([]any, 0)
 	for _, v := range indexs {
-		value := values[v]
-		// check if value is array type
-		if reflect.TypeOf(value).Kind() == reflect.Slice || reflect.TypeOf(value).Kind() == reflect.Array {
-			// if it is array type, append all the values to the result
-			sliceValue := reflect.ValueOf(value)
-			for i := 0; i < sliceValue.Len(); i++ {
-				result = append(result, sliceValue.Index(i).Interface())
-			}
-		} else {
-			// if it is not array type, append the value directly
-			result = append(result, value)
+		result = appendValue(result, values[v])
+	}
+	return result
+}
+
+// GetParamValues returns the arguments for the params, in placeholder order.
+// Indexed params are resolved against the values slice the same way as in GetValues,
+// fixed params are returned as they are.
+// Assumes validation has already been applied to ensure values has enough values.
+func GetParamValues(params []Param, values []any) []any {
+	if len(params) == 0 {
+		return nil
+	}
+	result := make([]any, 0, len(params))
+	for _, param := range params {
+		if param.Fixed {
+			result = append(result, param.Value)
+			continue
+		}
+		result = appendValue(result, values[param.Index])
+	}
+	return result
+}
+
+func appendValue(result []any, value any) []any {
+	// check if value is array type
+	if reflect.TypeOf(value).Kind() == reflect.Slice || reflect.TypeOf(value).Kind() == reflect.Array {
+		// if it is array type, append all the values to the result
+		sliceValue := reflect.ValueOf(value)
+		for i := 0; i < sliceValue.Len(); i++ {
+			result = append(result, sliceValue.Index(i).Interface())
 		}
+	} else {
+		// if it is not array type, append the value directly
+		result = append(result, value)
 	}
 	return result
 }
@@ -700,16 +1171,49 @@ func GetValues(indexs []int, values []any) []any {
 // Options provides additional configuration for database operations.
 // It can be used to specify database selection, query preparation, and timeouts.
 type Options struct {
-	// UsePrimaryDB specifies whether to use the primary database.
-	// No need to set this for write operations as they default to the primary database.
+	// UsePrimaryDB makes Get and GetByID read from the primary database instead of a read replica,
+	// e.g. to read a write the replicas may not have applied yet, see Routing.
+	// No need to set this for write operations and transactions as they always run on the primary database.
 	UsePrimaryDB bool
 	// PreparedName specifies a unique name for prepared statement caching.
 	// If set, the query will be prepared and cached for reuse.
 	PreparedName string
-	// Timeout specifies the query execution timeout in milliseconds.
+	// Timeout sets the deadline of the operation in milliseconds from its call, the whole transaction for BeginTransaction and RunInTransaction.
+	// It overrides the default timeout of the operation type set in the config, see Timeouts.
+	// The operation fails with an error of code ErrCodeTimeout once the deadline is reached.
 	Timeout int64
 	// Transaction specifies whether to run the operation within a transaction.
+	// By default the operation runs in the transaction of the context if any, see WithTransaction,
+	// it fails if both are set to different transactions.
 	Transaction Transaction
+	// Lock specifies the row locks to take in GetByID, requires a transaction.
+	// For Get, use Filter.Lock instead.
+	Lock LockMode
+	// BatchSize overrides the number of records or IDs sent in one statement by the bulk operations.
+	// By default the batches are as large as the database's parameter and row limits allow, the IDs only within the parameter limit.
+	BatchSize int
+	// Atomic runs all the batches of InsertMany and UpsertMany in one transaction, so either every record is written or none.
+	// It is ignored when Options.Transaction is set, the batches already run in that transaction.
+	Atomic bool
+	// Progress is called by BulkLoad with the number of rows sent so far,
+	// every Options.BatchSize rows (10000 by default) and once all the rows are sent.
+	Progress func(rows int64)
+	// Conflict configures the conflict target and the updates of Upsert and UpsertMany, see Conflict.
+	// By default the conflict is detected on the record's IdColumn and every other column is updated.
+	// With OnConflictDoNothing only its columns or constraint are used.
+	Conflict *Conflict
+	// OnConflictDoNothing skips the records of Insert and InsertMany that conflict with existing rows,
+	// they are not counted as inserted and sql.ErrNoRecordInserted is not returned.
+	// PostgreSQL skips the conflicts on Conflict.Columns or Conflict.Constraint if set, otherwise on every unique constraint,
+	// MySQL runs INSERT IGNORE which skips the conflicts on every unique key,
+	// and MSSQL runs a MERGE inserting the records not matching a row on Conflict.Columns, which are required.
+	OnConflictDoNothing bool
+	// Retry retries RunInTransaction on serialization failures and deadlocks, see Retry.
+	// By default the transaction is not retried.
+	Retry *Retry
+	// TxOptions sets the isolation level and the access mode of the transaction begun by BeginTransaction and RunInTransaction,
+	// see TxOptions. It is ignored when Options.Transaction is set.
+	TxOptions *TxOptions
 }
 
 // GetOptions returns the first option from the options slice if available,
@@ -110,6 +110,41 @@ func TestGetValues(t *testing.T) {
 	}
 }
 
+func TestGetParamValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []Param
+		values []any
+		want   []any
+	}{
+		{
+			name:   "no params",
+			params: nil,
+			values: []any{1},
+			want:   nil,
+		},
+		{
+			name:   "indexed and fixed params in placeholder order",
+			params: []Param{{Value: "O'Brien", Fixed: true}, {Index: 1}, {Index: 0}},
+			values: []any{"john", 42},
+			want:   []any{"O'Brien", 42, "john"},
+		},
+		{
+			name:   "indexed slice is expanded, fixed slice is not",
+			params: []Param{{Index: 0}, {Value: []byte("raw"), Fixed: true}},
+			values: []any{[]int{1, 2}},
+			want:   []any{1, 2, []byte("raw")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetParamValues(tt.params, tt.values); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetParamValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNewTable(t *testing.T) {
 	tests := []struct {
 		name string
@@ -270,6 +305,47 @@ func TestSort_Fields(t *testing.T) {
 	}
 }
 
+func TestSort_Nulls(t *testing.T) {
+	sort := NewSort().NullsFirst() // no criterion, nothing to change
+	sort.Add("score", Desc).NullsLast().Add("name", Asc).NullsFirst()
+
+	fields := sort.Fields()
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 fields, got %d", len(fields))
+	}
+	if fields[0].Nulls != NullsLast || fields[1].Nulls != NullsFirst {
+		t.Errorf("Nulls placement mismatch: %v", fields)
+	}
+}
+
+func TestOrderBy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		orderBy OrderBy
+		wantErr bool
+	}{
+		{"field", OrderBy{Field: "name"}, false},
+		{"table qualified field", OrderBy{Field: "u.name"}, false},
+		{"aggregate", OrderBy{Aggregate: CountOf(NewField("*"))}, false},
+		{"nested aggregate", OrderBy{Aggregate: CountOf(DistinctOf(NewField("email")))}, false},
+		{"expression", OrderBy{Expression: "LENGTH(name)"}, false},
+		{"nothing set", OrderBy{}, true},
+		{"field and aggregate", OrderBy{Field: "name", Aggregate: CountOf(NewField("id"))}, true},
+		{"invalid field", OrderBy{Field: "name DESC"}, true},
+		{"invalid aggregate field", OrderBy{Aggregate: SumOf(NewField("1; --"))}, true},
+		{"empty aggregate", OrderBy{Aggregate: &Field{Func: Count}}, true},
+		{"invalid nulls", OrderBy{Field: "name", Nulls: Nulls(5)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.orderBy.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("OrderBy.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestOperator_String(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -308,6 +384,28 @@ func TestOperator_String(t *testing.T) {
 	}
 }
 
+func TestLockMode_String(t *testing.T) {
+	tests := []struct {
+		name string
+		lock LockMode
+		want string
+	}{
+		{"NoLock", NoLock, "NO LOCK"},
+		{"ForUpdate", ForUpdate, "FOR UPDATE"},
+		{"ForUpdateSkipLocked", ForUpdateSkipLocked, "FOR UPDATE SKIP LOCKED"},
+		{"ForUpdateNoWait", ForUpdateNoWait, "FOR UPDATE NOWAIT"},
+		{"unknown", 999, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.lock.String(); got != tt.want {
+				t.Errorf("LockMode.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNewGroupBy(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -350,6 +448,74 @@ func TestGroupBy_Fields(t *testing.T) {
 	}
 }
 
+func TestFilter_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  *Filter
+		wantErr bool
+	}{
+		{"nil filter", nil, false},
+		{"distinct", &Filter{Distinct: true}, false},
+		{"distinct on", &Filter{DistinctOn: DistinctOn("email")}, false},
+		{"distinct and distinct on", &Filter{Distinct: true, DistinctOn: DistinctOn("email")}, true},
+		{"distinct on without fields", &Filter{DistinctOn: DistinctOn()}, true},
+		{"distinct with lock", &Filter{Distinct: true, Lock: ForUpdate}, true},
+		{"lock without distinct", &Filter{Lock: ForUpdate}, false},
+		{"invalid distinct on field", &Filter{DistinctOn: DistinctOn("user_id, 1")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.filter.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Filter.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDistinctFields_Sort(t *testing.T) {
+	tests := []struct {
+		name     string
+		distinct *DistinctFields
+		sort     *Sort
+		want     []OrderBy
+	}{
+		{
+			name:     "nil sort",
+			distinct: DistinctOn("user_id"),
+			sort:     nil,
+			want:     []OrderBy{{Field: "user_id", Order: Asc}},
+		},
+		{
+			name:     "distinct fields lead the sort",
+			distinct: DistinctOn("user_id"),
+			sort:     NewSort().Add("created_at", Desc),
+			want:     []OrderBy{{Field: "user_id", Order: Asc}, {Field: "created_at", Order: Desc}},
+		},
+		{
+			name:     "distinct field order is kept",
+			distinct: DistinctOn("user_id", "type"),
+			sort:     NewSort().Add("created_at", Desc).Add("user_id", Desc),
+			want:     []OrderBy{{Field: "user_id", Order: Desc}, {Field: "type", Order: Asc}, {Field: "created_at", Order: Desc}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var before []OrderBy
+			if tt.sort != nil {
+				before = append(before, tt.sort.Fields()...)
+			}
+			if got := tt.distinct.Sort(tt.sort).Fields(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DistinctFields.Sort() = %v, want %v", got, tt.want)
+			}
+			if tt.sort != nil && !reflect.DeepEqual(tt.sort.Fields(), before) {
+				t.Errorf("DistinctFields.Sort() modified the given sort: %v", tt.sort.Fields())
+			}
+		})
+	}
+}
+
 func TestCondition_Validate(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -361,6 +527,21 @@ func TestCondition_Validate(t *testing.T) {
 			condition: nil,
 			wantErr:   false,
 		},
+		{
+			name:      "invalid field name",
+			condition: NewCondition("name = name OR 1", EQ, NewIndexedValue(0)),
+			wantErr:   true,
+		},
+		{
+			name:      "raw field expression",
+			condition: NewRawCondition("LOWER(name)", EQ, NewIndexedValue(0)),
+			wantErr:   false,
+		},
+		{
+			name:      "invalid column value",
+			condition: NewCondition("name", EQ, NewColumnValue("other; --")),
+			wantErr:   true,
+		},
 		{
 			name: "valid AND condition",
 			condition: &Condition{
@@ -700,6 +881,20 @@ func TestNewColumnValue(t *testing.T) {
 	}
 }
 
+func TestRawLiteral(t *testing.T) {
+	value := RawLiteral("active")
+
+	if !value.IsRawLiteral() {
+		t.Errorf("Expected raw literal")
+	}
+	if value.Value != "active" {
+		t.Errorf("Expected value 'active', got %v", value.Value)
+	}
+	if NewValue("active").IsRawLiteral() {
+		t.Errorf("Expected NewValue not to be a raw literal")
+	}
+}
+
 func TestGetOptions(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -998,6 +1193,32 @@ func TestNewField(t *testing.T) {
 	}
 }
 
+func TestField_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   *Field
+		wantErr bool
+	}{
+		{"column", NewField("name"), false},
+		{"all columns", NewField("*"), false},
+		{"all table columns", NewField("u.*"), false},
+		{"aggregate with alias", CountOf(NewField("id")).As("total"), false},
+		{"raw expression", NewRawField("LOWER(name)").As("lower_name"), false},
+		{"invalid name", NewField("LOWER(name)"), true},
+		{"invalid alias", NewField("name").As("n; --"), true},
+		{"empty", &Field{}, true},
+		{"invalid aggregate function", &Field{Name: "id", Func: AggregateFunc(42)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.field.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Field.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestField_As(t *testing.T) {
 	tests := []struct {
 		name  string
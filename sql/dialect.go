@@ -0,0 +1,15 @@
+package sql
+
+// Dialect identifies the SQL dialect a query is generated for.
+type Dialect string
+
+const (
+	PostgreSQL Dialect = "postgresql"
+	MySQL      Dialect = "mysql"
+	MSSQL      Dialect = "mssql"
+)
+
+// String returns the string representation of the dialect.
+func (d Dialect) String() string {
+	return string(d)
+}
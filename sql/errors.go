@@ -12,11 +12,13 @@ const (
 	ErrCodeNoRecordInserted
 	ErrCodeInvalidQuery
 	ErrUnknownDatabaseError
+	ErrCodeTimeout
 )
 
 type Error struct {
 	message string
 	code    ErrorCode
+	err     error // the error returned by the driver, if any
 }
 
 func (e *Error) Error() string {
@@ -31,6 +33,16 @@ func (e *Error) IsQueryError() bool {
 	return e.code == ErrCodeInvalidQuery
 }
 
+// IsTimeout returns true if the operation did not complete before its deadline, see Options.Timeout.
+func (e *Error) IsTimeout() bool {
+	return e.code == ErrCodeTimeout
+}
+
+// Unwrap returns the error returned by the driver, eg. to read its error code with errors.As.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
 var (
 	ErrInvalidConfig    = &Error{message: "invalid config", code: ErrCodeInvalidConfig}
 	ErrNoRecordFound    = &Error{message: "no record found", code: ErrCodeNoRecordFound}
@@ -45,6 +57,7 @@ func NewDatabaseError(err error) error {
 	return &Error{
 		message: err.Error(),
 		code:    ErrUnknownDatabaseError,
+		err:     err,
 	}
 }
 
@@ -54,3 +67,16 @@ func NewInvalidQueryError(message string, args ...any) error {
 		code:    ErrCodeInvalidQuery,
 	}
 }
+
+// NewTimeoutError returns the error of an operation that did not complete before its deadline,
+// err is the error returned by the driver.
+func NewTimeoutError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{
+		message: err.Error(),
+		code:    ErrCodeTimeout,
+		err:     err,
+	}
+}
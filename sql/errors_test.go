@@ -75,6 +75,17 @@ func TestNewDatabaseError(t *testing.T) {
 	}
 }
 
+func TestNewDatabaseError_Unwrap(t *testing.T) {
+	cause := errors.New("deadlock detected")
+	err := NewDatabaseError(cause)
+	if !errors.Is(err, cause) {
+		t.Errorf("NewDatabaseError() = %v, want it to wrap %v", err, cause)
+	}
+	if errors.Unwrap(NewInvalidQueryError("invalid query")) != nil {
+		t.Errorf("NewInvalidQueryError() wraps an error, want none")
+	}
+}
+
 func TestNewInvalidQueryError(t *testing.T) {
 	type args struct {
 		message string
@@ -211,3 +222,21 @@ func TestError_IsQueryError(t *testing.T) {
 		})
 	}
 }
+
+func TestNewTimeoutError(t *testing.T) {
+	if err := NewTimeoutError(nil); err != nil {
+		t.Errorf("NewTimeoutError(nil) = %v, want nil", err)
+	}
+	cause := errors.New("context deadline exceeded")
+	err := NewTimeoutError(cause)
+	var sqlErr *Error
+	if !errors.As(err, &sqlErr) || !sqlErr.IsTimeout() || sqlErr.Code() != ErrCodeTimeout {
+		t.Errorf("NewTimeoutError() = %v, want a timeout error", err)
+	}
+	if !errors.Is(err, cause) {
+		t.Errorf("NewTimeoutError() does not wrap %v", cause)
+	}
+	if sqlErr.IsQueryError() {
+		t.Errorf("NewTimeoutError() is a query error")
+	}
+}
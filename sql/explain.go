@@ -0,0 +1,23 @@
+package sql
+
+import "slices"
+
+// QueryPlan represents the execution plan the database chose for a query.
+// Raw holds the plan as returned by the database, the other fields are a
+// summary normalised across dialects.
+type QueryPlan struct {
+	// Raw is the plan as returned by the database.
+	// JSON for PostgreSQL and MySQL, showplan XML for MSSQL.
+	Raw string
+	// EstimatedRows is the number of rows the planner expects the query to return.
+	EstimatedRows float64
+	// SequentialScan is true if any table in the plan is read with a full scan.
+	SequentialScan bool
+	// Indexes are the names of the indexes used by the plan, in plan order.
+	Indexes []string
+}
+
+// UsesIndex returns true if the plan uses the given index.
+func (p *QueryPlan) UsesIndex(index string) bool {
+	return slices.Contains(p.Indexes, index)
+}
@@ -0,0 +1,42 @@
+package sql
+
+import (
+	"regexp"
+	"strings"
+)
+
+// identifierRegex matches a table, column or alias name, optionally qualified as schema.table or table.column.
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*){0,2}$`)
+
+// ValidateIdentifier validates a table, column or alias name.
+// Identifiers are quoted when they are rendered, so reserved words and mixed case names are valid,
+// anything else, like an expression, must use the expression variant of the API, eg. NewRawField.
+func ValidateIdentifier(identifier string) error {
+	if !identifierRegex.MatchString(identifier) {
+		return NewInvalidQueryError("invalid identifier: %q", identifier)
+	}
+	return nil
+}
+
+// QuoteIdentifier quotes every part of the identifier for the dialect,
+// eg. u.name is rendered as "u"."name" on postgresql, `u`.`name` on mysql and [u].[name] on mssql.
+// A * part is not quoted, eg. u.* is rendered as "u".* on postgresql.
+// The identifier is expected to be validated with ValidateIdentifier.
+// This is used internally by the library.
+func (d Dialect) QuoteIdentifier(identifier string) string {
+	open, close := `"`, `"`
+	switch d {
+	case MySQL:
+		open, close = "`", "`"
+	case MSSQL:
+		open, close = "[", "]"
+	}
+	parts := strings.Split(identifier, ".")
+	for i, part := range parts {
+		if part == "*" {
+			continue
+		}
+		parts[i] = open + part + close
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,58 @@
+package sql
+
+import "testing"
+
+func TestValidateIdentifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier string
+		wantErr    bool
+	}{
+		{"column", "name", false},
+		{"reserved word", "order", false},
+		{"mixed case", "CreatedAt", false},
+		{"table qualified column", "u.name", false},
+		{"schema qualified column", "public.users.name", false},
+		{"empty", "", true},
+		{"leading digit", "1name", true},
+		{"space", "first name", true},
+		{"expression", "LOWER(name)", true},
+		{"injection", "name; DROP TABLE users", true},
+		{"quoted", `"name"`, true},
+		{"too many parts", "a.b.c.d", true},
+		{"trailing dot", "users.", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateIdentifier(tt.identifier); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateIdentifier() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDialect_QuoteIdentifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		dialect    Dialect
+		identifier string
+		want       string
+	}{
+		{"postgresql column", PostgreSQL, "CreatedAt", `"CreatedAt"`},
+		{"postgresql qualified column", PostgreSQL, "u.name", `"u"."name"`},
+		{"postgresql all columns", PostgreSQL, "u.*", `"u".*`},
+		{"mysql column", MySQL, "order", "`order`"},
+		{"mysql qualified column", MySQL, "u.name", "`u`.`name`"},
+		{"mssql column", MSSQL, "user", "[user]"},
+		{"mssql schema qualified table", MSSQL, "dbo.users", "[dbo].[users]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dialect.QuoteIdentifier(tt.identifier); got != tt.want {
+				t.Errorf("Dialect.QuoteIdentifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package common
+
+import (
+	"context"
+	driver "database/sql"
+	"iter"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+)
+
+// bulkProgressRows is the number of rows between two progress reports when Options.BatchSize is not set.
+const bulkProgressRows = 10000
+
+// ValidateBulkLoad validates the table and the columns a bulk load writes to.
+func ValidateBulkLoad(table *sql.Table, columns []string) error {
+	if table == nil {
+		return sql.NewInvalidQueryError("bulk load:: table cannot be nil")
+	}
+	if len(table.Join) > 0 {
+		return sql.NewInvalidQueryError("bulk load:: table cannot have joins")
+	}
+	if err := sql.ValidateIdentifier(table.Name); err != nil {
+		return err
+	}
+	if len(columns) == 0 {
+		return sql.NewInvalidQueryError("bulk load:: no columns provided")
+	}
+	for _, column := range columns {
+		if err := sql.ValidateIdentifier(column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+BulkRows calls send with the values of every record of the source, in the order they are yielded.
+Every record should have a value for every column.
+Options.Progress is called with the number of rows sent every Options.BatchSize rows and once all the rows are sent.
+Returns the number of rows sent, errors returned by send are handled as database errors.
+*/
+func BulkRows(ctx context.Context, source iter.Seq[sql.Record], columns []string, opt sql.Options, send func(values []any) error) (int64, error) {
+	every := int64(opt.BatchSize)
+	if every <= 0 {
+		every = bulkProgressRows
+	}
+	var rows int64
+	for record := range source {
+		if err := ctx.Err(); err != nil {
+			return rows, internal.HandleContextError(ctx, err)
+		}
+		values := record.Values()
+		if len(values) != len(columns) {
+			return rows, sql.NewInvalidQueryError("bulk load:: record %d has %d values for %d columns", rows+1, len(values), len(columns))
+		}
+		if err := send(values); err != nil {
+			return rows, internal.HandleContextError(ctx, err)
+		}
+		rows++
+		if opt.Progress != nil && rows%every == 0 {
+			opt.Progress(rows)
+		}
+	}
+	// the last rows are reported unless they were just reported
+	if opt.Progress != nil && rows%every != 0 {
+		opt.Progress(rows)
+	}
+	return rows, nil
+}
+
+/*
+InBulkTransaction runs load in the transaction of the options if provided,
+otherwise in a transaction of its own that is committed if load succeeds and rolled back if it fails.
+*/
+func InBulkTransaction(ctx context.Context, db internal.DB, opt sql.Options, load func(txn *driver.Tx) (int64, error)) (int64, error) {
+	if opt.Transaction != nil {
+		txn, err := internal.GetTransaction(opt.Transaction)
+		if err != nil {
+			return 0, err
+		}
+		return load(txn)
+	}
+	txn, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, internal.HandleContextError(ctx, err)
+	}
+	rows, err := load(txn)
+	if err != nil {
+		_ = txn.Rollback()
+		return 0, err
+	}
+	if err := txn.Commit(); err != nil {
+		return 0, internal.HandleContextError(ctx, err)
+	}
+	return rows, nil
+}
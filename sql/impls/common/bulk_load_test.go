@@ -0,0 +1,131 @@
+package common
+
+import (
+	"context"
+	driver "database/sql"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/gofreego/database/mocks"
+	sqlpkg "github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/tests/records"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var userColumns = []string{"name", "email", "password_hash", "score", "is_active", "created_at", "updated_at"}
+
+func TestValidateBulkLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		table   *sqlpkg.Table
+		columns []string
+		wantErr bool
+	}{
+		{name: "valid", table: sqlpkg.NewTable("users"), columns: userColumns},
+		{name: "schema qualified table", table: sqlpkg.NewTable("public.users"), columns: userColumns},
+		{name: "nil table", table: nil, columns: userColumns, wantErr: true},
+		{name: "invalid table", table: sqlpkg.NewTable("users; DROP TABLE users"), columns: userColumns, wantErr: true},
+		{name: "table with join", table: sqlpkg.NewTable("users").WithInnerJoin(sqlpkg.NewTable("posts"), nil), columns: userColumns, wantErr: true},
+		{name: "no columns", table: sqlpkg.NewTable("users"), columns: nil, wantErr: true},
+		{name: "invalid column", table: sqlpkg.NewTable("users"), columns: []string{"name", "email)"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBulkLoad(tt.table, tt.columns)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBulkLoad() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBulkRows(t *testing.T) {
+	users := func(n int) []sqlpkg.Record {
+		users := make([]sqlpkg.Record, n)
+		for i := range users {
+			users[i] = &records.User{Id: int64(i + 1), Name: "user"}
+		}
+		return users
+	}
+
+	t.Run("progress is reported every batch and at the end", func(t *testing.T) {
+		var sent [][]any
+		var progress []int64
+		rows, err := BulkRows(context.Background(), slices.Values(users(5)), userColumns, sqlpkg.Options{
+			BatchSize: 2,
+			Progress:  func(rows int64) { progress = append(progress, rows) },
+		}, func(values []any) error {
+			sent = append(sent, values)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), rows)
+		assert.Len(t, sent, 5)
+		assert.Equal(t, []int64{2, 4, 5}, progress)
+	})
+
+	t.Run("last batch is reported once", func(t *testing.T) {
+		var progress []int64
+		rows, err := BulkRows(context.Background(), slices.Values(users(4)), userColumns, sqlpkg.Options{
+			BatchSize: 2,
+			Progress:  func(rows int64) { progress = append(progress, rows) },
+		}, func(values []any) error { return nil })
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), rows)
+		assert.Equal(t, []int64{2, 4}, progress)
+	})
+
+	t.Run("record without a value for every column", func(t *testing.T) {
+		rows, err := BulkRows(context.Background(), slices.Values(users(2)), userColumns[:2], sqlpkg.Options{}, func(values []any) error { return nil })
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), rows)
+	})
+
+	t.Run("send error stops the rows", func(t *testing.T) {
+		rows, err := BulkRows(context.Background(), slices.Values(users(3)), userColumns, sqlpkg.Options{}, func(values []any) error {
+			return errors.New("connection reset")
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), rows)
+	})
+
+	t.Run("cancelled context stops the rows", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		rows, err := BulkRows(ctx, slices.Values(users(3)), userColumns, sqlpkg.Options{}, func(values []any) error { return nil })
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), rows)
+	})
+}
+
+func TestInBulkTransaction(t *testing.T) {
+	t.Run("begin transaction error", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		db.On("BeginTx", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+		rows, err := InBulkTransaction(context.Background(), db, sqlpkg.Options{}, func(txn *driver.Tx) (int64, error) {
+			t.Fatal("load should not run without a transaction")
+			return 0, nil
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), rows)
+	})
+
+	t.Run("invalid transaction", func(t *testing.T) {
+		rows, err := InBulkTransaction(context.Background(), mocks.NewDB(t), sqlpkg.Options{Transaction: mocks.NewTransaction(t)}, func(txn *driver.Tx) (int64, error) {
+			t.Fatal("load should not run without a transaction")
+			return 0, nil
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), rows)
+	})
+}
@@ -0,0 +1,281 @@
+package common
+
+import (
+	"context"
+	driver "database/sql"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/goutils/logger"
+)
+
+// defaultIdColumn is the id column of the tables when the records do not name it.
+const defaultIdColumn = "id"
+
+/*
+GetByIDs retrieves the records with the given IDs, the IDs are queried in batches within the parser's parameter limits.
+records.Scan is called once with the rows of all the batches.
+Returns the IDs that were not found, in the order they were given.
+Returns nil, nil if no IDs are provided, records are not scanned in that case.
+Query will not be prepared because of variable length of IDs.
+*/
+func (c *Executor) GetByIDs(ctx context.Context, ids []int64, records sql.Records, options ...sql.Options) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Read)
+	defer cancel()
+	if err := validateLock(opt.Lock, opt); err != nil {
+		return nil, err
+	}
+	if records == nil || records.Table() == nil {
+		return nil, sql.NewInvalidQueryError("get by ids query:: records cannot be nil")
+	}
+	var txn *driver.Tx
+	if opt.Transaction != nil {
+		txn, err = internal.GetTransaction(opt.Transaction)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ids = uniqueIDs(ids)
+	idColumn, err := c.qualifiedIdColumn(records.Table(), tableIdColumn(records.Table(), recordsIdColumn(records)))
+	if err != nil {
+		return nil, err
+	}
+	// the id column is selected last and scanned by the rows to find the missing ids
+	idRecords := &idRecords{Records: records, idColumn: idColumn}
+	rows := &batchRows{
+		batches: slices.Collect(slices.Chunk(ids, c.idsBatchSize(opt))),
+		found:   make(map[int64]bool, len(ids)),
+		open: func(batch []int64) (resultRows, error) {
+			filter := &sql.Filter{
+				Condition: sql.NewRawCondition(idColumn, sql.IN, sql.NewValue(toAny(batch))),
+				Lock:      opt.Lock,
+			}
+			query, params, err := c.parser.ParseGetByFilterQuery(filter, idRecords)
+			if err != nil {
+				return nil, err
+			}
+			logger.Debug(ctx, "GetByIDs query: %s", query)
+			var rows *driver.Rows
+			if txn != nil {
+				rows, err = txn.QueryContext(ctx, query, sql.GetParamValues(params, nil)...)
+			} else {
+				rows, err = c.db.QueryContext(ctx, query, sql.GetParamValues(params, nil)...)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return rows, nil
+		},
+	}
+	defer rows.Close()
+	if err := records.Scan(rows); err != nil {
+		return nil, internal.HandleContextError(ctx, err)
+	}
+	if rows.err != nil {
+		return nil, internal.HandleContextError(ctx, rows.err)
+	}
+	missing := []int64{}
+	for _, id := range ids {
+		if !rows.found[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
+/*
+DeleteByIDs permanently removes the rows of the table with the given IDs, in batches within the parser's parameter limits.
+Returns the number of rows affected by all the batches.
+If a batch fails, the rows affected by the batches already executed are returned with the error.
+Returns 0, nil if no IDs are provided.
+*/
+func (c *Executor) DeleteByIDs(ctx context.Context, table *sql.Table, ids []int64, options ...sql.Options) (int64, error) {
+	return c.execByIDs(ctx, table, ids, c.Delete, options...)
+}
+
+/*
+SoftDeleteByIDs marks the rows of the table with the given IDs as deleted, in batches within the parser's parameter limits.
+Returns the number of rows affected by all the batches.
+If a batch fails, the rows affected by the batches already executed are returned with the error.
+Returns 0, nil if no IDs are provided.
+*/
+func (c *Executor) SoftDeleteByIDs(ctx context.Context, table *sql.Table, ids []int64, options ...sql.Options) (int64, error) {
+	return c.execByIDs(ctx, table, ids, c.SoftDelete, options...)
+}
+
+// execByIDs runs exec for every batch of the IDs with an IN condition on the table's id column.
+func (c *Executor) execByIDs(ctx context.Context, table *sql.Table, ids []int64,
+	exec func(ctx context.Context, table *sql.Table, condition *sql.Condition, values []any, options ...sql.Options) (int64, error),
+	options ...sql.Options) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	if table == nil {
+		return 0, sql.NewInvalidQueryError("table cannot be nil")
+	}
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return 0, err
+	}
+	// the deadline is of the whole call, every batch runs before it
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
+	// the statement changes with the number of ids, so it is not prepared
+	opt.PreparedName = ""
+	idColumn, err := c.qualifiedIdColumn(table, tableIdColumn(table, defaultIdColumn))
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for batch := range slices.Chunk(uniqueIDs(ids), c.idsBatchSize(opt)) {
+		condition := sql.NewRawCondition(idColumn, sql.IN, sql.NewValue(toAny(batch)))
+		rowsAffected, err := exec(ctx, table, condition, nil, opt)
+		if err != nil {
+			return total, err
+		}
+		total += rowsAffected
+	}
+	return total, nil
+}
+
+// recordsIdColumn returns the id column of the records if they name it, eg. when they embed the record type.
+func recordsIdColumn(records sql.Records) string {
+	if r, ok := records.(interface{ IdColumn() string }); ok && r.IdColumn() != "" {
+		return r.IdColumn()
+	}
+	return defaultIdColumn
+}
+
+// tableIdColumn returns the id column of the table if it names it, otherwise the id column given.
+func tableIdColumn(table *sql.Table, idColumn string) string {
+	if table.IdColumn != "" {
+		return table.IdColumn
+	}
+	return idColumn
+}
+
+// qualifiedIdColumn returns the id column qualified with the table's alias or name, so it is not ambiguous with joined tables.
+// A schema qualified table is referred to by its name without the schema, eg. the id column of app.users is users.id.
+// The column is quoted for the dialect of the parser, it is added to the queries as a raw expression.
+func (c *Executor) qualifiedIdColumn(table *sql.Table, idColumn string) (string, error) {
+	qualifier := table.Alias
+	if qualifier == "" {
+		qualifier = table.Name[strings.LastIndex(table.Name, ".")+1:]
+	}
+	for _, identifier := range []string{qualifier, idColumn} {
+		if err := sql.ValidateIdentifier(identifier); err != nil {
+			return "", err
+		}
+	}
+	return c.parser.Dialect().QuoteIdentifier(qualifier + "." + idColumn), nil
+}
+
+// idsBatchSize returns the number of IDs sent in the IN list of one statement by the by-IDs operations.
+// Options.BatchSize is used when it is set, otherwise the list is as large as the parser's parameter limit allows,
+// the row limit of the parser is of VALUES lists and does not apply.
+func (c *Executor) idsBatchSize(opt sql.Options) int {
+	if opt.BatchSize > 0 {
+		return opt.BatchSize
+	}
+	return max(c.parser.MaxParams(), 1)
+}
+
+// uniqueIDs returns the IDs without duplicates, in the order they were given.
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+func toAny(ids []int64) []any {
+	values := make([]any, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+	return values
+}
+
+// idRecords selects the id column after the columns of the records.
+type idRecords struct {
+	sql.Records
+	idColumn string
+}
+
+func (r *idRecords) Columns() []*sql.Field {
+	return append(slices.Clone(r.Records.Columns()), sql.NewRawField(r.idColumn))
+}
+
+// resultRows is the part of *database/sql.Rows read by batchRows.
+type resultRows interface {
+	Next() bool
+	Scan(dest ...any) error
+	Err() error
+	Close() error
+}
+
+// batchRows reads the rows of every batch one after another, the query of a batch runs when its rows are reached.
+// The id selected after the columns of the records is scanned by batchRows and is not passed to the records.
+type batchRows struct {
+	batches [][]int64
+	open    func(batch []int64) (resultRows, error)
+	rows    resultRows
+	found   map[int64]bool
+	err     error
+}
+
+func (r *batchRows) Next() bool {
+	for r.err == nil {
+		if r.rows != nil {
+			if r.rows.Next() {
+				return true
+			}
+			r.err = r.rows.Err()
+			r.rows.Close()
+			r.rows = nil
+			continue
+		}
+		if len(r.batches) == 0 {
+			return false
+		}
+		r.rows, r.err = r.open(r.batches[0])
+		r.batches = r.batches[1:]
+	}
+	return false
+}
+
+func (r *batchRows) Scan(dest ...any) error {
+	if r.rows == nil {
+		return errors.New("sql: Scan called without calling Next")
+	}
+	var id int64
+	if err := r.rows.Scan(append(dest, &id)...); err != nil {
+		return err
+	}
+	r.found[id] = true
+	return nil
+}
+
+func (r *batchRows) Close() error {
+	if r.rows == nil {
+		return nil
+	}
+	err := r.rows.Close()
+	r.rows = nil
+	return err
+}
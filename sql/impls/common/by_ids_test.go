@@ -0,0 +1,400 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofreego/database/mocks"
+	sqlpkg "github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/database/sql/tests/records"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// inCondition matches the IN condition on the id column with the given ids.
+func inCondition(idColumn string, ids ...any) any {
+	return mock.MatchedBy(func(condition *sqlpkg.Condition) bool {
+		return condition.Field == idColumn && condition.RawField && condition.Operator == sqlpkg.IN && assert.ObjectsAreEqual(ids, condition.Value.Value)
+	})
+}
+
+func TestExecutor_DeleteByIDs(t *testing.T) {
+	t.Run("ids are deleted in batches", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		table := sqlpkg.NewTable("users")
+		parser.On("Dialect").Return(sqlpkg.PostgreSQL)
+
+		parser.On("MaxParams").Return(2)
+		parser.On("ParseDeleteQuery", table, inCondition(`"users"."id"`, int64(1), int64(2))).Return("batch 1", nil, nil)
+		parser.On("ParseDeleteQuery", table, inCondition(`"users"."id"`, int64(3))).Return("batch 2", nil, nil)
+		db.On("ExecContext", mock.Anything, "batch 1").Return(&mockResult{rowsAffected: 2}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2").Return(&mockResult{rowsAffected: 0}, nil)
+
+		// duplicate ids are deleted once
+		rowsAffected, err := executor.DeleteByIDs(context.Background(), table, []int64{1, 2, 1, 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), rowsAffected)
+	})
+
+	t.Run("table alias qualifies the id column", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		table := &sqlpkg.Table{Name: "users", Alias: "u"}
+		parser.On("Dialect").Return(sqlpkg.PostgreSQL)
+
+		parser.On("ParseDeleteQuery", table, inCondition(`"u"."id"`, int64(1))).Return("batch 1", nil, nil)
+		db.On("ExecContext", mock.Anything, "batch 1").Return(&mockResult{rowsAffected: 1}, nil)
+
+		rowsAffected, err := executor.DeleteByIDs(context.Background(), table, []int64{1}, sqlpkg.Options{BatchSize: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), rowsAffected)
+	})
+
+	t.Run("table id column", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		table := &sqlpkg.Table{Name: "events", IdColumn: "event_id"}
+		parser.On("Dialect").Return(sqlpkg.PostgreSQL)
+
+		parser.On("ParseDeleteQuery", table, inCondition(`"events"."event_id"`, int64(1))).Return("batch 1", nil, nil)
+		db.On("ExecContext", mock.Anything, "batch 1").Return(&mockResult{rowsAffected: 1}, nil)
+
+		rowsAffected, err := executor.DeleteByIDs(context.Background(), table, []int64{1}, sqlpkg.Options{BatchSize: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), rowsAffected)
+	})
+
+	t.Run("schema qualified table", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		table := sqlpkg.NewTable("app.users")
+		ids := make([]int64, 1500)
+		values := make([]any, len(ids))
+		for i := range ids {
+			ids[i] = int64(i + 1)
+			values[i] = ids[i]
+		}
+
+		// the ids are within the parameter limit, the row limit of the VALUES lists does not split them
+		parser.On("Dialect").Return(sqlpkg.MSSQL)
+		parser.On("MaxParams").Return(2098)
+		parser.On("ParseDeleteQuery", table, inCondition("[users].[id]", values...)).Return("batch 1", nil, nil)
+		db.On("ExecContext", mock.Anything, "batch 1").Return(&mockResult{rowsAffected: 1500}, nil)
+
+		rowsAffected, err := executor.DeleteByIDs(context.Background(), table, ids)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1500), rowsAffected)
+	})
+
+	t.Run("invalid id column", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		table := &sqlpkg.Table{Name: "users", IdColumn: "id; DROP TABLE users"}
+
+		_, err := executor.DeleteByIDs(context.Background(), table, []int64{1}, sqlpkg.Options{BatchSize: 10})
+
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsQueryError())
+	})
+
+	t.Run("conflicting transactions", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		ctx := sqlpkg.WithTransaction(context.Background(), mocks.NewTransaction(t))
+
+		// no batch runs when the transaction of the options is not the one of the context
+		_, err := executor.DeleteByIDs(ctx, sqlpkg.NewTable("users"), []int64{1}, sqlpkg.Options{Transaction: mocks.NewTransaction(t)})
+
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsQueryError())
+	})
+
+	t.Run("failed batch returns the rows affected by the executed batches", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		table := sqlpkg.NewTable("users")
+		parser.On("Dialect").Return(sqlpkg.PostgreSQL)
+
+		parser.On("ParseDeleteQuery", table, inCondition(`"users"."id"`, int64(1))).Return("batch 1", nil, nil)
+		parser.On("ParseDeleteQuery", table, inCondition(`"users"."id"`, int64(2))).Return("batch 2", nil, nil)
+		db.On("ExecContext", mock.Anything, "batch 1").Return(&mockResult{rowsAffected: 1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2").Return(nil, errors.New("connection reset"))
+
+		rowsAffected, err := executor.DeleteByIDs(context.Background(), table, []int64{1, 2, 3}, sqlpkg.Options{BatchSize: 1})
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(1), rowsAffected)
+	})
+
+	t.Run("prepared name is ignored", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		table := sqlpkg.NewTable("users")
+		parser.On("Dialect").Return(sqlpkg.PostgreSQL)
+
+		parser.On("ParseDeleteQuery", table, inCondition(`"users"."id"`, int64(1))).Return("batch 1", nil, nil)
+		db.On("ExecContext", mock.Anything, "batch 1").Return(&mockResult{rowsAffected: 1}, nil)
+
+		rowsAffected, err := executor.DeleteByIDs(context.Background(), table, []int64{1}, sqlpkg.Options{BatchSize: 1, PreparedName: "delete_users"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), rowsAffected)
+	})
+
+	t.Run("no ids", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		rowsAffected, err := executor.DeleteByIDs(context.Background(), sqlpkg.NewTable("users"), nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), rowsAffected)
+	})
+
+	t.Run("nil table", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		_, err := executor.DeleteByIDs(context.Background(), nil, []int64{1})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestExecutor_SoftDeleteByIDs(t *testing.T) {
+	db := mocks.NewDB(t)
+	parser := mocks.NewParser(t)
+	executor := &Executor{
+		db:                 db,
+		parser:             parser,
+		preparedStatements: internal.NewPreparedStatements(),
+	}
+	table := sqlpkg.NewTable("users")
+	parser.On("Dialect").Return(sqlpkg.PostgreSQL)
+
+	parser.On("ParseSoftDeleteQuery", table, inCondition(`"users"."id"`, int64(1), int64(2))).Return("batch 1", nil, nil)
+	parser.On("ParseSoftDeleteQuery", table, inCondition(`"users"."id"`, int64(3))).Return("batch 2", nil, nil)
+	db.On("ExecContext", mock.Anything, "batch 1").Return(&mockResult{rowsAffected: 2}, nil)
+	db.On("ExecContext", mock.Anything, "batch 2").Return(&mockResult{rowsAffected: 1}, nil)
+
+	rowsAffected, err := executor.SoftDeleteByIDs(context.Background(), table, []int64{1, 2, 3}, sqlpkg.Options{BatchSize: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), rowsAffected)
+}
+
+func TestExecutor_GetByIDs(t *testing.T) {
+	t.Run("parser error", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		users := &records.Users{}
+		parser.On("Dialect").Return(sqlpkg.PostgreSQL)
+
+		parser.On("ParseGetByFilterQuery", mock.Anything, mock.Anything).Return("", nil, errors.New("parser error"))
+
+		missing, err := executor.GetByIDs(context.Background(), []int64{1, 2}, users, sqlpkg.Options{BatchSize: 10})
+
+		assert.Error(t, err)
+		assert.Nil(t, missing)
+	})
+
+	t.Run("database query error", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		users := &records.Users{}
+		parser.On("Dialect").Return(sqlpkg.PostgreSQL)
+
+		// the id column is selected after the columns of the records
+		parser.On("ParseGetByFilterQuery", mock.MatchedBy(func(filter *sqlpkg.Filter) bool {
+			return filter.Condition.Field == `"users"."id"`
+		}), mock.MatchedBy(func(records sqlpkg.Records) bool {
+			columns := records.Columns()
+			return len(columns) == len(users.Columns())+1 && columns[len(columns)-1].Name == `"users"."id"` && columns[len(columns)-1].Raw
+		})).Return("batch 1", []sqlpkg.Param{{Fixed: true, Value: int64(1)}, {Fixed: true, Value: int64(2)}}, nil)
+		db.On("QueryContext", mock.Anything, "batch 1", int64(1), int64(2)).Return(nil, errors.New("database error"))
+
+		missing, err := executor.GetByIDs(context.Background(), []int64{1, 2}, users, sqlpkg.Options{BatchSize: 10})
+
+		assert.Error(t, err)
+		assert.Nil(t, missing)
+	})
+
+	t.Run("lock outside transaction", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		_, err := executor.GetByIDs(context.Background(), []int64{1}, &records.Users{}, sqlpkg.Options{Lock: sqlpkg.ForUpdate})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("no ids", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		missing, err := executor.GetByIDs(context.Background(), nil, &records.Users{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, missing)
+	})
+}
+
+// fakeRows returns the given ids, one row for each id with every column set to the id.
+type fakeRows struct {
+	ids    []int64
+	next   int
+	closed bool
+}
+
+func (r *fakeRows) Next() bool {
+	r.next++
+	return r.next <= len(r.ids)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	for _, d := range dest {
+		*d.(*int64) = r.ids[r.next-1]
+	}
+	return nil
+}
+
+func (r *fakeRows) Err() error { return nil }
+
+func (r *fakeRows) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestBatchRows(t *testing.T) {
+	t.Run("rows of all the batches are read in order", func(t *testing.T) {
+		opened := []*fakeRows{}
+		rows := &batchRows{
+			batches: [][]int64{{1, 2}, {3, 4}, {5}},
+			found:   map[int64]bool{},
+			open: func(batch []int64) (resultRows, error) {
+				// 4 and 5 are not found
+				found := []int64{}
+				for _, id := range batch {
+					if id < 4 {
+						found = append(found, id)
+					}
+				}
+				r := &fakeRows{ids: found}
+				opened = append(opened, r)
+				return r, nil
+			},
+		}
+
+		var scanned []int64
+		for rows.Next() {
+			var id int64
+			assert.NoError(t, rows.Scan(&id))
+			scanned = append(scanned, id)
+		}
+
+		assert.NoError(t, rows.err)
+		assert.Equal(t, []int64{1, 2, 3}, scanned)
+		assert.Equal(t, map[int64]bool{1: true, 2: true, 3: true}, rows.found)
+		assert.Len(t, opened, 3)
+		for _, r := range opened {
+			assert.True(t, r.closed)
+		}
+	})
+
+	t.Run("open error stops the rows", func(t *testing.T) {
+		rows := &batchRows{
+			batches: [][]int64{{1}, {2}},
+			found:   map[int64]bool{},
+			open: func(batch []int64) (resultRows, error) {
+				if batch[0] == 2 {
+					return nil, errors.New("database error")
+				}
+				return &fakeRows{ids: batch}, nil
+			},
+		}
+
+		assert.True(t, rows.Next())
+		assert.NoError(t, rows.Scan(new(int64)))
+		assert.False(t, rows.Next())
+		assert.Error(t, rows.err)
+		assert.NoError(t, rows.Close())
+	})
+
+	t.Run("scan without next", func(t *testing.T) {
+		rows := &batchRows{found: map[int64]bool{}}
+
+		assert.Error(t, rows.Scan(new(int64)))
+	})
+}
+
+func TestTableIdColumn(t *testing.T) {
+	assert.Equal(t, "id", tableIdColumn(sqlpkg.NewTable("users"), defaultIdColumn))
+	assert.Equal(t, "user_id", tableIdColumn(sqlpkg.NewTable("users"), "user_id"))
+	assert.Equal(t, "event_id", tableIdColumn(&sqlpkg.Table{Name: "events", IdColumn: "event_id"}, "user_id"))
+}
@@ -8,5 +8,11 @@ import (
 
 func (c *Executor) Close(ctx context.Context) error {
 	c.preparedStatements.Close()
+	if c.replicas != nil {
+		if err := c.replicas.close(); err != nil {
+			_ = c.db.Close()
+			return internal.HandleError(err)
+		}
+	}
 	return internal.HandleError(c.db.Close())
 }
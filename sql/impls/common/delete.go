@@ -11,8 +11,12 @@ import (
 
 // DeleteByID implements sql.Database.
 func (c *Executor) DeleteByID(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
-	opt := sql.GetOptions(options...)
-	var err error
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
 	var result driver.Result
 	var query string
 	// if prepared name is not empty, use prepared statement
@@ -24,12 +28,12 @@ func (c *Executor) DeleteByID(ctx context.Context, record sql.Record, options ..
 			if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
 				query, err = c.parser.ParseDeleteByIDQuery(record)
 				if err != nil {
-					return false, internal.HandleError(err)
+					return false, internal.HandleContextError(ctx, err)
 				}
 				logger.Debug(ctx, "DeleteByID query: %s", query)
 				ps, err := c.db.PrepareContext(ctx, query)
 				if err != nil {
-					return false, internal.HandleError(err)
+					return false, internal.HandleContextError(ctx, err)
 				}
 				stmt = internal.NewPreparedStatement(ps).WithQuery(query)
 				c.preparedStatements.Add(opt.PreparedName, stmt)
@@ -42,7 +46,9 @@ func (c *Executor) DeleteByID(ctx context.Context, record sql.Record, options ..
 			if err != nil {
 				return false, err
 			}
-			result, err = txn.ExecContext(ctx, stmt.GetQuery(), record.ID())
+			txStmt := txn.StmtContext(ctx, stmt.GetStatement())
+			defer txStmt.Close()
+			result, err = txStmt.ExecContext(ctx, record.ID())
 		} else {
 			result, err = stmt.GetStatement().ExecContext(ctx, record.ID())
 		}
@@ -50,7 +56,7 @@ func (c *Executor) DeleteByID(ctx context.Context, record sql.Record, options ..
 		// if prepared name is empty, parse the query and execute the query
 		query, err = c.parser.ParseDeleteByIDQuery(record)
 		if err != nil {
-			return false, internal.HandleError(err)
+			return false, internal.HandleContextError(ctx, err)
 		}
 		logger.Debug(ctx, "DeleteByID query: %s", query)
 		// if transaction is provided, use it to execute the query
@@ -67,12 +73,12 @@ func (c *Executor) DeleteByID(ctx context.Context, record sql.Record, options ..
 	}
 	// if there is an error, return false and the error
 	if err != nil {
-		return false, internal.HandleError(err)
+		return false, internal.HandleContextError(ctx, err)
 	}
 	// get the number of rows affected
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return false, internal.HandleError(err)
+		return false, internal.HandleContextError(ctx, err)
 	}
 	// if the number of rows affected is greater than 0, return true, otherwise return false
 	return rowsAffected > 0, nil
@@ -80,27 +86,31 @@ func (c *Executor) DeleteByID(ctx context.Context, record sql.Record, options ..
 
 // Delete implements sql.Database.
 func (c *Executor) Delete(ctx context.Context, table *sql.Table, condition *sql.Condition, values []any, options ...sql.Options) (int64, error) {
-	opt := sql.GetOptions(options...)
-	var err error
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
 	var result driver.Result
 	var query string
-	var valueIndexes []int
+	var params []sql.Param
 	if opt.PreparedName != "" {
 		var stmt *internal.PreparedStatement
 		var ok bool
 		// if prepared statement is not found, parse the query and create a new prepared statement
 		{
 			if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
-				query, valueIndexes, err = c.parser.ParseDeleteQuery(table, condition)
+				query, params, err = c.parser.ParseDeleteQuery(table, condition)
 				if err != nil {
-					return 0, internal.HandleError(err)
+					return 0, internal.HandleContextError(ctx, err)
 				}
 				logger.Debug(ctx, "Delete query: %s", query)
 				ps, err := c.db.PrepareContext(ctx, query)
 				if err != nil {
-					return 0, internal.HandleError(err)
+					return 0, internal.HandleContextError(ctx, err)
 				}
-				stmt = internal.NewPreparedStatement(ps).WithValueIndexes(valueIndexes).WithQuery(query)
+				stmt = internal.NewPreparedStatement(ps).WithParams(params).WithQuery(query)
 				c.preparedStatements.Add(opt.PreparedName, stmt)
 			}
 		}
@@ -111,14 +121,16 @@ func (c *Executor) Delete(ctx context.Context, table *sql.Table, condition *sql.
 			if err != nil {
 				return 0, err
 			}
-			result, err = txn.ExecContext(ctx, stmt.GetQuery(), sql.GetValues(valueIndexes, values)...)
+			txStmt := txn.StmtContext(ctx, stmt.GetStatement())
+			defer txStmt.Close()
+			result, err = txStmt.ExecContext(ctx, sql.GetParamValues(stmt.GetParams(), values)...)
 		} else {
-			result, err = stmt.GetStatement().ExecContext(ctx, sql.GetValues(valueIndexes, values)...)
+			result, err = stmt.GetStatement().ExecContext(ctx, sql.GetParamValues(stmt.GetParams(), values)...)
 		}
 	} else {
-		query, valueIndexes, err = c.parser.ParseDeleteQuery(table, condition)
+		query, params, err = c.parser.ParseDeleteQuery(table, condition)
 		if err != nil {
-			return 0, internal.HandleError(err)
+			return 0, internal.HandleContextError(ctx, err)
 		}
 		logger.Debug(ctx, "Delete query: %s", query)
 		// if transaction is provided, use it to execute the query
@@ -128,27 +140,31 @@ func (c *Executor) Delete(ctx context.Context, table *sql.Table, condition *sql.
 			if err != nil {
 				return 0, err
 			}
-			result, err = txn.ExecContext(ctx, query, sql.GetValues(valueIndexes, values)...)
+			result, err = txn.ExecContext(ctx, query, sql.GetParamValues(params, values)...)
 		} else {
-			result, err = c.db.ExecContext(ctx, query, sql.GetValues(valueIndexes, values)...)
+			result, err = c.db.ExecContext(ctx, query, sql.GetParamValues(params, values)...)
 		}
 	}
 	if err != nil {
-		return 0, internal.HandleError(err)
+		return 0, internal.HandleContextError(ctx, err)
 	}
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return 0, internal.HandleError(err)
+		return 0, internal.HandleContextError(ctx, err)
 	}
 	return rowsAffected, nil
 }
 
 func (c *Executor) SoftDeleteByID(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
-	opt := sql.GetOptions(options...)
-	var err error
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
 	var result driver.Result
 	var query string
-	var valueIndexes []int
+	var params []sql.Param
 	if opt.PreparedName != "" {
 		var stmt *internal.PreparedStatement
 		var ok bool
@@ -157,14 +173,14 @@ func (c *Executor) SoftDeleteByID(ctx context.Context, record sql.Record, option
 			if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
 				query, err = c.parser.ParseSoftDeleteByIDQuery(record.Table(), record)
 				if err != nil {
-					return false, internal.HandleError(err)
+					return false, internal.HandleContextError(ctx, err)
 				}
 				logger.Debug(ctx, "Soft delete by id query: %s", query)
 				ps, err := c.db.PrepareContext(ctx, query)
 				if err != nil {
-					return false, internal.HandleError(err)
+					return false, internal.HandleContextError(ctx, err)
 				}
-				stmt = internal.NewPreparedStatement(ps).WithValueIndexes(valueIndexes).WithQuery(query)
+				stmt = internal.NewPreparedStatement(ps).WithParams(params).WithQuery(query)
 				c.preparedStatements.Add(opt.PreparedName, stmt)
 			}
 		}
@@ -175,14 +191,16 @@ func (c *Executor) SoftDeleteByID(ctx context.Context, record sql.Record, option
 			if err != nil {
 				return false, err
 			}
-			result, err = txn.ExecContext(ctx, stmt.GetQuery(), record.ID())
+			txStmt := txn.StmtContext(ctx, stmt.GetStatement())
+			defer txStmt.Close()
+			result, err = txStmt.ExecContext(ctx, record.ID())
 		} else {
 			result, err = stmt.GetStatement().ExecContext(ctx, record.ID())
 		}
 	} else {
 		query, err = c.parser.ParseSoftDeleteByIDQuery(record.Table(), record)
 		if err != nil {
-			return false, internal.HandleError(err)
+			return false, internal.HandleContextError(ctx, err)
 		}
 		logger.Debug(ctx, "Soft delete by id query: %s", query)
 		// if transaction is provided, use it to execute the query
@@ -198,37 +216,41 @@ func (c *Executor) SoftDeleteByID(ctx context.Context, record sql.Record, option
 		}
 	}
 	if err != nil {
-		return false, internal.HandleError(err)
+		return false, internal.HandleContextError(ctx, err)
 	}
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return false, internal.HandleError(err)
+		return false, internal.HandleContextError(ctx, err)
 	}
 	return rowsAffected > 0, nil
 }
 
 func (c *Executor) SoftDelete(ctx context.Context, table *sql.Table, condition *sql.Condition, values []any, options ...sql.Options) (int64, error) {
-	opt := sql.GetOptions(options...)
-	var err error
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
 	var result driver.Result
 	var query string
-	var valueIndexes []int
+	var params []sql.Param
 	if opt.PreparedName != "" {
 		var stmt *internal.PreparedStatement
 		var ok bool
 		// if prepared statement is not found, parse the query and create a new prepared statement
 		{
 			if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
-				query, valueIndexes, err = c.parser.ParseSoftDeleteQuery(table, condition)
+				query, params, err = c.parser.ParseSoftDeleteQuery(table, condition)
 				if err != nil {
-					return 0, internal.HandleError(err)
+					return 0, internal.HandleContextError(ctx, err)
 				}
 				logger.Debug(ctx, "Soft delete query: %s", query)
 				ps, err := c.db.PrepareContext(ctx, query)
 				if err != nil {
-					return 0, internal.HandleError(err)
+					return 0, internal.HandleContextError(ctx, err)
 				}
-				stmt = internal.NewPreparedStatement(ps).WithValueIndexes(valueIndexes).WithQuery(query)
+				stmt = internal.NewPreparedStatement(ps).WithParams(params).WithQuery(query)
 				c.preparedStatements.Add(opt.PreparedName, stmt)
 			}
 		}
@@ -239,14 +261,16 @@ func (c *Executor) SoftDelete(ctx context.Context, table *sql.Table, condition *
 			if err != nil {
 				return 0, err
 			}
-			result, err = txn.ExecContext(ctx, stmt.GetQuery(), sql.GetValues(valueIndexes, values)...)
+			txStmt := txn.StmtContext(ctx, stmt.GetStatement())
+			defer txStmt.Close()
+			result, err = txStmt.ExecContext(ctx, sql.GetParamValues(stmt.GetParams(), values)...)
 		} else {
-			result, err = stmt.GetStatement().ExecContext(ctx, sql.GetValues(valueIndexes, values)...)
+			result, err = stmt.GetStatement().ExecContext(ctx, sql.GetParamValues(stmt.GetParams(), values)...)
 		}
 	} else {
-		query, valueIndexes, err = c.parser.ParseSoftDeleteQuery(table, condition)
+		query, params, err = c.parser.ParseSoftDeleteQuery(table, condition)
 		if err != nil {
-			return 0, internal.HandleError(err)
+			return 0, internal.HandleContextError(ctx, err)
 		}
 		logger.Debug(ctx, "Soft delete query: %s", query)
 		// if transaction is provided, use it to execute the query
@@ -256,17 +280,17 @@ func (c *Executor) SoftDelete(ctx context.Context, table *sql.Table, condition *
 			if err != nil {
 				return 0, err
 			}
-			result, err = txn.ExecContext(ctx, query, sql.GetValues(valueIndexes, values)...)
+			result, err = txn.ExecContext(ctx, query, sql.GetParamValues(params, values)...)
 		} else {
-			result, err = c.db.ExecContext(ctx, query, sql.GetValues(valueIndexes, values)...)
+			result, err = c.db.ExecContext(ctx, query, sql.GetParamValues(params, values)...)
 		}
 	}
 	if err != nil {
-		return 0, internal.HandleError(err)
+		return 0, internal.HandleContextError(ctx, err)
 	}
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return 0, internal.HandleError(err)
+		return 0, internal.HandleContextError(ctx, err)
 	}
 	return rowsAffected, nil
 }
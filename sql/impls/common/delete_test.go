@@ -366,7 +366,7 @@ func TestExecutor_Delete(t *testing.T) {
 		condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{0}
 		expectedQuery := "DELETE FROM users WHERE is_active = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -398,7 +398,7 @@ func TestExecutor_Delete(t *testing.T) {
 		condition := sqlpkg.NewCondition("email", sqlpkg.LIKE, sqlpkg.NewIndexedValue(0))
 		values := []any{"%test%"}
 		expectedQuery := "DELETE FROM users WHERE email LIKE ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 		preparedName := "delete_users_by_email"
 
 		// Mock parser to return the expected query and value indexes
@@ -431,7 +431,7 @@ func TestExecutor_Delete(t *testing.T) {
 		condition := sqlpkg.NewCondition("id", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{999} // Non-existent ID
 		expectedQuery := "DELETE FROM users WHERE id = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -492,7 +492,7 @@ func TestExecutor_Delete(t *testing.T) {
 		condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{0}
 		expectedQuery := "DELETE FROM users WHERE is_active = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 		expectedErr := errors.New("connection timeout")
 
 		// Mock parser to return the expected query and value indexes
@@ -525,7 +525,7 @@ func TestExecutor_Delete(t *testing.T) {
 		condition := sqlpkg.NewCondition("name", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{"test"}
 		expectedQuery := "DELETE FROM users WHERE name = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 		preparedName := "delete_users_by_name"
 		expectedErr := errors.New("syntax error")
 
@@ -559,7 +559,7 @@ func TestExecutor_Delete(t *testing.T) {
 		condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{0}
 		expectedQuery := "DELETE FROM users WHERE is_active = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 		expectedErr := errors.New("rows affected error")
 
 		// Mock parser to return the expected query and value indexes
@@ -622,7 +622,7 @@ func TestExecutor_Delete(t *testing.T) {
 				Or(sqlpkg.NewCondition("name", sqlpkg.LIKE, sqlpkg.NewIndexedValue(2))))
 		values := []any{1, "%admin%", "%admin%"}
 		expectedQuery := "DELETE FROM users WHERE (is_active = ? AND (email LIKE ? OR name LIKE ?))"
-		expectedValueIndexes := []int{0, 1, 2}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 1}, {Index: 2}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -654,7 +654,7 @@ func TestExecutor_Delete(t *testing.T) {
 		condition := sqlpkg.NewCondition("id", sqlpkg.IN, sqlpkg.NewIndexedValue(0).WithCount(3))
 		values := []any{[]any{1, 2, 3}}
 		expectedQuery := "DELETE FROM users WHERE id IN (?, ?, ?)"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -685,7 +685,7 @@ func TestExecutor_Delete(t *testing.T) {
 		table := sqlpkg.NewTable("users")
 		var values []any
 		expectedQuery := "DELETE FROM users WHERE 1=1"
-		expectedValueIndexes := []int{}
+		expectedValueIndexes := []sqlpkg.Param{}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseDeleteQuery", table, (*sqlpkg.Condition)(nil)).Return(expectedQuery, expectedValueIndexes, nil)
@@ -717,7 +717,7 @@ func TestExecutor_Delete(t *testing.T) {
 		condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewValue(1)) // Fixed value, no parameters
 		values := []any{}
 		expectedQuery := "DELETE FROM users WHERE is_active = 1"
-		expectedValueIndexes := []int{}
+		expectedValueIndexes := []sqlpkg.Param{}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -749,7 +749,7 @@ func TestExecutor_Delete(t *testing.T) {
 		condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{0}
 		expectedQuery := "DELETE FROM users WHERE is_active = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -783,7 +783,7 @@ func BenchmarkExecutor_Delete(b *testing.B) {
 	condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 	values := []any{1}
 	expectedQuery := "DELETE FROM users WHERE is_active = ?"
-	expectedValueIndexes := []int{0}
+	expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 	// Mock parser to return the expected query and value indexes
 	parser.On("ParseDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1238,7 +1238,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{0}
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE is_active = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseSoftDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1270,7 +1270,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("email", sqlpkg.LIKE, sqlpkg.NewIndexedValue(0))
 		values := []any{"%test%"}
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE email LIKE ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 		preparedName := "soft_delete_users_by_email"
 
 		// Mock parser to return the expected query and value indexes
@@ -1303,7 +1303,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("id", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{999} // Non-existent ID
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE id = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseSoftDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1364,7 +1364,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{0}
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE is_active = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 		expectedErr := errors.New("connection timeout")
 
 		// Mock parser to return the expected query and value indexes
@@ -1397,7 +1397,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("name", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{"test"}
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE name = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 		preparedName := "soft_delete_users_by_name"
 		expectedErr := errors.New("syntax error")
 
@@ -1431,7 +1431,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{0}
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE is_active = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 		expectedErr := errors.New("rows affected error")
 
 		// Mock parser to return the expected query and value indexes
@@ -1494,7 +1494,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 				Or(sqlpkg.NewCondition("name", sqlpkg.LIKE, sqlpkg.NewIndexedValue(2))))
 		values := []any{1, "%admin%", "%admin%"}
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE (is_active = ? AND (email LIKE ? OR name LIKE ?))"
-		expectedValueIndexes := []int{0, 1, 2}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 1}, {Index: 2}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseSoftDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1526,7 +1526,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("id", sqlpkg.IN, sqlpkg.NewIndexedValue(0).WithCount(3))
 		values := []any{[]any{1, 2, 3}}
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE id IN (?, ?, ?)"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseSoftDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1557,7 +1557,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		table := sqlpkg.NewTable("users")
 		var values []any
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE 1=1"
-		expectedValueIndexes := []int{}
+		expectedValueIndexes := []sqlpkg.Param{}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseSoftDeleteQuery", table, (*sqlpkg.Condition)(nil)).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1589,7 +1589,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewValue(1)) // Fixed value, no parameters
 		values := []any{}
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE is_active = 1"
-		expectedValueIndexes := []int{}
+		expectedValueIndexes := []sqlpkg.Param{}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseSoftDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1621,7 +1621,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{0}
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE is_active = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseSoftDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1653,7 +1653,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("deleted", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{1} // Already soft deleted
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE deleted = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseSoftDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1685,7 +1685,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("status", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{"inactive"}
 		expectedQuery := "UPDATE customers SET deleted = 1 WHERE status = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseSoftDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1717,7 +1717,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("deleted_at", sqlpkg.ISNULL, nil)
 		values := []any{}
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE deleted_at IS NULL"
-		expectedValueIndexes := []int{}
+		expectedValueIndexes := []sqlpkg.Param{}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseSoftDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1749,7 +1749,7 @@ func TestExecutor_SoftDelete(t *testing.T) {
 		condition := sqlpkg.NewCondition("created_at", sqlpkg.BETWEEN, sqlpkg.NewIndexedValue(0).WithCount(2))
 		values := []any{"2023-01-01", "2023-12-31"}
 		expectedQuery := "UPDATE users SET deleted = 1 WHERE created_at BETWEEN ? AND ?"
-		expectedValueIndexes := []int{0, 1} // Both values should be indexed
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 1}} // Both values should be indexed
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseSoftDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1783,7 +1783,7 @@ func BenchmarkExecutor_SoftDelete(b *testing.B) {
 	condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 	values := []any{1}
 	expectedQuery := "UPDATE users SET deleted = 1 WHERE is_active = ?"
-	expectedValueIndexes := []int{0}
+	expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 	// Mock parser to return the expected query and value indexes
 	parser.On("ParseSoftDeleteQuery", table, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -1,28 +1,49 @@
 package common
 
 import (
+	"context"
+
 	"github.com/gofreego/database/sql"
 	"github.com/gofreego/database/sql/internal"
 )
 
 type Parser interface {
+	Dialect() sql.Dialect
+	MaxParams() int
+	MaxRows() int
+	InsertReturnsIDs() bool
+	ReturnsModifiedRows() bool
 	ParseDeleteByIDQuery(record sql.Record) (string, error)
-	ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []int, error)
+	ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []sql.Param, error)
+	ParseDeleteReturningQuery(condition *sql.Condition, records sql.Records) (string, []sql.Param, error)
 	ParseSoftDeleteByIDQuery(table *sql.Table, record sql.Record) (string, error)
-	ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []int, error)
-	ParseGetByIDQuery(record sql.Record) (string, error)
-	ParseGetByFilterQuery(filter *sql.Filter, records sql.Records) (string, []int, error)
+	ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []sql.Param, error)
+	ParseGetByIDQuery(record sql.Record, lock sql.LockMode) (string, error)
+	ParseGetByFilterQuery(filter *sql.Filter, records sql.Records) (string, []sql.Param, error)
+	ParseExplainQuery(filter *sql.Filter, records sql.Records) (string, []sql.Param, error)
+	ParseQueryPlan(plan string) (*sql.QueryPlan, error)
 	ParseInsertQuery(record ...sql.Record) (string, []any, error)
+	ParseInsertReturningIDsQuery(records ...sql.Record) (string, []any, error)
+	ParseInsertIgnoreQuery(conflict *sql.Conflict, records ...sql.Record) (string, []any, error)
 	ParseUpdateByIDQuery(record sql.Record) (string, error)
-	ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condition *sql.Condition) (string, []int, error)
-	ParseUpsertQuery(record sql.Record) (string, []any, error)
+	ParseUpdateManyByIDQuery(records ...sql.Record) (string, []any, error)
+	ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condition *sql.Condition) (string, []sql.Param, error)
+	ParseUpdateReturningQuery(updates *sql.Updates, condition *sql.Condition, records sql.Records) (string, []sql.Param, error)
+	ParseUpsertQuery(conflict *sql.Conflict, records ...sql.Record) (string, []any, error)
 	ParseSPQuery(spName string, values []any) (string, error)
+	ParseTransactionQuery(options *sql.TxOptions) (string, error)
+	ParseSavepointQuery(name string) (string, error)
+	ParseRollbackToSavepointQuery(name string) (string, error)
+	ParseReleaseSavepointQuery(name string) (string, error)
 }
 
 type Executor struct {
 	db                 internal.DB
 	parser             Parser
 	preparedStatements internal.PreparedStatements
+	timeouts           sql.Timeouts
+	// replicas are the read replicas of the database, nil if it has none
+	replicas *replicas
 }
 
 func NewExecutor(conn internal.DB, parser Parser) *Executor {
@@ -32,3 +53,63 @@ func NewExecutor(conn internal.DB, parser Parser) *Executor {
 		preparedStatements: internal.NewPreparedStatements(),
 	}
 }
+
+// WithReplicas routes Get and GetByID to the read replicas of the database with the routing, see sql.Routing.
+// The replicas are pinged in the background until the executor is closed.
+func (c *Executor) WithReplicas(replicas []internal.DB, routing sql.Routing) *Executor {
+	if len(replicas) == 0 {
+		return c
+	}
+	c.replicas = newReplicas(replicas, routing)
+	c.replicas.start()
+	return c
+}
+
+// prepareFunc returns the statement prepared with the name, the query of parse is prepared if there is none
+type prepareFunc func(ctx context.Context, name string, parse func() (string, []sql.Param, error)) (*internal.PreparedStatement, error)
+
+/*
+reader returns the connection of the read and prepare for the statements prepared on it: a healthy replica,
+or the primary if the read runs in a transaction, Options.UsePrimaryDB is set or no replica is healthy.
+done ends the read on the replica once its rows are scanned.
+*/
+func (c *Executor) reader(opt sql.Options) (db internal.DB, prepare prepareFunc, done func()) {
+	if c.replicas == nil || opt.Transaction != nil || opt.UsePrimaryDB {
+		return c.db, c.prepare, func() {}
+	}
+	rep, picked := c.replicas.pick()
+	if rep == nil {
+		return c.db, c.prepare, picked
+	}
+	stmts := rep.acquire()
+	prepare = func(ctx context.Context, name string, parse func() (string, []sql.Param, error)) (*internal.PreparedStatement, error) {
+		return stmts.prepare(ctx, rep.db, name, parse)
+	}
+	return rep.db, prepare, func() {
+		stmts.release()
+		picked()
+	}
+}
+
+// prepare returns the statement prepared with the name on the primary, the query of parse is prepared if there is none
+func (c *Executor) prepare(ctx context.Context, name string, parse func() (string, []sql.Param, error)) (*internal.PreparedStatement, error) {
+	return prepareStatement(ctx, c.db, c.preparedStatements, name, parse)
+}
+
+// prepareStatement returns the statement of stmts with the name, the query of parse is prepared on db and added to stmts if there is none
+func prepareStatement(ctx context.Context, db internal.DB, stmts internal.PreparedStatements, name string, parse func() (string, []sql.Param, error)) (*internal.PreparedStatement, error) {
+	if stmt, ok := stmts.Get(name); ok {
+		return stmt, nil
+	}
+	query, params, err := parse()
+	if err != nil {
+		return nil, err
+	}
+	ps, err := db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	stmt := internal.NewPreparedStatement(ps).WithParams(params).WithQuery(query)
+	stmts.Add(name, stmt)
+	return stmt, nil
+}
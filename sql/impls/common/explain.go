@@ -0,0 +1,47 @@
+package common
+
+import (
+	"context"
+	driver "database/sql"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/goutils/logger"
+)
+
+// Explain returns the query plan for the query Get would run for the filter.
+// The explain query is generated by the parser and the returned plan is summarised by the parser.
+func (c *Executor) Explain(ctx context.Context, filter *sql.Filter, values []any, records sql.Records, options ...sql.Options) (*sql.QueryPlan, error) {
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Read)
+	defer cancel()
+	query, params, err := c.parser.ParseExplainQuery(filter, records)
+	if err != nil {
+		return nil, internal.HandleContextError(ctx, err)
+	}
+	args, err := resolveValues(params, values)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug(ctx, "Explain query: %s", query)
+	var row *driver.Row
+	// if transaction is provided, use it to execute the query
+	if opt.Transaction != nil {
+		var txn *driver.Tx
+		txn, err = internal.GetTransaction(opt.Transaction)
+		if err != nil {
+			return nil, err
+		}
+		row = txn.QueryRowContext(ctx, query, args...)
+	} else {
+		row = c.db.QueryRowContext(ctx, query, args...)
+	}
+	var plan string
+	if err = row.Scan(&plan); err != nil {
+		return nil, internal.HandleContextError(ctx, err)
+	}
+	return c.parser.ParseQueryPlan(plan)
+}
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofreego/database/mocks"
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutor_Explain(t *testing.T) {
+	t.Run("parser error", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		filter := &sql.Filter{}
+		parser.On("ParseExplainQuery", filter, records).Return("", nil, errors.New("invalid table"))
+
+		plan, err := executor.Explain(context.Background(), filter, nil, records)
+
+		assert.Nil(t, plan)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid table")
+		db.AssertNotCalled(t, "QueryRowContext")
+	})
+
+	t.Run("missing values", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		filter := &sql.Filter{Condition: sql.NewCondition("name", sql.EQ, sql.NewIndexedValue(0))}
+		parser.On("ParseExplainQuery", filter, records).Return("EXPLAIN FORMAT=JSON SELECT id FROM users WHERE name = ?", []sql.Param{{Index: 0}}, nil)
+
+		plan, err := executor.Explain(context.Background(), filter, nil, records)
+
+		assert.Nil(t, plan)
+		assert.Error(t, err)
+		db.AssertNotCalled(t, "QueryRowContext")
+	})
+
+	t.Run("invalid transaction", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		parser.On("ParseExplainQuery", (*sql.Filter)(nil), records).Return("EXPLAIN FORMAT=JSON SELECT id FROM users WHERE 1", nil, nil)
+
+		plan, err := executor.Explain(context.Background(), nil, nil, records, sql.Options{Transaction: mocks.NewTransaction(t)})
+
+		assert.Nil(t, plan)
+		assert.EqualError(t, err, "invalid transaction object")
+	})
+}
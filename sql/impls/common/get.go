@@ -10,29 +10,32 @@ import (
 )
 
 func (c *Executor) Get(ctx context.Context, filter *sql.Filter, values []any, records sql.Records, options ...sql.Options) error {
-	opt := sql.GetOptions(options...)
-	var err error
-	var filterIndexes []int
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Read)
+	defer cancel()
+	if filter != nil {
+		if err := validateLock(filter.Lock, opt); err != nil {
+			return err
+		}
+	}
+	db, prepare, done := c.reader(opt)
+	defer done()
+	var params []sql.Param
 	var rows sql.Rows
 	if opt.PreparedName != "" {
-		var stmt *internal.PreparedStatement
-		var ok bool
 		// if prepared statement is not found, parse the query and create a new prepared statement
-		{
-			if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
-				var query string
-				query, filterIndexes, err = c.parser.ParseGetByFilterQuery(filter, records)
-				if err != nil {
-					return internal.HandleError(err)
-				}
+		stmt, err := prepare(ctx, opt.PreparedName, func() (string, []sql.Param, error) {
+			query, params, err := c.parser.ParseGetByFilterQuery(filter, records)
+			if err == nil {
 				logger.Debug(ctx, "GetByFilter query: %s", query)
-				ps, err := c.db.PrepareContext(ctx, query)
-				if err != nil {
-					return internal.HandleError(err)
-				}
-				stmt = internal.NewPreparedStatement(ps).WithValueIndexes(filterIndexes).WithQuery(query)
-				c.preparedStatements.Add(opt.PreparedName, stmt)
 			}
+			return query, params, err
+		})
+		if err != nil {
+			return internal.HandleContextError(ctx, err)
 		}
 		// if transaction is provided, use it to execute the query
 		if opt.Transaction != nil {
@@ -41,16 +44,18 @@ func (c *Executor) Get(ctx context.Context, filter *sql.Filter, values []any, re
 			if err != nil {
 				return err
 			}
-			rows, err = txn.QueryContext(ctx, stmt.GetQuery(), sql.GetValues(stmt.GetValueIndexes(), values)...)
+			txStmt := txn.StmtContext(ctx, stmt.GetStatement())
+			defer txStmt.Close()
+			rows, err = txStmt.QueryContext(ctx, sql.GetParamValues(stmt.GetParams(), values)...)
 		} else {
-			rows, err = stmt.GetStatement().QueryContext(ctx, sql.GetValues(stmt.GetValueIndexes(), values)...)
+			rows, err = stmt.GetStatement().QueryContext(ctx, sql.GetParamValues(stmt.GetParams(), values)...)
 		}
 	} else {
 		// if prepared statement is not provided, parse the query and execute it
 		var query string
-		query, filterIndexes, err = c.parser.ParseGetByFilterQuery(filter, records)
+		query, params, err = c.parser.ParseGetByFilterQuery(filter, records)
 		if err != nil {
-			return internal.HandleError(err)
+			return internal.HandleContextError(ctx, err)
 		}
 		logger.Debug(ctx, "GetByFilter query: %s", query)
 		// if transaction is provided, use it to execute the query
@@ -60,40 +65,42 @@ func (c *Executor) Get(ctx context.Context, filter *sql.Filter, values []any, re
 			if err != nil {
 				return err
 			}
-			rows, err = txn.QueryContext(ctx, query, sql.GetValues(filterIndexes, values)...)
+			rows, err = txn.QueryContext(ctx, query, sql.GetParamValues(params, values)...)
 		} else {
-			rows, err = c.db.QueryContext(ctx, query, sql.GetValues(filterIndexes, values)...)
+			rows, err = db.QueryContext(ctx, query, sql.GetParamValues(params, values)...)
 		}
 	}
 	if err != nil {
-		return internal.HandleError(err)
+		return internal.HandleContextError(ctx, err)
 	}
-	return internal.HandleError(records.Scan(rows))
+	return internal.HandleContextError(ctx, records.Scan(rows))
 }
 
 func (c *Executor) GetByID(ctx context.Context, record sql.Record, options ...sql.Options) error {
-	opt := sql.GetOptions(options...)
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Read)
+	defer cancel()
+	if err := validateLock(opt.Lock, opt); err != nil {
+		return err
+	}
+	db, prepare, done := c.reader(opt)
+	defer done()
 	var row *driver.Row
-	var err error
 	var query string
 	if opt.PreparedName != "" {
-		var stmt *internal.PreparedStatement
-		var ok bool
 		// if prepared statement is not found, parse the query and create a new prepared statement
-		{
-			if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
-				query, err = c.parser.ParseGetByIDQuery(record)
-				if err != nil {
-					return internal.HandleError(err)
-				}
+		stmt, err := prepare(ctx, opt.PreparedName, func() (string, []sql.Param, error) {
+			query, err := c.parser.ParseGetByIDQuery(record, opt.Lock)
+			if err == nil {
 				logger.Debug(ctx, "GetByID query: %s", query)
-				ps, err := c.db.PrepareContext(ctx, query)
-				if err != nil {
-					return internal.HandleError(err)
-				}
-				stmt = internal.NewPreparedStatement(ps).WithQuery(query)
-				c.preparedStatements.Add(opt.PreparedName, stmt)
 			}
+			return query, nil, err
+		})
+		if err != nil {
+			return internal.HandleContextError(ctx, err)
 		}
 		// if transaction is provided, use it to execute the query
 		if opt.Transaction != nil {
@@ -102,14 +109,16 @@ func (c *Executor) GetByID(ctx context.Context, record sql.Record, options ...sq
 			if err != nil {
 				return err
 			}
-			row = txn.QueryRowContext(ctx, stmt.GetQuery(), record.ID())
+			txStmt := txn.StmtContext(ctx, stmt.GetStatement())
+			defer txStmt.Close()
+			row = txStmt.QueryRowContext(ctx, record.ID())
 		} else {
 			row = stmt.GetStatement().QueryRowContext(ctx, record.ID())
 		}
 	} else {
-		query, err = c.parser.ParseGetByIDQuery(record)
+		query, err = c.parser.ParseGetByIDQuery(record, opt.Lock)
 		if err != nil {
-			return internal.HandleError(err)
+			return internal.HandleContextError(ctx, err)
 		}
 		logger.Debug(ctx, "GetByID query: %s", query)
 		// if transaction is provided, use it to execute the query
@@ -121,11 +130,26 @@ func (c *Executor) GetByID(ctx context.Context, record sql.Record, options ...sq
 			}
 			row = txn.QueryRowContext(ctx, query, record.ID())
 		} else {
-			row = c.db.QueryRowContext(ctx, query, record.ID())
+			row = db.QueryRowContext(ctx, query, record.ID())
 		}
 	}
 	if row.Err() != nil {
-		return internal.HandleError(row.Err())
+		return internal.HandleContextError(ctx, row.Err())
+	}
+	return internal.HandleContextError(ctx, record.Scan(row))
+}
+
+// validateLock checks that a locking read runs within a transaction.
+// Outside a transaction the locks would be released as soon as the query returns.
+func validateLock(lock sql.LockMode, opt sql.Options) error {
+	if lock == sql.NoLock {
+		return nil
+	}
+	if lock.String() == "" {
+		return sql.NewInvalidQueryError("invalid lock mode: %d", lock)
+	}
+	if opt.Transaction == nil {
+		return sql.NewInvalidQueryError("invalid lock mode: %s requires a transaction, set Options.Transaction", lock.String())
 	}
-	return internal.HandleError(record.Scan(row))
+	return nil
 }
@@ -65,7 +65,7 @@ func TestExecutor_Get(t *testing.T) {
 		values := []any{1}
 		records := &records.Users{}
 		expectedQuery := "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE is_active = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseGetByFilterQuery", filter, records).Return(expectedQuery, expectedValueIndexes, nil)
@@ -101,7 +101,7 @@ func TestExecutor_Get(t *testing.T) {
 		values := []any{"%@example.com"}
 		records := &records.Users{}
 		expectedQuery := "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE email LIKE ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseGetByFilterQuery", filter, records).Return(expectedQuery, expectedValueIndexes, nil)
@@ -137,7 +137,7 @@ func TestExecutor_Get(t *testing.T) {
 		values := []any{1}
 		records := &records.Users{}
 		expectedQuery := "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE is_active = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseGetByFilterQuery", filter, records).Return(expectedQuery, expectedValueIndexes, nil)
@@ -169,7 +169,7 @@ func TestExecutor_Get(t *testing.T) {
 		values := []any{}
 		records := &records.Users{}
 		expectedQuery := "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users"
-		expectedValueIndexes := []int{}
+		expectedValueIndexes := []sqlpkg.Param{}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseGetByFilterQuery", (*sqlpkg.Filter)(nil), records).Return(expectedQuery, expectedValueIndexes, nil)
@@ -218,7 +218,7 @@ func TestExecutor_Get(t *testing.T) {
 		values := []any{1, int64(1640995200000), int64(0)}
 		records := &records.Users{}
 		expectedQuery := "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE (is_active = ? AND created_at > ?) ORDER BY created_at DESC LIMIT 10 OFFSET ?"
-		expectedValueIndexes := []int{0, 1, 2}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 1}, {Index: 2}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseGetByFilterQuery", filter, records).Return(expectedQuery, expectedValueIndexes, nil)
@@ -254,7 +254,7 @@ func TestExecutor_Get(t *testing.T) {
 		values := []any{}
 		records := &records.Users{}
 		expectedQuery := "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE is_active = 1"
-		expectedValueIndexes := []int{}
+		expectedValueIndexes := []sqlpkg.Param{}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseGetByFilterQuery", filter, records).Return(expectedQuery, expectedValueIndexes, nil)
@@ -321,7 +321,7 @@ func BenchmarkExecutor_Get(b *testing.B) {
 	values := []any{1}
 	records := &records.Users{}
 	expectedQuery := "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE is_active = ?"
-	expectedValueIndexes := []int{0}
+	expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 	// Mock parser to return the expected query and value indexes
 	parser.On("ParseGetByFilterQuery", filter, records).Return(expectedQuery, expectedValueIndexes, nil)
@@ -334,3 +334,79 @@ func BenchmarkExecutor_Get(b *testing.B) {
 		_ = executor.Get(context.Background(), filter, values, records)
 	}
 }
+
+func TestExecutor_LockingReads(t *testing.T) {
+	t.Run("get with lock outside transaction", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		err := executor.Get(context.Background(), &sqlpkg.Filter{Lock: sqlpkg.ForUpdateSkipLocked}, nil, records)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "FOR UPDATE SKIP LOCKED requires a transaction")
+		parser.AssertNotCalled(t, "ParseGetByFilterQuery")
+	})
+
+	t.Run("get by id with lock outside transaction", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		record := mocks.NewRecord(t)
+
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		err := executor.GetByID(context.Background(), record, sqlpkg.Options{Lock: sqlpkg.ForUpdate})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "FOR UPDATE requires a transaction")
+		parser.AssertNotCalled(t, "ParseGetByIDQuery")
+	})
+
+	t.Run("get by id with invalid lock", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		record := mocks.NewRecord(t)
+
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		err := executor.GetByID(context.Background(), record, sqlpkg.Options{Lock: sqlpkg.LockMode(100), Transaction: mocks.NewTransaction(t)})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid lock mode")
+	})
+
+	t.Run("get with lock in transaction", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		filter := &sqlpkg.Filter{Lock: sqlpkg.ForUpdate}
+		parser.On("ParseGetByFilterQuery", filter, records).Return("SELECT id FROM users WHERE 1 FOR UPDATE", nil, nil)
+
+		// the mocked transaction is not a *driver.Tx, so execution fails after validation
+		err := executor.Get(context.Background(), filter, nil, records, sqlpkg.Options{Transaction: mocks.NewTransaction(t)})
+
+		assert.EqualError(t, err, "invalid transaction object")
+		parser.AssertExpectations(t)
+	})
+}
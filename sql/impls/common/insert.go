@@ -4,6 +4,7 @@ import (
 	"context"
 	driver "database/sql"
 	"errors"
+	"slices"
 
 	"github.com/gofreego/database/sql"
 	"github.com/gofreego/database/sql/internal"
@@ -12,147 +13,273 @@ import (
 /*
 Insert inserts a record into the database.
 Returns an error if any.
-It will set the ID of the record to the last inserted ID.
+It will set the ID of the record to the ID returned by the insert query if the parser supports it, otherwise to the last inserted ID.
+The ID is read from the result of the insert itself, so concurrent inserts cannot get the ID of each other's record.
+Returns sql.ErrNoRecordInserted if the record is not inserted.
+With Options.OnConflictDoNothing a record conflicting with an existing row is skipped without an error, its ID is not set.
 */
 func (c *Executor) Insert(ctx context.Context, record sql.Record, options ...sql.Options) error {
-	opt := sql.GetOptions(options...)
-	var err error
-	var res driver.Result
+	if record == nil {
+		return sql.NewInvalidQueryError("record is nil")
+	}
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
+	records := []sql.Record{record}
 	var query string
 	var values []any
+	var prepared *driver.Stmt
 	if opt.PreparedName != "" {
 		var stmt *internal.PreparedStatement
 		var ok bool
 		// if prepared statement is not found, parse the query and create a new prepared statement
 		{
 			if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
-				query, _, err = c.parser.ParseInsertQuery(record)
+				query, _, err = parseInsertQuery(c.parser, records, opt)
 				if err != nil {
-					return internal.HandleError(err)
+					return internal.HandleContextError(ctx, err)
 				}
 				ps, err := c.db.PrepareContext(ctx, query)
 				if err != nil {
-					return internal.HandleError(err)
+					return internal.HandleContextError(ctx, err)
 				}
 				stmt = internal.NewPreparedStatement(ps).WithQuery(query)
-				c.preparedStatements[opt.PreparedName] = stmt
-			}
-		}
-		// if transaction is provided, use it to execute the query
-		if opt.Transaction != nil {
-			var txn *driver.Tx
-			txn, err = internal.GetTransaction(opt.Transaction)
-			if err != nil {
-				return err
+				c.preparedStatements.Add(opt.PreparedName, stmt)
 			}
-			res, err = txn.ExecContext(ctx, stmt.GetQuery(), record.Values()...)
-		} else {
-			res, err = stmt.GetStatement().ExecContext(ctx, record.Values()...)
 		}
+		prepared, query, values = stmt.GetStatement(), stmt.GetQuery(), record.Values()
 	} else {
-		query, values, err = c.parser.ParseInsertQuery(record)
+		query, values, err = parseInsertQuery(c.parser, records, opt)
 		if err != nil {
-			return internal.HandleError(err)
+			return internal.HandleContextError(ctx, err)
 		}
-		// if transaction is provided, use it to execute the query
-		if opt.Transaction != nil {
-			var txn *driver.Tx
-			txn, err = internal.GetTransaction(opt.Transaction)
-			if err != nil {
-				return err
-			}
-			res, err = txn.ExecContext(ctx, query, values...)
-		} else {
-			res, err = c.db.ExecContext(ctx, query, values...)
+	}
+	// if transaction is provided, use it to execute the query
+	var txn *driver.Tx
+	if opt.Transaction != nil {
+		txn, err = internal.GetTransaction(opt.Transaction)
+		if err != nil {
+			return err
 		}
 	}
+	inserted, err := c.execInsert(ctx, txn, prepared, query, values, records)
 	if err != nil {
-		return internal.HandleError(err)
+		return err
 	}
-
-	id, err := res.LastInsertId()
-	if err != nil {
-		return internal.HandleError(err)
+	if inserted == 0 && !opt.OnConflictDoNothing {
+		return sql.ErrNoRecordInserted
 	}
-	record.SetID(id)
 	return nil
 }
 
 /*
-InsertMany inserts multiple records into the database.
-Returns the number of rows affected and an error if any.
+InsertMany inserts multiple records into the database and sets their IDs, in the order of the records.
+The IDs are returned by the insert query if the parser supports it, otherwise they are consecutive from LastInsertId,
+as mysql allocates consecutive IDs to the rows of a multi-row insert when auto_increment_increment is 1.
+The records are inserted in batches within the parser's parameter and row limits, see Options.BatchSize.
+With Options.Atomic the batches run in one transaction, unless Options.Transaction is set.
+Returns the number of rows affected by all the batches and an error if any,
+if a batch fails outside a transaction the rows affected by the batches already executed are returned with the error.
 Returns 0, nil if no records are provided.
 Returns 0, sql.ErrNoRecordInserted if no records are inserted.
-Query will not be prepared because of variable length of records, if you want to prepare the query, use Insert instead.
+With Options.OnConflictDoNothing the records conflicting with existing rows are skipped, only the inserted rows are counted
+and no error is returned if every record is skipped. The IDs of a batch are only set if none of its records is skipped.
+With Options.PreparedName the records are inserted in a single statement prepared for that number of records.
 */
 func (c *Executor) InsertMany(ctx context.Context, records []sql.Record, options ...sql.Options) (int64, error) {
 	// if no records to insert
 	if len(records) == 0 {
 		return 0, nil
 	}
-	opt := sql.GetOptions(options...)
-	var err error
-	var res driver.Result
-	var query string
-	var values []any
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
+	var rowsAffected int64
 	if opt.PreparedName != "" {
-		var stmt *internal.PreparedStatement
-		var ok bool
-		// if prepared statement is not found, parse the query and create a new prepared statement
-		{
-			if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
-				query, _, err = c.parser.ParseInsertQuery(records...)
-				if err != nil {
-					return 0, internal.HandleError(err)
-				}
-				ps, err := c.db.PrepareContext(ctx, query)
-				if err != nil {
-					return 0, internal.HandleError(err)
-				}
-				stmt = internal.NewPreparedStatement(ps).WithRecords(len(records)).WithQuery(query)
-				c.preparedStatements.Add(opt.PreparedName, stmt)
-			}
-			if stmt.GetNoOfRecords() != len(records) {
-				return 0, errors.New("for insert many prepared statement, number of records should match with first inserted records")
+		rowsAffected, err = c.insertPrepared(ctx, records, opt)
+	} else {
+		rowsAffected, err = c.insertBatches(ctx, records, opt)
+	}
+	if err != nil {
+		return rowsAffected, err
+	}
+	if rowsAffected == 0 && !opt.OnConflictDoNothing {
+		return 0, sql.ErrNoRecordInserted
+	}
+	return rowsAffected, nil
+}
+
+// insertPrepared inserts the records with the prepared statement, the statement is prepared for the number of records of its first use.
+func (c *Executor) insertPrepared(ctx context.Context, records []sql.Record, opt sql.Options) (int64, error) {
+	var err error
+	var stmt *internal.PreparedStatement
+	var ok bool
+	// if prepared statement is not found, parse the query and create a new prepared statement
+	{
+		if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
+			query, _, err := parseInsertQuery(c.parser, records, opt)
+			if err != nil {
+				return 0, internal.HandleContextError(ctx, err)
 			}
-		}
-		// if transaction is provided, use it to execute the query
-		if opt.Transaction != nil {
-			var txn *driver.Tx
-			txn, err = internal.GetTransaction(opt.Transaction)
+			ps, err := c.db.PrepareContext(ctx, query)
 			if err != nil {
-				return 0, err
+				return 0, internal.HandleContextError(ctx, err)
 			}
-			res, err = txn.ExecContext(ctx, stmt.GetQuery(), getValues(records)...)
-		} else {
-			res, err = stmt.GetStatement().ExecContext(ctx, getValues(records)...)
+			stmt = internal.NewPreparedStatement(ps).WithRecords(len(records)).WithQuery(query)
+			c.preparedStatements.Add(opt.PreparedName, stmt)
 		}
-	} else {
-		query, values, err = c.parser.ParseInsertQuery(records...)
+		if stmt.GetNoOfRecords() != len(records) {
+			return 0, errors.New("for insert many prepared statement, number of records should match with first inserted records")
+		}
+	}
+	// if transaction is provided, use it to execute the query
+	var txn *driver.Tx
+	if opt.Transaction != nil {
+		txn, err = internal.GetTransaction(opt.Transaction)
 		if err != nil {
-			return 0, internal.HandleError(err)
+			return 0, err
 		}
-		// if transaction is provided, use it to execute the query
-		if opt.Transaction != nil {
-			var txn *driver.Tx
-			txn, err = internal.GetTransaction(opt.Transaction)
-			if err != nil {
-				return 0, err
+	}
+	return c.execInsert(ctx, txn, stmt.GetStatement(), stmt.GetQuery(), getValues(records), records)
+}
+
+// insertBatches inserts the records in batches, in the transaction of the options if provided.
+// With Options.Atomic and more than one batch, the batches run in a transaction of their own.
+func (c *Executor) insertBatches(ctx context.Context, records []sql.Record, opt sql.Options) (int64, error) {
+	size := c.batchSize(opt, len(records[0].Values()))
+	if opt.Transaction != nil {
+		txn, err := internal.GetTransaction(opt.Transaction)
+		if err != nil {
+			return 0, err
+		}
+		return c.execInsertBatches(ctx, txn, records, size, opt)
+	}
+	if !opt.Atomic || len(records) <= size {
+		return c.execInsertBatches(ctx, nil, records, size, opt)
+	}
+	txn, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, internal.HandleContextError(ctx, err)
+	}
+	rowsAffected, err := c.execInsertBatches(ctx, txn, records, size, opt)
+	if err != nil {
+		// nothing is inserted once the transaction is rolled back
+		_ = txn.Rollback()
+		return 0, err
+	}
+	if err := txn.Commit(); err != nil {
+		return 0, internal.HandleContextError(ctx, err)
+	}
+	return rowsAffected, nil
+}
+
+// execInsertBatches executes the insert query of every batch, in the transaction if it is not nil.
+func (c *Executor) execInsertBatches(ctx context.Context, txn *driver.Tx, records []sql.Record, size int, opt sql.Options) (int64, error) {
+	var total int64
+	for batch := range slices.Chunk(records, size) {
+		query, values, err := parseInsertQuery(c.parser, batch, opt)
+		if err != nil {
+			return total, internal.HandleContextError(ctx, err)
+		}
+		rowsAffected, err := c.execInsert(ctx, txn, nil, query, values, batch)
+		total += rowsAffected
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// parseInsertQuery returns the insert query of the records, the query returns the inserted IDs if the parser supports it.
+// With Options.OnConflictDoNothing the query skips the records conflicting with existing rows.
+func parseInsertQuery(parser Parser, records []sql.Record, opt sql.Options) (string, []any, error) {
+	if opt.OnConflictDoNothing {
+		return parser.ParseInsertIgnoreQuery(opt.Conflict, records...)
+	}
+	if parser.InsertReturnsIDs() {
+		return parser.ParseInsertReturningIDsQuery(records...)
+	}
+	return parser.ParseInsertQuery(records...)
+}
+
+/*
+execInsert runs the insert query of the records and sets their IDs.
+The prepared statement is used if it is not nil, rebound to the transaction if the transaction is not nil,
+otherwise the query runs in the transaction if it is not nil, otherwise on the database.
+Returns the number of rows inserted.
+*/
+func (c *Executor) execInsert(ctx context.Context, txn *driver.Tx, stmt *driver.Stmt, query string, values []any, records []sql.Record) (int64, error) {
+	// the statement prepared on the database is bound to the transaction, it is not prepared again on the same connection
+	if txn != nil && stmt != nil {
+		stmt = txn.StmtContext(ctx, stmt)
+		defer stmt.Close()
+	}
+	if c.parser.InsertReturnsIDs() {
+		var rows *driver.Rows
+		var err error
+		switch {
+		case stmt != nil:
+			rows, err = stmt.QueryContext(ctx, values...)
+		case txn != nil:
+			rows, err = txn.QueryContext(ctx, query, values...)
+		default:
+			rows, err = c.db.QueryContext(ctx, query, values...)
+		}
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		defer rows.Close()
+		ids := make([]int64, 0, len(records))
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				return int64(len(ids)), internal.HandleContextError(ctx, err)
+			}
+			ids = append(ids, id)
+		}
+		if err := rows.Err(); err != nil {
+			return int64(len(ids)), internal.HandleContextError(ctx, err)
+		}
+		// the IDs are returned in the order of the records, they can only be matched to the records if every record was inserted
+		if len(ids) == len(records) {
+			for i, record := range records {
+				record.SetID(ids[i])
 			}
-			res, err = txn.ExecContext(ctx, query, values...)
-		} else {
-			res, err = c.db.ExecContext(ctx, query, values...)
 		}
+		return int64(len(ids)), nil
+	}
+	var res driver.Result
+	var err error
+	switch {
+	case stmt != nil:
+		res, err = stmt.ExecContext(ctx, values...)
+	case txn != nil:
+		res, err = txn.ExecContext(ctx, query, values...)
+	default:
+		res, err = c.db.ExecContext(ctx, query, values...)
 	}
 	if err != nil {
-		return 0, internal.HandleError(err)
+		return 0, internal.HandleContextError(ctx, err)
 	}
 	rowsAffected, err := res.RowsAffected()
 	if err != nil {
-		return 0, internal.HandleError(err)
+		return 0, internal.HandleContextError(ctx, err)
 	}
-	if rowsAffected == 0 {
-		return 0, sql.ErrNoRecordInserted
+	// LastInsertId is the ID of the first row, the IDs can only be matched to the records if every record was inserted
+	if rowsAffected == int64(len(records)) {
+		id, err := res.LastInsertId()
+		if err != nil {
+			return rowsAffected, internal.HandleContextError(ctx, err)
+		}
+		for i, record := range records {
+			record.SetID(id + int64(i))
+		}
 	}
 	return rowsAffected, nil
 }
@@ -3,9 +3,11 @@ package common
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 
 	"github.com/gofreego/database/mocks"
+	sqlpkg "github.com/gofreego/database/sql"
 	"github.com/gofreego/database/sql/internal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -15,6 +17,7 @@ func TestExecutor_Insert(t *testing.T) {
 	t.Run("successful insert with direct query", func(t *testing.T) {
 		db := mocks.NewDB(t)
 		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
 		record := mocks.NewRecord(t)
 
 		executor := &Executor{
@@ -49,6 +52,7 @@ func TestExecutor_Insert(t *testing.T) {
 	t.Run("parser error", func(t *testing.T) {
 		db := mocks.NewDB(t)
 		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
 		record := mocks.NewRecord(t)
 
 		executor := &Executor{
@@ -75,6 +79,7 @@ func TestExecutor_Insert(t *testing.T) {
 	t.Run("database execution error", func(t *testing.T) {
 		db := mocks.NewDB(t)
 		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
 		record := mocks.NewRecord(t)
 
 		executor := &Executor{
@@ -109,6 +114,7 @@ func TestExecutor_Insert(t *testing.T) {
 	t.Run("LastInsertId error", func(t *testing.T) {
 		db := mocks.NewDB(t)
 		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
 		record := mocks.NewRecord(t)
 
 		executor := &Executor{
@@ -144,6 +150,7 @@ func TestExecutor_Insert(t *testing.T) {
 	t.Run("empty values", func(t *testing.T) {
 		db := mocks.NewDB(t)
 		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
 		record := mocks.NewRecord(t)
 
 		executor := &Executor{
@@ -174,11 +181,392 @@ func TestExecutor_Insert(t *testing.T) {
 		db.AssertExpectations(t)
 		parser.AssertExpectations(t)
 	})
+	t.Run("concurrent inserts get their own ids", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		// every insert returns the id of its own row, it must be set on its own record
+		records := make([]*mocks.Record, 20)
+		for i := range records {
+			record := mocks.NewRecord(t)
+			record.On("SetID", int64(100+i)).Return().Once()
+			// records are matched by identity, comparing them would read the mocks the other goroutines write
+			parser.On("ParseInsertQuery", mock.MatchedBy(func(r *mocks.Record) bool { return r == record })).Return("insert", []any{i}, nil)
+			db.On("ExecContext", mock.Anything, "insert", i).Return(&mockResult{rowsAffected: 1, lastInsertId: int64(100 + i)}, nil)
+			records[i] = record
+		}
+
+		var wg sync.WaitGroup
+		for _, record := range records {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, executor.Insert(context.Background(), record))
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("no record inserted", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		record := mocks.NewRecord(t)
+
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		parser.On("ParseInsertQuery", record).Return("insert", []any{"Test User"}, nil)
+		db.On("ExecContext", mock.Anything, "insert", "Test User").Return(&mockResult{rowsAffected: 0}, nil)
+
+		err := executor.Insert(context.Background(), record)
+
+		assert.ErrorIs(t, err, sqlpkg.ErrNoRecordInserted)
+		record.AssertNotCalled(t, "SetID")
+	})
+
+	t.Run("conflicting record is skipped with on conflict do nothing", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		record := mocks.NewRecord(t)
+
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		parser.On("ParseInsertIgnoreQuery", (*sqlpkg.Conflict)(nil), record).Return("insert ignore", []any{"Test User"}, nil)
+		db.On("ExecContext", mock.Anything, "insert ignore", "Test User").Return(&mockResult{rowsAffected: 0}, nil)
+
+		err := executor.Insert(context.Background(), record, sqlpkg.Options{OnConflictDoNothing: true})
+
+		assert.NoError(t, err)
+		record.AssertNotCalled(t, "SetID")
+	})
+
+	t.Run("returning query error", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		record := mocks.NewRecord(t)
+
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		parser.On("InsertReturnsIDs").Return(true)
+		parser.On("ParseInsertReturningIDsQuery", record).Return("insert returning", []any{"Test User"}, nil)
+		db.On("QueryContext", mock.Anything, "insert returning", "Test User").Return(nil, errors.New("connection reset"))
+
+		err := executor.Insert(context.Background(), record)
+
+		assert.Error(t, err)
+		record.AssertNotCalled(t, "SetID")
+	})
+
+	t.Run("nil record", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		err := executor.Insert(context.Background(), nil)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestExecutor_InsertMany(t *testing.T) {
+	newRecords := func(t *testing.T, n int) []sqlpkg.Record {
+		records := make([]sqlpkg.Record, n)
+		for i := range records {
+			record := mocks.NewRecord(t)
+			// distinct values tell the mock records apart in the parser expectations
+			record.On("Values").Return([]any{"name", i}).Maybe()
+			record.On("SetID", mock.Anything).Return().Maybe()
+			records[i] = record
+		}
+		return records
+	}
+
+	t.Run("records are inserted in batches within the parser limits", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 5)
+
+		// 2 parameters for every record, 5 parameters fit 2 records
+		parser.On("MaxParams").Return(5)
+		parser.On("MaxRows").Return(0)
+		parser.On("ParseInsertQuery", records[0], records[1]).Return("batch 1", []any{1}, nil)
+		parser.On("ParseInsertQuery", records[2], records[3]).Return("batch 2", []any{2}, nil)
+		parser.On("ParseInsertQuery", records[4]).Return("batch 3", []any{3}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 2, lastInsertId: 11}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2", 2).Return(&mockResult{rowsAffected: 2, lastInsertId: 13}, nil)
+		db.On("ExecContext", mock.Anything, "batch 3", 3).Return(&mockResult{rowsAffected: 1, lastInsertId: 20}, nil)
+
+		rowsAffected, err := executor.InsertMany(context.Background(), records)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), rowsAffected)
+		// the IDs of a batch are consecutive from its last insert id
+		for i, id := range []int64{11, 12, 13, 14, 20} {
+			records[i].(*mocks.Record).AssertCalled(t, "SetID", id)
+		}
+	})
+
+	t.Run("batch size is limited by the rows limit", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 3)
+
+		parser.On("MaxParams").Return(2100)
+		parser.On("MaxRows").Return(2)
+		parser.On("ParseInsertQuery", records[0], records[1]).Return("batch 1", []any{1}, nil)
+		parser.On("ParseInsertQuery", records[2]).Return("batch 2", []any{2}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 2}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2", 2).Return(&mockResult{rowsAffected: 1}, nil)
+
+		rowsAffected, err := executor.InsertMany(context.Background(), records)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), rowsAffected)
+	})
+
+	t.Run("batch size option overrides the parser limits", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 3)
+
+		parser.On("ParseInsertQuery", records[0]).Return("batch 1", []any{1}, nil)
+		parser.On("ParseInsertQuery", records[1]).Return("batch 2", []any{2}, nil)
+		parser.On("ParseInsertQuery", records[2]).Return("batch 3", []any{3}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2", 2).Return(&mockResult{rowsAffected: 1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 3", 3).Return(&mockResult{rowsAffected: 1}, nil)
+
+		rowsAffected, err := executor.InsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), rowsAffected)
+	})
+
+	t.Run("failed batch returns the rows affected by the executed batches", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 3)
+
+		parser.On("ParseInsertQuery", records[0]).Return("batch 1", []any{1}, nil)
+		parser.On("ParseInsertQuery", records[1]).Return("batch 2", []any{2}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2", 2).Return(nil, errors.New("duplicate key"))
+
+		rowsAffected, err := executor.InsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 1})
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(1), rowsAffected)
+	})
+
+	t.Run("ids are not set if some records are not inserted", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 2)
+
+		parser.On("ParseInsertQuery", records[0], records[1]).Return("batch 1", []any{1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 1, lastInsertId: 11}, nil)
+
+		rowsAffected, err := executor.InsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), rowsAffected)
+		for _, record := range records {
+			record.(*mocks.Record).AssertNotCalled(t, "SetID", mock.Anything)
+		}
+	})
+
+	t.Run("returned ids are parsed with the returning query", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 2)
+
+		parser.On("InsertReturnsIDs").Return(true)
+		parser.On("ParseInsertReturningIDsQuery", records[0], records[1]).Return("batch 1", []any{1}, nil)
+		db.On("QueryContext", mock.Anything, "batch 1", 1).Return(nil, errors.New("database error"))
+
+		rowsAffected, err := executor.InsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 2})
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), rowsAffected)
+	})
+
+	t.Run("atomic insert fails if the transaction cannot begin", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 2)
+
+		db.On("BeginTx", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+		rowsAffected, err := executor.InsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 1, Atomic: true})
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), rowsAffected)
+	})
+
+	t.Run("atomic insert of a single batch runs without a transaction", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 2)
+
+		parser.On("ParseInsertQuery", records[0], records[1]).Return("batch 1", []any{1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 2}, nil)
+
+		rowsAffected, err := executor.InsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 2, Atomic: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), rowsAffected)
+	})
+
+	t.Run("no record inserted", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 1)
+
+		parser.On("ParseInsertQuery", records[0]).Return("batch 1", []any{1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 0}, nil)
+
+		_, err := executor.InsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 1})
+
+		assert.ErrorIs(t, err, sqlpkg.ErrNoRecordInserted)
+	})
+
+	t.Run("only the inserted rows are counted with on conflict do nothing", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 3)
+		conflict := &sqlpkg.Conflict{Columns: []string{"email"}}
+
+		parser.On("ParseInsertIgnoreQuery", conflict, records[0], records[1]).Return("batch 1", []any{1}, nil)
+		parser.On("ParseInsertIgnoreQuery", conflict, records[2]).Return("batch 2", []any{2}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 1, lastInsertId: 11}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2", 2).Return(&mockResult{rowsAffected: 1, lastInsertId: 12}, nil)
+
+		rowsAffected, err := executor.InsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 2, OnConflictDoNothing: true, Conflict: conflict})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), rowsAffected)
+		// the IDs of the first batch cannot be matched to its records as one of them is skipped
+		records[0].(*mocks.Record).AssertNotCalled(t, "SetID", mock.Anything)
+		records[1].(*mocks.Record).AssertNotCalled(t, "SetID", mock.Anything)
+		records[2].(*mocks.Record).AssertCalled(t, "SetID", int64(12))
+	})
+
+	t.Run("every record skipped with on conflict do nothing", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("InsertReturnsIDs").Return(false).Maybe()
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 2)
+
+		parser.On("ParseInsertIgnoreQuery", (*sqlpkg.Conflict)(nil), records[0], records[1]).Return("batch 1", []any{1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 0}, nil)
+
+		rowsAffected, err := executor.InsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 2, OnConflictDoNothing: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), rowsAffected)
+	})
+
+	t.Run("no records", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		rowsAffected, err := executor.InsertMany(context.Background(), nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), rowsAffected)
+	})
 }
 
 func BenchmarkExecutor_Insert(b *testing.B) {
 	db := mocks.NewDB(b)
 	parser := mocks.NewParser(b)
+	parser.On("InsertReturnsIDs").Return(false).Maybe()
 	record := mocks.NewRecord(b)
 
 	executor := &Executor{
@@ -198,7 +586,7 @@ func BenchmarkExecutor_Insert(b *testing.B) {
 	parser.On("ParseInsertQuery", record).Return(expectedQuery, expectedValues, nil)
 
 	// Mock database execution
-	mockResult := &mockResult{rowsAffected: 1}
+	mockResult := &mockResult{rowsAffected: 1, lastInsertId: 1}
 	db.On("ExecContext", mock.Anything, expectedQuery, "Bench User", "bench@example.com").Return(mockResult, nil)
 
 	b.ResetTimer()
@@ -6,6 +6,10 @@ import (
 	"github.com/gofreego/database/sql/internal"
 )
 
+// Ping pings the primary database, the replicas are pinged too and the ones whose ping fails are taken out of rotation
 func (c *Executor) Ping(ctx context.Context) error {
-	return internal.HandleError(c.db.PingContext(ctx))
+	if c.replicas != nil {
+		c.replicas.check(ctx)
+	}
+	return internal.HandleContextError(ctx, c.db.PingContext(ctx))
 }
@@ -101,7 +101,7 @@ func TestExecutor_Ping(t *testing.T) {
 		assert.Error(t, err)
 		assert.IsType(t, &sql.Error{}, err)
 		assert.Contains(t, err.Error(), "context deadline exceeded")
-		assert.Equal(t, sql.ErrUnknownDatabaseError, err.(*sql.Error).Code())
+		assert.Equal(t, sql.ErrCodeTimeout, err.(*sql.Error).Code())
 		db.AssertExpectations(t)
 	})
 
@@ -0,0 +1,192 @@
+package common
+
+import (
+	"slices"
+
+	"github.com/gofreego/database/sql"
+)
+
+// Renderer renders the queries generated by the parser without executing them.
+type Renderer struct {
+	parser Parser
+}
+
+func NewRenderer(parser Parser) *Renderer {
+	return &Renderer{
+		parser: parser,
+	}
+}
+
+// ToSQL returns the renderer for the executor's dialect.
+func (c *Executor) ToSQL() sql.Renderer {
+	return NewRenderer(c.parser)
+}
+
+func (r *Renderer) Dialect() sql.Dialect {
+	return r.parser.Dialect()
+}
+
+func (r *Renderer) Insert(records ...sql.Record) (*sql.Query, error) {
+	query, values, err := parseInsertQuery(r.parser, records, sql.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: values}, nil
+}
+
+func (r *Renderer) InsertIgnore(records []sql.Record, options ...sql.Options) (*sql.Query, error) {
+	opt := sql.Options{OnConflictDoNothing: true, Conflict: sql.GetOptions(options...).Conflict}
+	query, values, err := parseInsertQuery(r.parser, records, opt)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: values}, nil
+}
+
+func (r *Renderer) Upsert(record sql.Record, options ...sql.Options) (*sql.Query, error) {
+	query, values, err := r.parser.ParseUpsertQuery(sql.GetOptions(options...).Conflict, record)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: values}, nil
+}
+
+func (r *Renderer) UpsertMany(records []sql.Record, options ...sql.Options) (*sql.Query, error) {
+	query, values, err := r.parser.ParseUpsertQuery(sql.GetOptions(options...).Conflict, records...)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: values}, nil
+}
+
+func (r *Renderer) GetByID(record sql.Record, options ...sql.Options) (*sql.Query, error) {
+	query, err := r.parser.ParseGetByIDQuery(record, sql.GetOptions(options...).Lock)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: []any{record.ID()}}, nil
+}
+
+func (r *Renderer) Get(filter *sql.Filter, values []any, records sql.Records) (*sql.Query, error) {
+	query, params, err := r.parser.ParseGetByFilterQuery(filter, records)
+	if err != nil {
+		return nil, err
+	}
+	args, err := resolveValues(params, values)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: args}, nil
+}
+
+func (r *Renderer) UpdateByID(record sql.Record) (*sql.Query, error) {
+	query, err := r.parser.ParseUpdateByIDQuery(record)
+	if err != nil {
+		return nil, err
+	}
+	// the values of the record are not appended to in place, their slice can have room for the id
+	return &sql.Query{SQL: query, Args: append(slices.Clip(record.Values()), record.ID())}, nil
+}
+
+func (r *Renderer) UpdateManyByID(records ...sql.Record) (*sql.Query, error) {
+	query, values, err := r.parser.ParseUpdateManyByIDQuery(records...)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: values}, nil
+}
+
+func (r *Renderer) Update(table *sql.Table, updates *sql.Updates, condition *sql.Condition, values []any) (*sql.Query, error) {
+	query, params, err := r.parser.ParseUpdateQuery(table, updates, condition)
+	if err != nil {
+		return nil, err
+	}
+	args, err := resolveValues(params, values)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: args}, nil
+}
+
+func (r *Renderer) UpdateReturning(updates *sql.Updates, condition *sql.Condition, values []any, records sql.Records) (*sql.Query, error) {
+	query, params, err := r.parser.ParseUpdateReturningQuery(updates, condition, records)
+	if err != nil {
+		return nil, err
+	}
+	args, err := resolveValues(params, values)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: args}, nil
+}
+
+func (r *Renderer) SoftDeleteByID(record sql.Record) (*sql.Query, error) {
+	query, err := r.parser.ParseSoftDeleteByIDQuery(record.Table(), record)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: []any{record.ID()}}, nil
+}
+
+func (r *Renderer) SoftDelete(table *sql.Table, condition *sql.Condition, values []any) (*sql.Query, error) {
+	query, params, err := r.parser.ParseSoftDeleteQuery(table, condition)
+	if err != nil {
+		return nil, err
+	}
+	args, err := resolveValues(params, values)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: args}, nil
+}
+
+func (r *Renderer) DeleteByID(record sql.Record) (*sql.Query, error) {
+	query, err := r.parser.ParseDeleteByIDQuery(record)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: []any{record.ID()}}, nil
+}
+
+func (r *Renderer) Delete(table *sql.Table, condition *sql.Condition, values []any) (*sql.Query, error) {
+	query, params, err := r.parser.ParseDeleteQuery(table, condition)
+	if err != nil {
+		return nil, err
+	}
+	args, err := resolveValues(params, values)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: args}, nil
+}
+
+func (r *Renderer) DeleteReturning(condition *sql.Condition, values []any, records sql.Records) (*sql.Query, error) {
+	query, params, err := r.parser.ParseDeleteReturningQuery(condition, records)
+	if err != nil {
+		return nil, err
+	}
+	args, err := resolveValues(params, values)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: args}, nil
+}
+
+func (r *Renderer) RunSP(spName string, values []any) (*sql.Query, error) {
+	query, err := r.parser.ParseSPQuery(spName, values)
+	if err != nil {
+		return nil, err
+	}
+	return &sql.Query{SQL: query, Args: values}, nil
+}
+
+// resolveValues validates that the values slice has a value for every indexed param
+// and returns the arguments in placeholder order.
+func resolveValues(params []sql.Param, values []any) ([]any, error) {
+	for _, param := range params {
+		if !param.Fixed && param.Index >= len(values) {
+			return nil, sql.NewInvalidQueryError("invalid values: value for index %d not provided, got %d values", param.Index, len(values))
+		}
+	}
+	return sql.GetParamValues(params, values), nil
+}
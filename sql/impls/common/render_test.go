@@ -0,0 +1,182 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofreego/database/mocks"
+	"github.com/gofreego/database/sql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderer_Get(t *testing.T) {
+	t.Run("renders query and resolved args", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+		filter := &sql.Filter{
+			Condition: sql.NewCondition("id", sql.IN, sql.NewIndexedValue(1).WithCount(2)).
+				And(sql.NewCondition("name", sql.EQ, sql.NewIndexedValue(0))),
+		}
+		parser.On("ParseGetByFilterQuery", filter, records).Return("SELECT id, name FROM users WHERE (id IN (?, ?) AND name = ?)", []sql.Param{{Index: 1}, {Index: 0}}, nil)
+
+		query, err := NewRenderer(parser).Get(filter, []any{"john", []int64{1, 2}}, records)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT id, name FROM users WHERE (id IN (?, ?) AND name = ?)", query.SQL)
+		assert.Equal(t, []any{int64(1), int64(2), "john"}, query.Args)
+	})
+
+	t.Run("fixed values are bound as args", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+		filter := &sql.Filter{
+			Condition: sql.NewCondition("name", sql.LIKE, sql.NewValue("O'Brien%")).
+				And(sql.NewCondition("id", sql.EQ, sql.NewIndexedValue(0))),
+		}
+		parser.On("ParseGetByFilterQuery", filter, records).Return("SELECT id FROM users WHERE (name LIKE $1 AND id = $2)", []sql.Param{{Value: "O'Brien%", Fixed: true}, {Index: 0}}, nil)
+
+		query, err := NewRenderer(parser).Get(filter, []any{int64(7)}, records)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []any{"O'Brien%", int64(7)}, query.Args)
+	})
+
+	t.Run("missing values", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+		filter := &sql.Filter{Condition: sql.NewCondition("name", sql.EQ, sql.NewIndexedValue(1))}
+		parser.On("ParseGetByFilterQuery", filter, records).Return("SELECT id FROM users WHERE name = ?", []sql.Param{{Index: 1}}, nil)
+
+		query, err := NewRenderer(parser).Get(filter, []any{"john"}, records)
+
+		assert.Nil(t, query)
+		assert.Error(t, err)
+		var sqlErr *sql.Error
+		assert.True(t, errors.As(err, &sqlErr))
+		assert.True(t, sqlErr.IsQueryError())
+	})
+
+	t.Run("parser error", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+		parser.On("ParseGetByFilterQuery", (*sql.Filter)(nil), records).Return("", nil, errors.New("invalid table"))
+
+		query, err := NewRenderer(parser).Get(nil, nil, records)
+
+		assert.Nil(t, query)
+		assert.EqualError(t, err, "invalid table")
+	})
+}
+
+func TestRenderer_UpdateByID(t *testing.T) {
+	parser := mocks.NewParser(t)
+	record := mocks.NewRecord(t)
+	record.On("Values").Return([]any{"john", "john@example.com"})
+	record.On("ID").Return(int64(7))
+	parser.On("ParseUpdateByIDQuery", record).Return("UPDATE users SET name = ?, email = ? WHERE id = ?", nil)
+
+	query, err := NewRenderer(parser).UpdateByID(record)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "UPDATE users SET name = ?, email = ? WHERE id = ?", query.SQL)
+	assert.Equal(t, []any{"john", "john@example.com", int64(7)}, query.Args)
+
+	t.Run("values of the record are not overwritten", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		record := mocks.NewRecord(t)
+		values := make([]any, 2, 3)
+		values[0], values[1] = "john", "john@example.com"
+		backing := values[:3]
+		record.On("Values").Return(values)
+		record.On("ID").Return(int64(7))
+		parser.On("ParseUpdateByIDQuery", record).Return("UPDATE users SET name = ?, email = ? WHERE id = ?", nil)
+
+		query, err := NewRenderer(parser).UpdateByID(record)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []any{"john", "john@example.com", int64(7)}, query.Args)
+		assert.Nil(t, backing[2])
+	})
+}
+
+func TestRenderer_Update(t *testing.T) {
+	parser := mocks.NewParser(t)
+	table := sql.NewTable("users")
+	updates := sql.NewUpdates().Add("name", sql.NewIndexedValue(1))
+	condition := sql.NewCondition("id", sql.EQ, sql.NewIndexedValue(0))
+	parser.On("ParseUpdateQuery", table, updates, condition).Return("UPDATE users SET name = $1 WHERE id = $2", []sql.Param{{Index: 1}, {Index: 0}}, nil)
+
+	query, err := NewRenderer(parser).Update(table, updates, condition, []any{int64(7), "john"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "UPDATE users SET name = $1 WHERE id = $2", query.SQL)
+	assert.Equal(t, []any{"john", int64(7)}, query.Args)
+}
+
+func TestRenderer_DeleteByID(t *testing.T) {
+	parser := mocks.NewParser(t)
+	record := mocks.NewRecord(t)
+	record.On("ID").Return(int64(7))
+	parser.On("ParseDeleteByIDQuery", record).Return("DELETE FROM users WHERE id = @p1", nil)
+
+	query, err := NewRenderer(parser).DeleteByID(record)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &sql.Query{SQL: "DELETE FROM users WHERE id = @p1", Args: []any{int64(7)}}, query)
+}
+
+func TestRenderer_Insert(t *testing.T) {
+	t.Run("insert", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		record := mocks.NewRecord(t)
+		parser.On("InsertReturnsIDs").Return(false)
+		parser.On("ParseInsertQuery", record).Return("INSERT INTO users (name) VALUES (?)", []any{"john"}, nil)
+
+		query, err := NewRenderer(parser).Insert(record)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &sql.Query{SQL: "INSERT INTO users (name) VALUES (?)", Args: []any{"john"}}, query)
+	})
+
+	t.Run("insert returning the IDs as the executor", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		record := mocks.NewRecord(t)
+		parser.On("InsertReturnsIDs").Return(true)
+		parser.On("ParseInsertReturningIDsQuery", record).Return(`INSERT INTO users (name) VALUES ($1) RETURNING "id"`, []any{"john"}, nil)
+
+		query, err := NewRenderer(parser).Insert(record)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &sql.Query{SQL: `INSERT INTO users (name) VALUES ($1) RETURNING "id"`, Args: []any{"john"}}, query)
+	})
+
+	t.Run("insert ignore", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		record := mocks.NewRecord(t)
+		conflict := &sql.Conflict{Columns: []string{"email"}}
+		parser.On("ParseInsertIgnoreQuery", conflict, record).Return("INSERT IGNORE INTO users (name) VALUES (?)", []any{"john"}, nil)
+
+		query, err := NewRenderer(parser).InsertIgnore([]sql.Record{record}, sql.Options{Conflict: conflict})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &sql.Query{SQL: "INSERT IGNORE INTO users (name) VALUES (?)", Args: []any{"john"}}, query)
+	})
+}
+
+func TestRenderer_RunSP(t *testing.T) {
+	parser := mocks.NewParser(t)
+	parser.On("ParseSPQuery", "get_user", []any{int64(7)}).Return("CALL get_user(?)", nil)
+
+	query, err := NewRenderer(parser).RunSP("get_user", []any{int64(7)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &sql.Query{SQL: "CALL get_user(?)", Args: []any{int64(7)}}, query)
+}
+
+func TestExecutor_ToSQL(t *testing.T) {
+	parser := mocks.NewParser(t)
+	parser.On("Dialect").Return(sql.MySQL)
+	executor := NewExecutor(mocks.NewDB(t), parser)
+
+	assert.Equal(t, sql.MySQL, executor.ToSQL().Dialect())
+}
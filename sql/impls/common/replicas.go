@@ -0,0 +1,219 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/goutils/logger"
+)
+
+// defaultHealthCheckInterval is the interval between the pings of the replicas in milliseconds when the routing does not set it
+const defaultHealthCheckInterval = 5000
+
+// replica is a read replica of the primary database, with the statements prepared on it
+type replica struct {
+	db internal.DB
+	// mu guards statements, they are replaced when the replica is taken out of rotation so they are prepared again once it is back
+	mu         sync.Mutex
+	statements *replicaStatements
+	healthy    atomic.Bool
+	// reads is the number of reads running on the replica, for the least connections balancing
+	reads atomic.Int64
+}
+
+/*
+replicaStatements are the statements prepared on a replica, shared by the reads running on it.
+The statements are counted as used by the reads holding them, once dropped they are closed when the last of these reads releases them.
+*/
+type replicaStatements struct {
+	mu      sync.Mutex
+	stmts   internal.PreparedStatements
+	reads   int
+	dropped bool
+}
+
+// acquire returns the statements of the replica, used by the read until it releases them
+func (rep *replica) acquire() *replicaStatements {
+	rep.mu.Lock()
+	defer rep.mu.Unlock()
+	stmts := rep.statements
+	stmts.mu.Lock()
+	stmts.reads++
+	stmts.mu.Unlock()
+	return stmts
+}
+
+// dropStatements replaces the statements of the replica with none, the dropped ones are closed once no read uses them
+func (rep *replica) dropStatements() error {
+	rep.mu.Lock()
+	stmts := rep.statements
+	rep.statements = &replicaStatements{stmts: internal.NewPreparedStatements()}
+	rep.mu.Unlock()
+
+	stmts.mu.Lock()
+	defer stmts.mu.Unlock()
+	stmts.dropped = true
+	if stmts.reads == 0 {
+		return stmts.stmts.Close()
+	}
+	return nil
+}
+
+// prepare returns the statement prepared with the name on the replica, it is prepared once with the query of parse
+func (s *replicaStatements) prepare(ctx context.Context, db internal.DB, name string, parse func() (string, []sql.Param, error)) (*internal.PreparedStatement, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return prepareStatement(ctx, db, s.stmts, name, parse)
+}
+
+// release ends the use of the statements by a read, they are closed if they were dropped and no read uses them anymore
+func (s *replicaStatements) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reads--
+	if s.dropped && s.reads == 0 {
+		if err := s.stmts.Close(); err != nil {
+			logger.Error(context.Background(), "failed to close the prepared statements of a replica: %s", err.Error())
+		}
+	}
+}
+
+/*
+replicas balances the reads across the healthy replicas with the balancing of the routing.
+The replicas are pinged at the interval of the routing once start is called,
+a replica is taken out of rotation when its ping fails and put back once it succeeds.
+*/
+type replicas struct {
+	replicas  []*replica
+	balancing sql.Balancing
+	interval  time.Duration
+	// next is the replica the round robin starts from, the least connections balancing breaks its ties with it
+	next atomic.Uint64
+	// stop stops the health checks and done is closed once they are stopped, both nil until start is called
+	stop chan struct{}
+	done chan struct{}
+}
+
+// newReplicas returns the replicas of the connections, all in rotation until their first ping
+func newReplicas(dbs []internal.DB, routing sql.Routing) *replicas {
+	interval := routing.HealthCheckInterval
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+	r := &replicas{
+		replicas:  make([]*replica, len(dbs)),
+		balancing: routing.Balancing,
+		interval:  time.Duration(interval) * time.Millisecond,
+	}
+	for i, db := range dbs {
+		r.replicas[i] = &replica{db: db, statements: &replicaStatements{stmts: internal.NewPreparedStatements()}}
+		r.replicas[i].healthy.Store(true)
+	}
+	return r
+}
+
+// pick returns the healthy replica taking the read and counts the read on it until done is called.
+// Returns nil if no replica is healthy.
+func (r *replicas) pick() (rep *replica, done func()) {
+	n := uint64(len(r.replicas))
+	start := r.next.Add(1) - 1
+	for i := uint64(0); i < n; i++ {
+		candidate := r.replicas[(start+i)%n]
+		if !candidate.healthy.Load() {
+			continue
+		}
+		if r.balancing != sql.LeastConnections {
+			rep = candidate
+			break
+		}
+		if rep == nil || candidate.reads.Load() < rep.reads.Load() {
+			rep = candidate
+		}
+	}
+	if rep == nil {
+		return nil, func() {}
+	}
+	rep.reads.Add(1)
+	return rep, func() { rep.reads.Add(-1) }
+}
+
+// check pings the replicas, the replicas whose ping fails are taken out of rotation and their prepared statements are dropped
+func (r *replicas) check(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i, rep := range r.replicas {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pingCtx, cancel := context.WithTimeout(ctx, r.interval)
+			defer cancel()
+			err := rep.db.PingContext(pingCtx)
+			if healthy := err == nil; rep.healthy.Swap(healthy) != healthy {
+				if healthy {
+					logger.Info(ctx, "replica %d is back in rotation", i)
+				} else {
+					logger.Error(ctx, "replica %d is taken out of rotation, ping failed: %s", i, err.Error())
+					if err := rep.dropStatements(); err != nil {
+						logger.Error(ctx, "failed to close the prepared statements of replica %d: %s", i, err.Error())
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// start pings the replicas at the interval in the background until close is called
+func (r *replicas) start() {
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	go r.run()
+}
+
+func (r *replicas) run() {
+	defer close(r.done)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.check(context.Background())
+		}
+	}
+}
+
+// close stops the health checks if started, then closes the prepared statements and the connections of the replicas
+func (r *replicas) close() error {
+	if r.stop != nil {
+		close(r.stop)
+		<-r.done
+	}
+	var errs []error
+	for _, rep := range r.replicas {
+		errs = append(errs, rep.dropStatements(), rep.db.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// OpenReplicas opens the connections of the n replicas of the config of a dialect with open,
+// the connections already opened are closed if one fails.
+func OpenReplicas(n int, open func(i int) (internal.DB, error)) ([]internal.DB, error) {
+	conns := make([]internal.DB, 0, n)
+	for i := range n {
+		conn, err := open(i)
+		if err != nil {
+			for _, conn := range conns {
+				_ = conn.Close()
+			}
+			return nil, err
+		}
+		conns = append(conns, conn)
+	}
+	return conns, nil
+}
@@ -0,0 +1,317 @@
+package common
+
+import (
+	"context"
+	driver "database/sql"
+	sqldriver "database/sql/driver"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/gofreego/database/mocks"
+	sqlpkg "github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/database/sql/tests/records"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReplicas_pick(t *testing.T) {
+	t.Run("round robin", func(t *testing.T) {
+		r := newReplicas([]internal.DB{mocks.NewDB(t), mocks.NewDB(t), mocks.NewDB(t)}, sqlpkg.Routing{})
+		for i := range 6 {
+			rep, done := r.pick()
+			assert.Same(t, r.replicas[i%3], rep)
+			done()
+		}
+	})
+
+	t.Run("round robin skips the unhealthy replicas", func(t *testing.T) {
+		r := newReplicas([]internal.DB{mocks.NewDB(t), mocks.NewDB(t), mocks.NewDB(t)}, sqlpkg.Routing{Balancing: sqlpkg.RoundRobin})
+		r.replicas[1].healthy.Store(false)
+		var picked []*replica
+		for range 4 {
+			rep, done := r.pick()
+			picked = append(picked, rep)
+			done()
+		}
+		assert.Equal(t, []*replica{r.replicas[0], r.replicas[2], r.replicas[2], r.replicas[0]}, picked)
+	})
+
+	t.Run("least connections", func(t *testing.T) {
+		r := newReplicas([]internal.DB{mocks.NewDB(t), mocks.NewDB(t)}, sqlpkg.Routing{Balancing: sqlpkg.LeastConnections})
+		first, doneFirst := r.pick()
+		second, doneSecond := r.pick()
+		assert.NotSame(t, first, second)
+		// the second read is done first, so its replica runs the fewest reads
+		doneSecond()
+		third, doneThird := r.pick()
+		assert.Same(t, second, third)
+		assert.Equal(t, int64(1), first.reads.Load())
+		assert.Equal(t, int64(1), third.reads.Load())
+		doneFirst()
+		doneThird()
+		assert.Equal(t, int64(0), first.reads.Load())
+		assert.Equal(t, int64(0), third.reads.Load())
+	})
+
+	t.Run("no healthy replica", func(t *testing.T) {
+		r := newReplicas([]internal.DB{mocks.NewDB(t)}, sqlpkg.Routing{Balancing: sqlpkg.LeastConnections})
+		r.replicas[0].healthy.Store(false)
+		rep, done := r.pick()
+		assert.Nil(t, rep)
+		done()
+	})
+}
+
+func TestReplicas_check(t *testing.T) {
+	healthy := mocks.NewDB(t)
+	failing := mocks.NewDB(t)
+	r := newReplicas([]internal.DB{healthy, failing}, sqlpkg.Routing{HealthCheckInterval: 1000})
+
+	healthy.On("PingContext", mock.Anything).Return(nil)
+	failing.On("PingContext", mock.Anything).Return(errors.New("connection refused")).Once()
+	r.check(context.Background())
+	assert.True(t, r.replicas[0].healthy.Load())
+	assert.False(t, r.replicas[1].healthy.Load())
+	for range 3 {
+		rep, done := r.pick()
+		assert.Same(t, r.replicas[0], rep)
+		done()
+	}
+
+	// the replica is put back in rotation once its ping succeeds
+	failing.On("PingContext", mock.Anything).Return(nil).Once()
+	r.check(context.Background())
+	assert.True(t, r.replicas[1].healthy.Load())
+}
+
+// stmtConnector opens connections without a database, their statements and transactions run no query
+type stmtConnector struct{}
+
+func (stmtConnector) Connect(context.Context) (sqldriver.Conn, error) { return stmtConn{}, nil }
+func (stmtConnector) Driver() sqldriver.Driver                        { return nil }
+
+type stmtConn struct{}
+
+func (stmtConn) Prepare(string) (sqldriver.Stmt, error) { return stmt{}, nil }
+func (stmtConn) Close() error                           { return nil }
+func (stmtConn) Begin() (sqldriver.Tx, error)           { return stmtTx{}, nil }
+
+type stmtTx struct{}
+
+func (stmtTx) Commit() error   { return nil }
+func (stmtTx) Rollback() error { return nil }
+
+type stmt struct{}
+
+func (stmt) Close() error  { return nil }
+func (stmt) NumInput() int { return -1 }
+func (stmt) Exec([]sqldriver.Value) (sqldriver.Result, error) {
+	return sqldriver.RowsAffected(0), nil
+}
+func (stmt) Query([]sqldriver.Value) (sqldriver.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestReplicas_check_dropsPreparedStatements(t *testing.T) {
+	db := driver.OpenDB(stmtConnector{})
+	defer db.Close()
+	ps, err := db.Prepare("SELECT id FROM users WHERE id = ?")
+	assert.NoError(t, err)
+
+	conn := mocks.NewDB(t)
+	r := newReplicas([]internal.DB{conn}, sqlpkg.Routing{HealthCheckInterval: 1000})
+	rep := r.replicas[0]
+	rep.statements.stmts.Add("get_user", internal.NewPreparedStatement(ps))
+	// a read is running with the statements
+	stmts := rep.acquire()
+
+	// the statements are dropped when the replica is taken out of rotation, but kept open for the running read
+	conn.On("PingContext", mock.Anything).Return(errors.New("connection refused")).Once()
+	r.check(context.Background())
+	assert.False(t, rep.healthy.Load())
+	assert.NotSame(t, stmts, rep.statements)
+	assert.Empty(t, rep.statements.stmts)
+	_, err = ps.Query(1)
+	assert.NotContains(t, err.Error(), "statement is closed")
+
+	// the statements are closed once the read is done
+	stmts.release()
+	_, err = ps.Query(1)
+	assert.ErrorContains(t, err, "statement is closed")
+
+	// the replica is back in rotation without statements, they are prepared again on the next reads
+	conn.On("PingContext", mock.Anything).Return(nil).Once()
+	r.check(context.Background())
+	assert.True(t, rep.healthy.Load())
+	assert.Empty(t, rep.statements.stmts)
+}
+
+func TestExecutor_reader_parallelPreparedReads(t *testing.T) {
+	db := driver.OpenDB(stmtConnector{})
+	defer db.Close()
+	query := "SELECT id, name FROM users WHERE id = ?"
+	ps, err := db.Prepare(query)
+	assert.NoError(t, err)
+
+	replica := mocks.NewDB(t)
+	parser := mocks.NewParser(t)
+	executor := &Executor{
+		db:                 mocks.NewDB(t),
+		parser:             parser,
+		preparedStatements: internal.NewPreparedStatements(),
+		replicas:           newReplicas([]internal.DB{replica}, sqlpkg.Routing{}),
+	}
+	parser.On("ParseGetByIDQuery", mock.Anything, sqlpkg.NoLock).Return(query, nil)
+	// the statement is prepared once for all the reads
+	replica.On("PrepareContext", mock.Anything, query).Return(ps, nil).Once()
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := executor.GetByID(context.Background(), &records.User{Id: int64(i)}, sqlpkg.Options{PreparedName: "get_user"})
+			// the connections of the test driver run no query
+			assert.ErrorContains(t, err, "not supported")
+		}()
+	}
+	wg.Wait()
+	assert.True(t, executor.replicas.replicas[0].statements.stmts.Exists("get_user"))
+	assert.Equal(t, 0, executor.replicas.replicas[0].statements.reads)
+}
+
+func TestExecutor_reader(t *testing.T) {
+	query := "SELECT id, name FROM users WHERE id = ?"
+
+	t.Run("GetByID prepares the statement on a replica", func(t *testing.T) {
+		primary := mocks.NewDB(t)
+		replica := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 primary,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+			replicas:           newReplicas([]internal.DB{replica}, sqlpkg.Routing{}),
+		}
+		user := &records.User{Id: 1}
+
+		parser.On("ParseGetByIDQuery", user, sqlpkg.NoLock).Return(query, nil)
+		replica.On("PrepareContext", mock.Anything, query).Return(nil, errors.New("replica error")).Run(func(args mock.Arguments) {
+			// the read is counted on the replica while it runs
+			assert.Equal(t, int64(1), executor.replicas.replicas[0].reads.Load())
+		})
+
+		err := executor.GetByID(context.Background(), user, sqlpkg.Options{PreparedName: "get_user"})
+
+		assert.ErrorContains(t, err, "replica error")
+		assert.Equal(t, int64(0), executor.replicas.replicas[0].reads.Load())
+		primary.AssertNotCalled(t, "PrepareContext", mock.Anything, query)
+	})
+
+	t.Run("UsePrimaryDB reads from the primary", func(t *testing.T) {
+		primary := mocks.NewDB(t)
+		replica := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 primary,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+			replicas:           newReplicas([]internal.DB{replica}, sqlpkg.Routing{}),
+		}
+		filter := &sqlpkg.Filter{}
+		users := &records.Users{}
+
+		parser.On("ParseGetByFilterQuery", filter, users).Return(query, nil, nil)
+		primary.On("QueryContext", mock.Anything, query).Return(nil, errors.New("database error"))
+
+		err := executor.Get(context.Background(), filter, nil, users, sqlpkg.Options{UsePrimaryDB: true})
+
+		assert.Error(t, err)
+		replica.AssertNotCalled(t, "QueryContext", mock.Anything, query)
+	})
+
+	t.Run("no healthy replica reads from the primary", func(t *testing.T) {
+		primary := mocks.NewDB(t)
+		replica := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 primary,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+			replicas:           newReplicas([]internal.DB{replica}, sqlpkg.Routing{}),
+		}
+		executor.replicas.replicas[0].healthy.Store(false)
+		filter := &sqlpkg.Filter{}
+		users := &records.Users{}
+
+		parser.On("ParseGetByFilterQuery", filter, users).Return(query, nil, nil)
+		primary.On("QueryContext", mock.Anything, query).Return(nil, errors.New("database error"))
+
+		err := executor.Get(context.Background(), filter, nil, users)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("replica read", func(t *testing.T) {
+		primary := mocks.NewDB(t)
+		replica := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 primary,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+			replicas:           newReplicas([]internal.DB{replica}, sqlpkg.Routing{}),
+		}
+		filter := &sqlpkg.Filter{}
+		users := &records.Users{}
+
+		parser.On("ParseGetByFilterQuery", filter, users).Return(query, nil, nil)
+		replica.On("QueryContext", mock.Anything, query).Return(nil, errors.New("replica error"))
+
+		err := executor.Get(context.Background(), filter, nil, users)
+
+		assert.ErrorContains(t, err, "replica error")
+		primary.AssertNotCalled(t, "QueryContext", mock.Anything, query)
+	})
+}
+
+func TestExecutor_CloseReplicas(t *testing.T) {
+	primary := mocks.NewDB(t)
+	replica := mocks.NewDB(t)
+	executor := (&Executor{
+		db:                 primary,
+		parser:             mocks.NewParser(t),
+		preparedStatements: internal.NewPreparedStatements(),
+	}).WithReplicas([]internal.DB{replica}, sqlpkg.Routing{HealthCheckInterval: 60000})
+
+	replica.On("Close").Return(nil).Once()
+	primary.On("Close").Return(nil).Once()
+
+	assert.NoError(t, executor.Close(context.Background()))
+}
+
+func TestOpenReplicas(t *testing.T) {
+	t.Run("opens every replica", func(t *testing.T) {
+		dbs := []internal.DB{mocks.NewDB(t), mocks.NewDB(t)}
+		conns, err := OpenReplicas(len(dbs), func(i int) (internal.DB, error) {
+			return dbs[i], nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, dbs, conns)
+	})
+
+	t.Run("closes the opened replicas on error", func(t *testing.T) {
+		opened := mocks.NewDB(t)
+		opened.On("Close").Return(nil).Once()
+		conns, err := OpenReplicas(2, func(i int) (internal.DB, error) {
+			if i == 0 {
+				return opened, nil
+			}
+			return nil, errors.New("invalid dsn")
+		})
+		assert.Error(t, err)
+		assert.Nil(t, conns)
+	})
+}
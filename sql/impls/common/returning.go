@@ -0,0 +1,202 @@
+package common
+
+import (
+	"context"
+	driver "database/sql"
+	"strings"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/goutils/logger"
+)
+
+/*
+UpdateReturning updates the rows of the table of the records matching the condition and scans the updated rows into the records.
+The query returns the updated rows if the parser supports it, otherwise the rows are selected with the condition after the update,
+in Options.Transaction if provided, otherwise in a transaction of their own.
+As the selected rows must be the updated ones, the condition cannot read the updated columns then.
+Returns the number of rows updated.
+Options.PreparedName is ignored.
+*/
+func (c *Executor) UpdateReturning(ctx context.Context, updates *sql.Updates, condition *sql.Condition, values []any, records sql.Records, options ...sql.Options) (int64, error) {
+	if records == nil {
+		return 0, sql.NewInvalidQueryError("update returning:: records cannot be nil")
+	}
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
+	if c.parser.ReturnsModifiedRows() {
+		query, params, err := c.parser.ParseUpdateReturningQuery(updates, condition, records)
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		logger.Debug(ctx, "UpdateReturning query: %s", query)
+		return c.queryModifiedRows(ctx, opt, query, sql.GetParamValues(params, values), records)
+	}
+	if column, ok := readsUpdatedColumn(condition, updates); ok {
+		return 0, sql.NewInvalidQueryError("update returning:: the condition cannot read the updated column %s", column)
+	}
+	updateQuery, updateParams, err := c.parser.ParseUpdateQuery(records.Table(), updates, condition)
+	if err != nil {
+		return 0, internal.HandleContextError(ctx, err)
+	}
+	selectQuery, selectParams, err := c.parser.ParseGetByFilterQuery(&sql.Filter{Condition: condition, Lock: sql.ForUpdate}, records)
+	if err != nil {
+		return 0, internal.HandleContextError(ctx, err)
+	}
+	logger.Debug(ctx, "UpdateReturning query: %s; %s", updateQuery, selectQuery)
+	return InBulkTransaction(ctx, c.db, opt, func(txn *driver.Tx) (int64, error) {
+		result, err := txn.ExecContext(ctx, updateQuery, sql.GetParamValues(updateParams, values)...)
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		// the updated rows are locked until the end of the transaction, they are selected with the updated values
+		if _, err := scanRows(ctx, txn, selectQuery, sql.GetParamValues(selectParams, values), records); err != nil {
+			return 0, err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		return rowsAffected, nil
+	})
+}
+
+/*
+DeleteReturning deletes the rows of the table of the records matching the condition and scans the deleted rows into the records.
+The query returns the deleted rows if the parser supports it, otherwise the rows are selected and locked with the condition before the delete,
+in Options.Transaction if provided, otherwise in a transaction of their own.
+Returns the number of rows deleted.
+Options.PreparedName is ignored.
+*/
+func (c *Executor) DeleteReturning(ctx context.Context, condition *sql.Condition, values []any, records sql.Records, options ...sql.Options) (int64, error) {
+	if records == nil {
+		return 0, sql.NewInvalidQueryError("delete returning:: records cannot be nil")
+	}
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
+	if c.parser.ReturnsModifiedRows() {
+		query, params, err := c.parser.ParseDeleteReturningQuery(condition, records)
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		logger.Debug(ctx, "DeleteReturning query: %s", query)
+		return c.queryModifiedRows(ctx, opt, query, sql.GetParamValues(params, values), records)
+	}
+	selectQuery, selectParams, err := c.parser.ParseGetByFilterQuery(&sql.Filter{Condition: condition, Lock: sql.ForUpdate}, records)
+	if err != nil {
+		return 0, internal.HandleContextError(ctx, err)
+	}
+	deleteQuery, deleteParams, err := c.parser.ParseDeleteQuery(records.Table(), condition)
+	if err != nil {
+		return 0, internal.HandleContextError(ctx, err)
+	}
+	logger.Debug(ctx, "DeleteReturning query: %s; %s", selectQuery, deleteQuery)
+	return InBulkTransaction(ctx, c.db, opt, func(txn *driver.Tx) (int64, error) {
+		// the selected rows are locked so they are still the rows matching the condition when they are deleted
+		if _, err := scanRows(ctx, txn, selectQuery, sql.GetParamValues(selectParams, values), records); err != nil {
+			return 0, err
+		}
+		result, err := txn.ExecContext(ctx, deleteQuery, sql.GetParamValues(deleteParams, values)...)
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		return rowsAffected, nil
+	})
+}
+
+// queryModifiedRows runs the query returning the modified rows and scans them into the records,
+// in the transaction of the options if provided, otherwise on the database.
+func (c *Executor) queryModifiedRows(ctx context.Context, opt sql.Options, query string, values []any, records sql.Records) (int64, error) {
+	if opt.Transaction != nil {
+		txn, err := internal.GetTransaction(opt.Transaction)
+		if err != nil {
+			return 0, err
+		}
+		return scanRows(ctx, txn, query, values, records)
+	}
+	return scanRows(ctx, c.db, query, values, records)
+}
+
+// querier runs a query on the database or in a transaction
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*driver.Rows, error)
+}
+
+// scanRows runs the query and scans its rows into the records, returns the number of rows scanned.
+func scanRows(ctx context.Context, q querier, query string, values []any, records sql.Records) (int64, error) {
+	rows, err := q.QueryContext(ctx, query, values...)
+	if err != nil {
+		return 0, internal.HandleContextError(ctx, err)
+	}
+	// the rows are closed before the next query of the transaction
+	defer rows.Close()
+	counted := &countedRows{Rows: rows}
+	if err := records.Scan(counted); err != nil {
+		return 0, internal.HandleContextError(ctx, err)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, internal.HandleContextError(ctx, err)
+	}
+	return counted.count, nil
+}
+
+// countedRows counts the rows read by Scan of the records
+type countedRows struct {
+	*driver.Rows
+	count int64
+}
+
+func (r *countedRows) Next() bool {
+	if !r.Rows.Next() {
+		return false
+	}
+	r.count++
+	return true
+}
+
+// readsUpdatedColumn returns the first column set by the updates that the condition reads, if any.
+// Qualified columns are compared by the name of their last part, raw fields and expressions are not checked.
+func readsUpdatedColumn(condition *sql.Condition, updates *sql.Updates) (string, bool) {
+	if condition == nil || updates == nil {
+		return "", false
+	}
+	updated := map[string]bool{}
+	for _, update := range updates.Fields {
+		updated[columnName(update.Field)] = true
+	}
+	var find func(condition sql.Condition) (string, bool)
+	find = func(condition sql.Condition) (string, bool) {
+		for _, subCondition := range condition.Conditions {
+			if column, ok := find(subCondition); ok {
+				return column, true
+			}
+		}
+		if condition.Field != "" && !condition.RawField && updated[columnName(condition.Field)] {
+			return condition.Field, true
+		}
+		if condition.Value != nil && condition.Value.IsColumn() && condition.Value.IsStringValue() {
+			if column := condition.Value.Value.(string); updated[columnName(column)] {
+				return column, true
+			}
+		}
+		return "", false
+	}
+	return find(*condition)
+}
+
+// columnName returns the name of the last part of a qualified column
+func columnName(column string) string {
+	return column[strings.LastIndex(column, ".")+1:]
+}
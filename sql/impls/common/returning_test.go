@@ -0,0 +1,193 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofreego/database/mocks"
+	sqlpkg "github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecutor_UpdateReturning(t *testing.T) {
+	updates := sqlpkg.NewUpdates().Add("is_active", sqlpkg.NewValue(0))
+	condition := sqlpkg.NewCondition("email", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
+
+	t.Run("nil records", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		_, err := executor.UpdateReturning(context.Background(), updates, condition, []any{"john@example.com"}, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("returning query error", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		parser.On("ReturnsModifiedRows").Return(true)
+		parser.On("ParseUpdateReturningQuery", updates, condition, records).Return("update returning", []sqlpkg.Param{{Index: 0}}, nil)
+		db.On("QueryContext", mock.Anything, "update returning", "john@example.com").Return(nil, errors.New("connection reset"))
+
+		rowsAffected, err := executor.UpdateReturning(context.Background(), updates, condition, []any{"john@example.com"}, records)
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), rowsAffected)
+		records.AssertNotCalled(t, "Scan", mock.Anything)
+	})
+
+	t.Run("parser error", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		parser.On("ReturnsModifiedRows").Return(true)
+		parser.On("ParseUpdateReturningQuery", updates, condition, records).Return("", nil, errors.New("updates is nil"))
+
+		_, err := executor.UpdateReturning(context.Background(), updates, condition, []any{"john@example.com"}, records)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("condition reading an updated column cannot select the updated rows", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		parser.On("ReturnsModifiedRows").Return(false)
+		active := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewValue(1))
+
+		_, err := executor.UpdateReturning(context.Background(), updates, active, nil, mocks.NewRecords(t))
+
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsQueryError())
+	})
+
+	t.Run("update and select fail if the transaction cannot begin", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		table := sqlpkg.NewTable("users")
+
+		parser.On("ReturnsModifiedRows").Return(false)
+		records.On("Table").Return(table)
+		parser.On("ParseUpdateQuery", table, updates, condition).Return("update", []sqlpkg.Param{{Index: 0}}, nil)
+		parser.On("ParseGetByFilterQuery", &sqlpkg.Filter{Condition: condition, Lock: sqlpkg.ForUpdate}, records).Return("select", []sqlpkg.Param{{Index: 0}}, nil)
+		db.On("BeginTx", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+		_, err := executor.UpdateReturning(context.Background(), updates, condition, []any{"john@example.com"}, records)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestExecutor_DeleteReturning(t *testing.T) {
+	condition := sqlpkg.NewCondition("email", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
+
+	t.Run("returning query error", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		parser.On("ReturnsModifiedRows").Return(true)
+		parser.On("ParseDeleteReturningQuery", condition, records).Return("delete returning", []sqlpkg.Param{{Index: 0}}, nil)
+		db.On("QueryContext", mock.Anything, "delete returning", "john@example.com").Return(nil, errors.New("connection reset"))
+
+		rowsAffected, err := executor.DeleteReturning(context.Background(), condition, []any{"john@example.com"}, records)
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), rowsAffected)
+	})
+
+	t.Run("select and delete fail if the transaction cannot begin", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		records := mocks.NewRecords(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		table := sqlpkg.NewTable("users")
+
+		parser.On("ReturnsModifiedRows").Return(false)
+		records.On("Table").Return(table)
+		parser.On("ParseGetByFilterQuery", &sqlpkg.Filter{Condition: condition, Lock: sqlpkg.ForUpdate}, records).Return("select", []sqlpkg.Param{{Index: 0}}, nil)
+		parser.On("ParseDeleteQuery", table, condition).Return("delete", []sqlpkg.Param{{Index: 0}}, nil)
+		db.On("BeginTx", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+		_, err := executor.DeleteReturning(context.Background(), condition, []any{"john@example.com"}, records)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("nil records", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		_, err := executor.DeleteReturning(context.Background(), condition, []any{"john@example.com"}, nil)
+
+		assert.Error(t, err)
+	})
+}
+
+func Test_readsUpdatedColumn(t *testing.T) {
+	updates := sqlpkg.NewUpdates().Add("is_active", sqlpkg.NewValue(0))
+	tests := []struct {
+		name      string
+		condition *sqlpkg.Condition
+		want      string
+		wantReads bool
+	}{
+		{"other column", sqlpkg.NewCondition("email", sqlpkg.EQ, sqlpkg.NewIndexedValue(0)), "", false},
+		{"updated column", sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewValue(1)), "is_active", true},
+		{"qualified updated column", sqlpkg.NewCondition("u.is_active", sqlpkg.EQ, sqlpkg.NewValue(1)), "u.is_active", true},
+		{"updated column as value", sqlpkg.NewCondition("score", sqlpkg.GT, sqlpkg.NewColumnValue("is_active")), "is_active", true},
+		{"nested updated column", &sqlpkg.Condition{Operator: sqlpkg.AND, Conditions: []sqlpkg.Condition{
+			*sqlpkg.NewCondition("email", sqlpkg.EQ, sqlpkg.NewIndexedValue(0)),
+			*sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewValue(1)),
+		}}, "is_active", true},
+		{"no condition", nil, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reads := readsUpdatedColumn(tt.condition, updates)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantReads, reads)
+		})
+	}
+}
@@ -10,8 +10,12 @@ import (
 )
 
 func (c *Executor) RunSP(ctx context.Context, spName string, values []any, result sql.SPResult, options ...sql.Options) error {
-	opt := sql.GetOptions(options...)
-	var err error
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
 	var row *driver.Row
 	var query string
 	if opt.PreparedName != "" {
@@ -22,12 +26,12 @@ func (c *Executor) RunSP(ctx context.Context, spName string, values []any, resul
 			if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
 				query, err = c.parser.ParseSPQuery(spName, values)
 				if err != nil {
-					return internal.HandleError(err)
+					return internal.HandleContextError(ctx, err)
 				}
 				logger.Debug(ctx, "GetByFilter query: %s", query)
 				ps, err := c.db.PrepareContext(ctx, query)
 				if err != nil {
-					return internal.HandleError(err)
+					return internal.HandleContextError(ctx, err)
 				}
 				stmt = internal.NewPreparedStatement(ps)
 				c.preparedStatements.Add(opt.PreparedName, stmt)
@@ -40,14 +44,16 @@ func (c *Executor) RunSP(ctx context.Context, spName string, values []any, resul
 			if err != nil {
 				return err
 			}
-			row = txn.QueryRowContext(ctx, stmt.GetQuery(), values...)
+			txStmt := txn.StmtContext(ctx, stmt.GetStatement())
+			defer txStmt.Close()
+			row = txStmt.QueryRowContext(ctx, values...)
 		} else {
 			row = stmt.GetStatement().QueryRowContext(ctx, values...)
 		}
 	} else {
 		query, err = c.parser.ParseSPQuery(spName, values)
 		if err != nil {
-			return internal.HandleError(err)
+			return internal.HandleContextError(ctx, err)
 		}
 		logger.Debug(ctx, "SP query: %s", query)
 		// if transaction is provided, use it to execute the query
@@ -63,7 +69,7 @@ func (c *Executor) RunSP(ctx context.Context, spName string, values []any, resul
 		}
 	}
 	if row.Err() != nil {
-		return internal.HandleError(row.Err())
+		return internal.HandleContextError(ctx, row.Err())
 	}
-	return internal.HandleError(result.Scan(row))
+	return internal.HandleContextError(ctx, result.Scan(row))
 }
@@ -0,0 +1,35 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofreego/database/sql"
+)
+
+/*
+WithTimeout returns the context of the operation with the deadline of Options.Timeout if set, otherwise of the default timeout
+of the operation type, both in milliseconds, and the function releasing the context once the operation is done.
+The context has no deadline of its own if both are 0.
+*/
+func WithTimeout(ctx context.Context, opt sql.Options, defaultTimeout int64) (context.Context, context.CancelFunc) {
+	timeout := opt.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, time.Duration(timeout)*time.Millisecond)
+}
+
+// Timeouts returns the default timeouts of the operations
+func (c *Executor) Timeouts() sql.Timeouts {
+	return c.timeouts
+}
+
+// WithTimeouts sets the default timeouts of the operations
+func (c *Executor) WithTimeouts(timeouts sql.Timeouts) *Executor {
+	c.timeouts = timeouts
+	return c
+}
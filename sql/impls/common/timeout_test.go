@@ -0,0 +1,115 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofreego/database/mocks"
+	sqlpkg "github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/database/sql/tests/records"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWithTimeout(t *testing.T) {
+	tests := []struct {
+		name           string
+		timeout        int64
+		defaultTimeout int64
+		want           time.Duration
+	}{
+		{"no timeout", 0, 0, 0},
+		{"default timeout", 0, 2000, 2 * time.Second},
+		{"timeout of the options", 1000, 0, time.Second},
+		{"timeout of the options overrides the default", 1000, 5000, time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := WithTimeout(context.Background(), sqlpkg.Options{Timeout: tt.timeout}, tt.defaultTimeout)
+			defer cancel()
+			deadline, ok := ctx.Deadline()
+			if tt.want == 0 {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(tt.want), deadline, 100*time.Millisecond)
+		})
+	}
+
+	t.Run("cancel releases the context", func(t *testing.T) {
+		ctx, cancel := WithTimeout(context.Background(), sqlpkg.Options{Timeout: 1000}, 0)
+		cancel()
+		assert.Error(t, ctx.Err())
+	})
+}
+
+func TestExecutor_Timeouts(t *testing.T) {
+	filter := &sqlpkg.Filter{
+		Condition: &sqlpkg.Condition{
+			Field:    "is_active",
+			Operator: sqlpkg.EQ,
+			Value:    sqlpkg.NewIndexedValue(0),
+		},
+	}
+	query := "SELECT id, name FROM users WHERE is_active = ?"
+	hasDeadline := func(want time.Duration) any {
+		return mock.MatchedBy(func(ctx context.Context) bool {
+			deadline, ok := ctx.Deadline()
+			return ok && time.Until(deadline) <= want && time.Until(deadline) > want-time.Second
+		})
+	}
+
+	t.Run("default timeout of the operation type", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := (&Executor{db: db, parser: parser, preparedStatements: internal.NewPreparedStatements()}).
+			WithTimeouts(sqlpkg.Timeouts{Read: 5000, Write: 60000})
+		records := &records.Users{}
+
+		parser.On("ParseGetByFilterQuery", filter, records).Return(query, []sqlpkg.Param{{Index: 0}}, nil)
+		db.On("QueryContext", hasDeadline(5*time.Second), query, 1).Return(nil, context.DeadlineExceeded)
+
+		err := executor.Get(context.Background(), filter, []any{1}, records)
+
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsTimeout())
+	})
+
+	t.Run("timeout of the options", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := (&Executor{db: db, parser: parser, preparedStatements: internal.NewPreparedStatements()}).
+			WithTimeouts(sqlpkg.Timeouts{Read: 60000})
+		records := &records.Users{}
+
+		parser.On("ParseGetByFilterQuery", filter, records).Return(query, []sqlpkg.Param{{Index: 0}}, nil)
+		db.On("QueryContext", hasDeadline(2*time.Second), query, 1).Return(nil, context.DeadlineExceeded)
+
+		err := executor.Get(context.Background(), filter, []any{1}, records, sqlpkg.Options{Timeout: 2000})
+
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsTimeout())
+	})
+
+	t.Run("begin transaction error releases the deadline", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := (&Executor{db: db, parser: parser, preparedStatements: internal.NewPreparedStatements()}).
+			WithTimeouts(sqlpkg.Timeouts{Transaction: 3000})
+
+		parser.On("ParseTransactionQuery", (*sqlpkg.TxOptions)(nil)).Return("", nil)
+		db.On("BeginTx", hasDeadline(3*time.Second), mock.Anything).Return(nil, context.DeadlineExceeded)
+
+		tx, err := executor.BeginTransaction(context.Background(), nil)
+
+		assert.Nil(t, tx)
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsTimeout())
+	})
+}
@@ -2,15 +2,173 @@ package common
 
 import (
 	"context"
+	driver "database/sql"
+	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/gofreego/database/sql"
 	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/goutils/logger"
 )
 
-func (c *Executor) BeginTransaction(ctx context.Context, options ...sql.Options) (sql.Transaction, error) {
-	tx, err := c.db.BeginTx(ctx, nil)
+// savepoints numbers the savepoints of the nested RunInTransaction calls
+var savepoints atomic.Int64
+
+// BeginTransaction begins a transaction with Options.TxOptions, see sql.Database.BeginTransaction.
+// The operations of the returned handle run on db, the database of the dialect, in the transaction.
+// The driver rolls the transaction back once the deadline of its timeout is reached.
+func (c *Executor) BeginTransaction(ctx context.Context, db sql.Database, options ...sql.Options) (sql.Tx, error) {
+	opt := sql.GetOptions(options...)
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Transaction)
+	tx, err := c.beginTx(ctx, opt.TxOptions)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return newTransaction(db, tx, c.parser).withCancel(cancel), nil
+}
+
+/*
+beginTx begins a transaction with the isolation level and the access mode of the options,
+then runs the statement of the parser setting the options the driver cannot begin the transaction with, if any.
+Returns an error if the database does not support the options.
+*/
+func (c *Executor) beginTx(ctx context.Context, options *sql.TxOptions) (*driver.Tx, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	query, err := c.parser.ParseTransactionQuery(options)
+	if err != nil {
+		return nil, err
+	}
+	txn, err := c.db.BeginTx(ctx, txOptions(options))
+	if err != nil {
+		return nil, internal.HandleContextError(ctx, err)
+	}
+	if query != "" {
+		logger.Debug(ctx, "BeginTransaction query: %s", query)
+		if _, err := txn.ExecContext(ctx, query); err != nil {
+			_ = txn.Rollback()
+			return nil, internal.HandleContextError(ctx, err)
+		}
+	}
+	return txn, nil
+}
+
+// txOptions returns the driver options of the transaction, nil for the defaults of the database
+func txOptions(options *sql.TxOptions) *driver.TxOptions {
+	if options == nil {
+		return nil
+	}
+	opts := &driver.TxOptions{ReadOnly: options.ReadOnly}
+	switch options.Isolation {
+	case sql.ReadCommitted:
+		opts.Isolation = driver.LevelReadCommitted
+	case sql.RepeatableRead:
+		opts.Isolation = driver.LevelRepeatableRead
+	case sql.Serializable:
+		opts.Isolation = driver.LevelSerializable
+	case sql.Snapshot:
+		opts.Isolation = driver.LevelSnapshot
+	}
+	return opts
+}
+
+/*
+RunInTransaction runs fn in a transaction of its own that is committed if fn succeeds and rolled back if it fails or panics,
+or in a savepoint of the transaction of the options or of the context if provided, see sql.Database.RunInTransaction.
+The context passed to fn carries the transaction.
+The operations of the transaction handle passed to fn run on db, the database of the dialect.
+With Options.Retry, fn is run again in a new transaction after the backoff while retryable reports the error of fn or of the commit,
+the serialization failures and deadlocks of the dialect, and the context is not done.
+*/
+func (c *Executor) RunInTransaction(ctx context.Context, db sql.Database, fn func(ctx context.Context, tx sql.Tx) error, retryable func(err error) bool, options ...sql.Options) error {
+	if fn == nil {
+		return sql.NewInvalidQueryError("run in transaction:: fn cannot be nil")
+	}
+	opt, err := internal.GetOptions(ctx, options...)
 	if err != nil {
-		return nil, internal.HandleError(err)
+		return err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Transaction)
+	defer cancel()
+	if opt.Transaction != nil {
+		tx, err := c.handle(db, opt.Transaction)
+		if err != nil {
+			return err
+		}
+		return runInSavepoint(ctx, tx, fn)
+	}
+	attempts := opt.Retry.Attempts()
+	for attempt := 1; ; attempt++ {
+		err := c.runInTransaction(ctx, db, fn, opt.TxOptions)
+		if err == nil || attempt >= attempts || !retryable(err) {
+			return err
+		}
+		timer := time.NewTimer(opt.Retry.Delay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// runInTransaction runs fn in a new transaction, commits it if fn succeeds and rolls it back otherwise.
+func (c *Executor) runInTransaction(ctx context.Context, db sql.Database, fn func(ctx context.Context, tx sql.Tx) error, options *sql.TxOptions) error {
+	txn, err := c.beginTx(ctx, options)
+	if err != nil {
+		return err
+	}
+	// rolls back if fn fails, panics or exits the goroutine, it is a no-op once the transaction is committed
+	defer func() {
+		_ = txn.Rollback()
+	}()
+	// the operations called with the context of fn run in the transaction, see sql.WithTransaction
+	tx := newTransaction(db, txn, c.parser)
+	if err := fn(sql.WithTransaction(ctx, tx), tx); err != nil {
+		return err
+	}
+	if err := txn.Commit(); err != nil {
+		return internal.HandleContextError(ctx, err)
+	}
+	return nil
+}
+
+// handle returns the handle of the transaction, a driver transaction set with Options.Transaction is run on db
+func (c *Executor) handle(db sql.Database, transaction sql.Transaction) (sql.Tx, error) {
+	if tx, ok := transaction.(sql.Tx); ok {
+		return tx, nil
+	}
+	txn, err := internal.GetTransaction(transaction)
+	if err != nil {
+		return nil, err
+	}
+	return newTransaction(db, txn, c.parser), nil
+}
+
+// runInSavepoint runs fn in a savepoint of the transaction, releases it if fn succeeds and rolls back to it otherwise.
+func runInSavepoint(ctx context.Context, tx sql.Tx, fn func(ctx context.Context, tx sql.Tx) error) error {
+	name := fmt.Sprintf("sp_%d", savepoints.Add(1))
+	if err := tx.Savepoint(ctx, name); err != nil {
+		return err
+	}
+	released := false
+	// rolls back to the savepoint if fn fails, panics or exits the goroutine, the transaction goes on without the changes of fn.
+	// The rollback runs even if the context is done.
+	defer func() {
+		if !released {
+			_ = tx.RollbackTo(context.WithoutCancel(ctx), name)
+		}
+	}()
+	if err := fn(sql.WithTransaction(ctx, tx), tx); err != nil {
+		return err
+	}
+	if err := tx.Release(ctx, name); err != nil {
+		return err
 	}
-	return tx, nil
+	released = true
+	return nil
 }
@@ -0,0 +1,276 @@
+package common
+
+import (
+	"context"
+	driver "database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofreego/database/mocks"
+	sqlpkg "github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecutor_BeginTransaction(t *testing.T) {
+	t.Run("unknown isolation level", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		_, err := executor.BeginTransaction(context.Background(), mocks.NewDatabase(t), sqlpkg.Options{TxOptions: &sqlpkg.TxOptions{Isolation: sqlpkg.IsolationLevel(42)}})
+
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsQueryError())
+	})
+
+	t.Run("options not supported by the database", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		options := &sqlpkg.TxOptions{ReadOnly: true}
+		parser.On("ParseTransactionQuery", options).Return("", sqlpkg.NewInvalidQueryError("read-only transactions are not supported"))
+
+		_, err := executor.BeginTransaction(context.Background(), mocks.NewDatabase(t), sqlpkg.Options{TxOptions: options})
+
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsQueryError())
+	})
+
+	t.Run("begins with the driver options", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		options := &sqlpkg.TxOptions{Isolation: sqlpkg.Serializable, ReadOnly: true}
+		parser.On("ParseTransactionQuery", options).Return("", nil)
+		db.On("BeginTx", mock.Anything, &driver.TxOptions{Isolation: driver.LevelSerializable, ReadOnly: true}).Return(nil, errors.New("connection refused"))
+
+		_, err := executor.BeginTransaction(context.Background(), mocks.NewDatabase(t), sqlpkg.Options{TxOptions: options})
+
+		assert.Error(t, err)
+	})
+}
+
+func Test_txOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *sqlpkg.TxOptions
+		want    *driver.TxOptions
+	}{
+		{"no options", nil, nil},
+		{"default isolation", &sqlpkg.TxOptions{}, &driver.TxOptions{}},
+		{"read committed", &sqlpkg.TxOptions{Isolation: sqlpkg.ReadCommitted}, &driver.TxOptions{Isolation: driver.LevelReadCommitted}},
+		{"repeatable read", &sqlpkg.TxOptions{Isolation: sqlpkg.RepeatableRead}, &driver.TxOptions{Isolation: driver.LevelRepeatableRead}},
+		{"serializable read only", &sqlpkg.TxOptions{Isolation: sqlpkg.Serializable, ReadOnly: true, Deferrable: true}, &driver.TxOptions{Isolation: driver.LevelSerializable, ReadOnly: true}},
+		{"snapshot", &sqlpkg.TxOptions{Isolation: sqlpkg.Snapshot}, &driver.TxOptions{Isolation: driver.LevelSnapshot}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, txOptions(tt.options))
+		})
+	}
+}
+
+func TestExecutor_RunInTransaction(t *testing.T) {
+	errBegin := errors.New("deadlock")
+	retryable := func(err error) bool { return errors.Is(err, errBegin) }
+	newExecutor := func(t *testing.T) (*Executor, *mocks.DB) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		parser.On("ParseTransactionQuery", (*sqlpkg.TxOptions)(nil)).Return("", nil).Maybe()
+		return &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}, db
+	}
+
+	t.Run("nil fn", func(t *testing.T) {
+		executor, _ := newExecutor(t)
+
+		err := executor.RunInTransaction(context.Background(), mocks.NewDatabase(t), nil, retryable)
+
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsQueryError())
+	})
+
+	t.Run("error rolls back to the savepoint of the transaction of the options", func(t *testing.T) {
+		executor, _ := newExecutor(t)
+		tx := mocks.NewTx(t)
+		errFn := errors.New("fn failed")
+		var name string
+		tx.On("Savepoint", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			name = args.String(1)
+		})
+		tx.On("RollbackTo", mock.Anything, mock.Anything).Return(nil)
+		var got sqlpkg.Tx
+
+		err := executor.RunInTransaction(context.Background(), mocks.NewDatabase(t), func(ctx context.Context, tx sqlpkg.Tx) error {
+			got = tx
+			return errFn
+		}, retryable, sqlpkg.Options{Transaction: tx, Retry: &sqlpkg.Retry{MaxAttempts: 3}})
+
+		assert.ErrorIs(t, err, errFn)
+		assert.Same(t, tx, got)
+		tx.AssertCalled(t, "RollbackTo", mock.Anything, name)
+		tx.AssertNotCalled(t, "Release", mock.Anything, mock.Anything)
+	})
+
+	t.Run("success releases the savepoint of the transaction of the options", func(t *testing.T) {
+		executor, _ := newExecutor(t)
+		tx := mocks.NewTx(t)
+		var name string
+		tx.On("Savepoint", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			name = args.String(1)
+		})
+		tx.On("Release", mock.Anything, mock.Anything).Return(nil)
+
+		err := executor.RunInTransaction(context.Background(), mocks.NewDatabase(t), func(ctx context.Context, tx sqlpkg.Tx) error {
+			return nil
+		}, retryable, sqlpkg.Options{Transaction: tx})
+
+		assert.NoError(t, err)
+		tx.AssertCalled(t, "Release", mock.Anything, name)
+		tx.AssertNotCalled(t, "RollbackTo", mock.Anything, mock.Anything)
+	})
+
+	t.Run("panic rolls back to the savepoint of the transaction of the options", func(t *testing.T) {
+		executor, _ := newExecutor(t)
+		tx := mocks.NewTx(t)
+		tx.On("Savepoint", mock.Anything, mock.Anything).Return(nil)
+		tx.On("RollbackTo", mock.Anything, mock.Anything).Return(nil)
+
+		assert.Panics(t, func() {
+			_ = executor.RunInTransaction(context.Background(), mocks.NewDatabase(t), func(ctx context.Context, tx sqlpkg.Tx) error {
+				panic("fn panicked")
+			}, retryable, sqlpkg.Options{Transaction: tx})
+		})
+		tx.AssertNotCalled(t, "Release", mock.Anything, mock.Anything)
+	})
+
+	t.Run("runs in a savepoint of the transaction of the context", func(t *testing.T) {
+		executor, _ := newExecutor(t)
+		tx := mocks.NewTx(t)
+		tx.On("Savepoint", mock.Anything, mock.Anything).Return(nil)
+		tx.On("Release", mock.Anything, mock.Anything).Return(nil)
+
+		err := executor.RunInTransaction(sqlpkg.WithTransaction(context.Background(), tx), mocks.NewDatabase(t), func(ctx context.Context, got sqlpkg.Tx) error {
+			assert.Same(t, tx, got)
+			assert.Same(t, tx, sqlpkg.TransactionFromContext(ctx))
+			return nil
+		}, retryable)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("runs in a savepoint of the driver transaction of the options", func(t *testing.T) {
+		executor, _ := newExecutor(t)
+		parser := executor.parser.(*mocks.Parser)
+		conn := driver.OpenDB(stmtConnector{})
+		defer conn.Close()
+		txn, err := conn.Begin()
+		assert.NoError(t, err)
+		defer txn.Rollback()
+		parser.On("ParseSavepointQuery", mock.Anything).Return("SAVEPOINT sp", nil)
+		parser.On("ParseReleaseSavepointQuery", mock.Anything).Return("RELEASE SAVEPOINT sp", nil)
+
+		err = executor.RunInTransaction(context.Background(), mocks.NewDatabase(t), func(ctx context.Context, tx sqlpkg.Tx) error {
+			got, err := internal.GetTransaction(tx)
+			assert.NoError(t, err)
+			assert.Same(t, txn, got)
+			return nil
+		}, retryable, sqlpkg.Options{Transaction: txn})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("conflicting transactions of the options and of the context", func(t *testing.T) {
+		executor, _ := newExecutor(t)
+
+		err := executor.RunInTransaction(sqlpkg.WithTransaction(context.Background(), mocks.NewTransaction(t)), mocks.NewDatabase(t), func(ctx context.Context, tx sqlpkg.Tx) error {
+			t.Error("fn must not run")
+			return nil
+		}, retryable, sqlpkg.Options{Transaction: mocks.NewTransaction(t)})
+
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsQueryError())
+	})
+
+	t.Run("savepoint error", func(t *testing.T) {
+		executor, _ := newExecutor(t)
+		tx := mocks.NewTx(t)
+		errSavepoint := errors.New("no transaction is active")
+		tx.On("Savepoint", mock.Anything, mock.Anything).Return(errSavepoint)
+
+		err := executor.RunInTransaction(context.Background(), mocks.NewDatabase(t), func(ctx context.Context, tx sqlpkg.Tx) error {
+			t.Error("fn must not run")
+			return nil
+		}, retryable, sqlpkg.Options{Transaction: tx})
+
+		assert.ErrorIs(t, err, errSavepoint)
+	})
+
+	t.Run("begin error is not retried without retry", func(t *testing.T) {
+		executor, db := newExecutor(t)
+		db.On("BeginTx", mock.Anything, mock.Anything).Return(nil, errBegin).Once()
+
+		err := executor.RunInTransaction(context.Background(), mocks.NewDatabase(t), func(ctx context.Context, tx sqlpkg.Tx) error {
+			t.Error("fn must not run")
+			return nil
+		}, retryable)
+
+		assert.ErrorIs(t, err, errBegin)
+	})
+
+	t.Run("retryable error is retried up to the max attempts", func(t *testing.T) {
+		executor, db := newExecutor(t)
+		db.On("BeginTx", mock.Anything, mock.Anything).Return(nil, errBegin).Times(3)
+
+		err := executor.RunInTransaction(context.Background(), mocks.NewDatabase(t), func(ctx context.Context, tx sqlpkg.Tx) error {
+			return nil
+		}, retryable, sqlpkg.Options{Retry: &sqlpkg.Retry{MaxAttempts: 3, Backoff: time.Millisecond}})
+
+		assert.ErrorIs(t, err, errBegin)
+	})
+
+	t.Run("other error is not retried", func(t *testing.T) {
+		executor, db := newExecutor(t)
+		errRefused := errors.New("connection refused")
+		db.On("BeginTx", mock.Anything, mock.Anything).Return(nil, errRefused).Once()
+
+		err := executor.RunInTransaction(context.Background(), mocks.NewDatabase(t), func(ctx context.Context, tx sqlpkg.Tx) error {
+			return nil
+		}, retryable, sqlpkg.Options{Retry: &sqlpkg.Retry{MaxAttempts: 3}})
+
+		assert.ErrorIs(t, err, errRefused)
+	})
+
+	t.Run("retries stop once the context is done", func(t *testing.T) {
+		executor, db := newExecutor(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		db.On("BeginTx", mock.Anything, mock.Anything).Return(nil, errBegin).Once().Run(func(args mock.Arguments) {
+			cancel()
+		})
+
+		err := executor.RunInTransaction(ctx, mocks.NewDatabase(t), func(ctx context.Context, tx sqlpkg.Tx) error {
+			return nil
+		}, retryable, sqlpkg.Options{Retry: &sqlpkg.Retry{MaxAttempts: 3, Backoff: time.Hour}})
+
+		assert.ErrorIs(t, err, errBegin)
+	})
+}
@@ -0,0 +1,214 @@
+package common
+
+import (
+	"context"
+	driver "database/sql"
+	"errors"
+	"iter"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+)
+
+/*
+transaction is the transaction begun by the executor, it is also the database handle of the transaction:
+every operation runs on the database of the dialect with Options.Transaction set to the transaction, unless another transaction is set.
+The savepoints are set with the queries of the parser.
+*/
+type transaction struct {
+	db     sql.Database
+	tx     *driver.Tx
+	parser Parser
+	// cancel releases the context of the deadline of the transaction, nil if the transaction has none of its own
+	cancel context.CancelFunc
+}
+
+// newTransaction returns the handle of the transaction, running its operations on the database
+func newTransaction(db sql.Database, tx *driver.Tx, parser Parser) *transaction {
+	return &transaction{db: db, tx: tx, parser: parser}
+}
+
+// withCancel sets the function releasing the context the transaction is begun with once it ends
+func (t *transaction) withCancel(cancel context.CancelFunc) *transaction {
+	t.cancel = cancel
+	return t
+}
+
+// release releases the context the transaction is begun with, if any
+func (t *transaction) release() {
+	if t.cancel != nil {
+		t.cancel()
+	}
+}
+
+// DriverTx returns the driver transaction, for the operations to run in it
+func (t *transaction) DriverTx() *driver.Tx {
+	return t.tx
+}
+
+// options returns the options of the operation with the transaction set, unless another transaction is set
+func (t *transaction) options(options []sql.Options) sql.Options {
+	opt := sql.GetOptions(options...)
+	if opt.Transaction == nil {
+		opt.Transaction = t
+	}
+	return opt
+}
+
+func (t *transaction) Commit() error {
+	defer t.release()
+	return t.tx.Commit()
+}
+
+func (t *transaction) Rollback() error {
+	defer t.release()
+	return t.tx.Rollback()
+}
+
+func (t *transaction) Savepoint(ctx context.Context, name string) error {
+	query, err := t.parser.ParseSavepointQuery(name)
+	if err != nil {
+		return err
+	}
+	return t.exec(ctx, query)
+}
+
+func (t *transaction) RollbackTo(ctx context.Context, name string) error {
+	query, err := t.parser.ParseRollbackToSavepointQuery(name)
+	if err != nil {
+		return err
+	}
+	return t.exec(ctx, query)
+}
+
+func (t *transaction) Release(ctx context.Context, name string) error {
+	query, err := t.parser.ParseReleaseSavepointQuery(name)
+	if err != nil {
+		return err
+	}
+	// mssql has no query to release a savepoint
+	if query == "" {
+		return nil
+	}
+	return t.exec(ctx, query)
+}
+
+// exec runs the savepoint query in the transaction
+func (t *transaction) exec(ctx context.Context, query string) error {
+	if _, err := t.tx.ExecContext(ctx, query); err != nil {
+		return internal.HandleContextError(ctx, err)
+	}
+	return nil
+}
+
+// Ping pings the database of the transaction
+func (t *transaction) Ping(ctx context.Context) error {
+	return t.db.Ping(ctx)
+}
+
+// Close rolls the transaction back unless it is committed, the database is not closed
+func (t *transaction) Close(ctx context.Context) error {
+	defer t.release()
+	if err := t.tx.Rollback(); err != nil && !errors.Is(err, driver.ErrTxDone) {
+		return internal.HandleError(err)
+	}
+	return nil
+}
+
+func (t *transaction) Insert(ctx context.Context, record sql.Record, options ...sql.Options) error {
+	return t.db.Insert(ctx, record, t.options(options))
+}
+
+func (t *transaction) InsertMany(ctx context.Context, records []sql.Record, options ...sql.Options) (int64, error) {
+	return t.db.InsertMany(ctx, records, t.options(options))
+}
+
+func (t *transaction) Upsert(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
+	return t.db.Upsert(ctx, record, t.options(options))
+}
+
+func (t *transaction) UpsertMany(ctx context.Context, records []sql.Record, options ...sql.Options) (int64, int64, error) {
+	return t.db.UpsertMany(ctx, records, t.options(options))
+}
+
+func (t *transaction) GetByID(ctx context.Context, record sql.Record, options ...sql.Options) error {
+	return t.db.GetByID(ctx, record, t.options(options))
+}
+
+func (t *transaction) GetByIDs(ctx context.Context, ids []int64, records sql.Records, options ...sql.Options) ([]int64, error) {
+	return t.db.GetByIDs(ctx, ids, records, t.options(options))
+}
+
+func (t *transaction) Get(ctx context.Context, filter *sql.Filter, values []any, records sql.Records, options ...sql.Options) error {
+	return t.db.Get(ctx, filter, values, records, t.options(options))
+}
+
+func (t *transaction) Explain(ctx context.Context, filter *sql.Filter, values []any, records sql.Records, options ...sql.Options) (*sql.QueryPlan, error) {
+	return t.db.Explain(ctx, filter, values, records, t.options(options))
+}
+
+func (t *transaction) UpdateByID(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
+	return t.db.UpdateByID(ctx, record, t.options(options))
+}
+
+func (t *transaction) UpdateManyByID(ctx context.Context, records []sql.Record, options ...sql.Options) ([]int64, error) {
+	return t.db.UpdateManyByID(ctx, records, t.options(options))
+}
+
+func (t *transaction) Update(ctx context.Context, table *sql.Table, updates *sql.Updates, condition *sql.Condition, values []any, options ...sql.Options) (int64, error) {
+	return t.db.Update(ctx, table, updates, condition, values, t.options(options))
+}
+
+func (t *transaction) UpdateReturning(ctx context.Context, updates *sql.Updates, condition *sql.Condition, values []any, records sql.Records, options ...sql.Options) (int64, error) {
+	return t.db.UpdateReturning(ctx, updates, condition, values, records, t.options(options))
+}
+
+func (t *transaction) SoftDeleteByID(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
+	return t.db.SoftDeleteByID(ctx, record, t.options(options))
+}
+
+func (t *transaction) SoftDeleteByIDs(ctx context.Context, table *sql.Table, ids []int64, options ...sql.Options) (int64, error) {
+	return t.db.SoftDeleteByIDs(ctx, table, ids, t.options(options))
+}
+
+func (t *transaction) SoftDelete(ctx context.Context, table *sql.Table, condition *sql.Condition, values []any, options ...sql.Options) (int64, error) {
+	return t.db.SoftDelete(ctx, table, condition, values, t.options(options))
+}
+
+func (t *transaction) DeleteByID(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
+	return t.db.DeleteByID(ctx, record, t.options(options))
+}
+
+func (t *transaction) DeleteByIDs(ctx context.Context, table *sql.Table, ids []int64, options ...sql.Options) (int64, error) {
+	return t.db.DeleteByIDs(ctx, table, ids, t.options(options))
+}
+
+func (t *transaction) Delete(ctx context.Context, table *sql.Table, condition *sql.Condition, values []any, options ...sql.Options) (int64, error) {
+	return t.db.Delete(ctx, table, condition, values, t.options(options))
+}
+
+func (t *transaction) DeleteReturning(ctx context.Context, condition *sql.Condition, values []any, records sql.Records, options ...sql.Options) (int64, error) {
+	return t.db.DeleteReturning(ctx, condition, values, records, t.options(options))
+}
+
+func (t *transaction) BulkLoad(ctx context.Context, table *sql.Table, columns []string, source iter.Seq[sql.Record], options ...sql.Options) (int64, error) {
+	return t.db.BulkLoad(ctx, table, columns, source, t.options(options))
+}
+
+func (t *transaction) RunSP(ctx context.Context, spName string, values []any, result sql.SPResult, options ...sql.Options) error {
+	return t.db.RunSP(ctx, spName, values, result, t.options(options))
+}
+
+// BeginTransaction returns an error as the transaction is already begun, use RunInTransaction or Savepoint to nest a transaction
+func (t *transaction) BeginTransaction(ctx context.Context, options ...sql.Options) (sql.Tx, error) {
+	return nil, sql.NewInvalidQueryError("begin transaction:: the transaction is already begun, use RunInTransaction or Savepoint to nest a transaction")
+}
+
+// RunInTransaction runs fn in a savepoint of the transaction, see sql.Database.RunInTransaction
+func (t *transaction) RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx sql.Tx) error, options ...sql.Options) error {
+	return t.db.RunInTransaction(ctx, fn, t.options(options))
+}
+
+func (t *transaction) ToSQL() sql.Renderer {
+	return t.db.ToSQL()
+}
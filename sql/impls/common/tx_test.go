@@ -0,0 +1,105 @@
+package common
+
+import (
+	"context"
+	driver "database/sql"
+	"testing"
+	"time"
+
+	"github.com/gofreego/database/mocks"
+	sqlpkg "github.com/gofreego/database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransaction_RoutesOperations(t *testing.T) {
+	t.Run("operations run in the transaction", func(t *testing.T) {
+		db := mocks.NewDatabase(t)
+		tx := newTransaction(db, nil, mocks.NewParser(t))
+		record := mocks.NewRecord(t)
+		db.On("Insert", mock.Anything, record, sqlpkg.Options{Transaction: tx, PreparedName: "insert_user"}).Return(nil)
+
+		err := tx.Insert(context.Background(), record, sqlpkg.Options{PreparedName: "insert_user"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("operations without options run in the transaction", func(t *testing.T) {
+		db := mocks.NewDatabase(t)
+		tx := newTransaction(db, nil, mocks.NewParser(t))
+		table := sqlpkg.NewTable("users")
+		db.On("DeleteByIDs", mock.Anything, table, []int64{1, 2}, sqlpkg.Options{Transaction: tx}).Return(int64(2), nil)
+
+		rowsAffected, err := tx.DeleteByIDs(context.Background(), table, []int64{1, 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), rowsAffected)
+	})
+
+	t.Run("another transaction of the options is kept", func(t *testing.T) {
+		db := mocks.NewDatabase(t)
+		tx := newTransaction(db, nil, mocks.NewParser(t))
+		other := mocks.NewTransaction(t)
+		record := mocks.NewRecord(t)
+		db.On("GetByID", mock.Anything, record, sqlpkg.Options{Transaction: other}).Return(nil)
+
+		err := tx.GetByID(context.Background(), record, sqlpkg.Options{Transaction: other})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("nested RunInTransaction runs in the transaction", func(t *testing.T) {
+		db := mocks.NewDatabase(t)
+		tx := newTransaction(db, nil, mocks.NewParser(t))
+		db.On("RunInTransaction", mock.Anything, mock.Anything, sqlpkg.Options{Transaction: tx}).Return(nil)
+
+		err := tx.RunInTransaction(context.Background(), func(ctx context.Context, tx sqlpkg.Tx) error {
+			return nil
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("another transaction cannot begin", func(t *testing.T) {
+		tx := newTransaction(mocks.NewDatabase(t), nil, mocks.NewParser(t))
+
+		_, err := tx.BeginTransaction(context.Background())
+
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsQueryError())
+	})
+}
+
+func TestTransaction_Savepoint(t *testing.T) {
+	conn := driver.OpenDB(stmtConnector{})
+	defer conn.Close()
+
+	t.Run("runs the savepoint query in the transaction", func(t *testing.T) {
+		txn, err := conn.Begin()
+		assert.NoError(t, err)
+		defer txn.Rollback()
+		parser := mocks.NewParser(t)
+		parser.On("ParseSavepointQuery", "before_import").Return("SAVEPOINT before_import", nil)
+
+		err = newTransaction(mocks.NewDatabase(t), txn, parser).Savepoint(context.Background(), "before_import")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("deadline of the context exceeded", func(t *testing.T) {
+		txn, err := conn.Begin()
+		assert.NoError(t, err)
+		defer txn.Rollback()
+		parser := mocks.NewParser(t)
+		parser.On("ParseRollbackToSavepointQuery", "before_import").Return("ROLLBACK TO SAVEPOINT before_import", nil)
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancel()
+
+		err = newTransaction(mocks.NewDatabase(t), txn, parser).RollbackTo(ctx, "before_import")
+
+		var sqlErr *sqlpkg.Error
+		assert.ErrorAs(t, err, &sqlErr)
+		assert.True(t, sqlErr.IsTimeout())
+	})
+}
@@ -18,12 +18,16 @@ func (c *Executor) UpdateByID(ctx context.Context, record sql.Record, options ..
 		return false, sql.NewInvalidQueryError("update by id:: record cannot be nil")
 	}
 
-	opt := sql.GetOptions(options...)
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
 	values := record.Values()
 	values = append(values, record.ID())
 
 	var res driver.Result
-	var err error
 	var query string
 	if opt.PreparedName != "" {
 		var stmt *internal.PreparedStatement
@@ -51,7 +55,9 @@ func (c *Executor) UpdateByID(ctx context.Context, record sql.Record, options ..
 			if err != nil {
 				return false, err
 			}
-			res, err = txn.ExecContext(ctx, stmt.GetQuery(), values...)
+			txStmt := txn.StmtContext(ctx, stmt.GetStatement())
+			defer txStmt.Close()
+			res, err = txStmt.ExecContext(ctx, values...)
 		} else {
 			res, err = stmt.GetStatement().ExecContext(ctx, values...)
 		}
@@ -75,7 +81,7 @@ func (c *Executor) UpdateByID(ctx context.Context, record sql.Record, options ..
 		}
 	}
 	if err != nil {
-		return false, internal.HandleError(err)
+		return false, internal.HandleContextError(ctx, err)
 	}
 	rowsAffected, err := res.RowsAffected()
 	if err != nil {
@@ -86,27 +92,31 @@ func (c *Executor) UpdateByID(ctx context.Context, record sql.Record, options ..
 
 // Update implements sql.Database.
 func (c *Executor) Update(ctx context.Context, table *sql.Table, updates *sql.Updates, condition *sql.Condition, values []any, options ...sql.Options) (int64, error) {
-	opt := sql.GetOptions(options...)
-	var err error
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
 	var result driver.Result
 	var query string
-	var valueIndexes []int
+	var params []sql.Param
 	if opt.PreparedName != "" {
 		var stmt *internal.PreparedStatement
 		var ok bool
 		// if prepared statement is not found, parse the query and create a new prepared statement
 		{
 			if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
-				query, valueIndexes, err = c.parser.ParseUpdateQuery(table, updates, condition)
+				query, params, err = c.parser.ParseUpdateQuery(table, updates, condition)
 				if err != nil {
-					return 0, internal.HandleError(err)
+					return 0, internal.HandleContextError(ctx, err)
 				}
 				logger.Debug(ctx, "Update query: %s", query)
 				ps, err := c.db.PrepareContext(ctx, query)
 				if err != nil {
-					return 0, internal.HandleError(err)
+					return 0, internal.HandleContextError(ctx, err)
 				}
-				stmt = internal.NewPreparedStatement(ps).WithValueIndexes(valueIndexes)
+				stmt = internal.NewPreparedStatement(ps).WithParams(params).WithQuery(query)
 				c.preparedStatements.Add(opt.PreparedName, stmt)
 			}
 		}
@@ -117,14 +127,16 @@ func (c *Executor) Update(ctx context.Context, table *sql.Table, updates *sql.Up
 			if err != nil {
 				return 0, err
 			}
-			result, err = txn.ExecContext(ctx, stmt.GetQuery(), sql.GetValues(valueIndexes, values)...)
+			txStmt := txn.StmtContext(ctx, stmt.GetStatement())
+			defer txStmt.Close()
+			result, err = txStmt.ExecContext(ctx, sql.GetParamValues(stmt.GetParams(), values)...)
 		} else {
-			result, err = stmt.GetStatement().ExecContext(ctx, sql.GetValues(valueIndexes, values)...)
+			result, err = stmt.GetStatement().ExecContext(ctx, sql.GetParamValues(stmt.GetParams(), values)...)
 		}
 	} else {
-		query, valueIndexes, err = c.parser.ParseUpdateQuery(table, updates, condition)
+		query, params, err = c.parser.ParseUpdateQuery(table, updates, condition)
 		if err != nil {
-			return 0, internal.HandleError(err)
+			return 0, internal.HandleContextError(ctx, err)
 		}
 		logger.Debug(ctx, "Update query: %s", query)
 		// if transaction is provided, use it to execute the query
@@ -134,17 +146,17 @@ func (c *Executor) Update(ctx context.Context, table *sql.Table, updates *sql.Up
 			if err != nil {
 				return 0, err
 			}
-			result, err = txn.ExecContext(ctx, query, sql.GetValues(valueIndexes, values)...)
+			result, err = txn.ExecContext(ctx, query, sql.GetParamValues(params, values)...)
 		} else {
-			result, err = c.db.ExecContext(ctx, query, sql.GetValues(valueIndexes, values)...)
+			result, err = c.db.ExecContext(ctx, query, sql.GetParamValues(params, values)...)
 		}
 	}
 	if err != nil {
-		return 0, internal.HandleError(err)
+		return 0, internal.HandleContextError(ctx, err)
 	}
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return 0, internal.HandleError(err)
+		return 0, internal.HandleContextError(ctx, err)
 	}
 	return rowsAffected, nil
 }
@@ -32,7 +32,7 @@ func TestExecutor_Update(t *testing.T) {
 		condition := sqlpkg.NewCondition("id", sqlpkg.EQ, sqlpkg.NewIndexedValue(3))
 		values := []any{"Updated Name", "updated@example.com", 1, 123}
 		expectedQuery := "UPDATE users SET name = ?, email = ?, is_active = ? WHERE id = ?"
-		expectedValueIndexes := []int{0, 1, 2, 3}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 1}, {Index: 2}, {Index: 3}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseUpdateQuery", table, updates, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -65,7 +65,7 @@ func TestExecutor_Update(t *testing.T) {
 		condition := sqlpkg.NewCondition("email", sqlpkg.LIKE, sqlpkg.NewIndexedValue(0))
 		values := []any{"%inactive%"}
 		expectedQuery := "UPDATE users SET is_active = ? WHERE email LIKE ?"
-		expectedValueIndexes := []int{0, 0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 0}}
 		preparedName := "update_users_by_email"
 
 		// Mock parser to return the expected query and value indexes
@@ -101,7 +101,7 @@ func TestExecutor_Update(t *testing.T) {
 		condition := sqlpkg.NewCondition("id", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{999} // Non-existent ID
 		expectedQuery := "UPDATE users SET name = ? WHERE id = ?"
-		expectedValueIndexes := []int{0, 0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseUpdateQuery", table, updates, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -134,7 +134,7 @@ func TestExecutor_Update(t *testing.T) {
 		condition := sqlpkg.NewCondition("is_active", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{0}
 		expectedQuery := "UPDATE users SET is_active = ? WHERE is_active = ?"
-		expectedValueIndexes := []int{0, 0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseUpdateQuery", table, updates, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -197,7 +197,7 @@ func TestExecutor_Update(t *testing.T) {
 		condition := sqlpkg.NewCondition("id", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{123}
 		expectedQuery := "UPDATE users SET name = ? WHERE id = ?"
-		expectedValueIndexes := []int{0, 0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 0}}
 		expectedErr := errors.New("connection timeout")
 
 		// Mock parser to return the expected query and value indexes
@@ -233,7 +233,7 @@ func TestExecutor_Update(t *testing.T) {
 		condition := sqlpkg.NewCondition("id", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{123}
 		expectedQuery := "UPDATE users SET name = ? WHERE id = ?"
-		expectedValueIndexes := []int{0, 0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseUpdateQuery", table, updates, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -269,7 +269,7 @@ func TestExecutor_Update(t *testing.T) {
 		condition := sqlpkg.NewCondition("id", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{123}
 		expectedQuery := "UPDATE users SET name = ? WHERE id = ?"
-		expectedValueIndexes := []int{0, 0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseUpdateQuery", table, updates, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -307,7 +307,7 @@ func TestExecutor_Update(t *testing.T) {
 		condition := sqlpkg.NewCondition("id", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 		values := []any{123}
 		expectedQuery := "UPDATE users SET name = ? WHERE id = ?"
-		expectedValueIndexes := []int{0, 0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 0}}
 		preparedName := "update_user"
 		expectedErr := errors.New("syntax error")
 
@@ -400,7 +400,7 @@ func TestExecutor_Update(t *testing.T) {
 			Add("is_active", sqlpkg.NewValue(1))
 		values := []any{1}
 		expectedQuery := "UPDATE users SET is_active = ?"
-		expectedValueIndexes := []int{0}
+		expectedValueIndexes := []sqlpkg.Param{{Index: 0}}
 
 		// Mock parser to return the expected query and value indexes
 		parser.On("ParseUpdateQuery", table, updates, (*sqlpkg.Condition)(nil)).Return(expectedQuery, expectedValueIndexes, nil)
@@ -434,7 +434,7 @@ func BenchmarkExecutor_Update(b *testing.B) {
 	condition := sqlpkg.NewCondition("id", sqlpkg.EQ, sqlpkg.NewIndexedValue(0))
 	values := []any{123}
 	expectedQuery := "UPDATE users SET name = ? WHERE id = ?"
-	expectedValueIndexes := []int{0, 0}
+	expectedValueIndexes := []sqlpkg.Param{{Index: 0}, {Index: 0}}
 
 	// Mock parser to return the expected query and value indexes
 	parser.On("ParseUpdateQuery", table, updates, condition).Return(expectedQuery, expectedValueIndexes, nil)
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"context"
+	driver "database/sql"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/goutils/logger"
+)
+
+/*
+UpdateManyByID updates the records by their IDs, every record with its own values.
+The records are updated in batches with a single statement per batch.
+Returns the rows affected by every batch in order, with the rows affected by the executed batches if a batch fails.
+Returns nil, nil if no records are provided.
+Query will not be prepared because of variable length of records, if you want to prepare the query, use UpdateByID instead.
+*/
+func (c *Executor) UpdateManyByID(ctx context.Context, records []sql.Record, options ...sql.Options) ([]int64, error) {
+	if len(records) == 0 {
+		return nil, nil
+	}
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
+	var txn *driver.Tx
+	if opt.Transaction != nil {
+		txn, err = internal.GetTransaction(opt.Transaction)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// the id and the values of every record are bound as parameters
+	size := c.batchSize(opt, len(records[0].Values())+1)
+	rowsAffected := make([]int64, 0, (len(records)+size-1)/size)
+	for start := 0; start < len(records); start += size {
+		batch := records[start:min(start+size, len(records))]
+		query, values, err := c.parser.ParseUpdateManyByIDQuery(batch...)
+		if err != nil {
+			return rowsAffected, internal.HandleContextError(ctx, err)
+		}
+		logger.Debug(ctx, "UpdateManyByID query: %s", query)
+		var res driver.Result
+		if txn != nil {
+			res, err = txn.ExecContext(ctx, query, values...)
+		} else {
+			res, err = c.db.ExecContext(ctx, query, values...)
+		}
+		if err != nil {
+			return rowsAffected, internal.HandleContextError(ctx, err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return rowsAffected, internal.HandleContextError(ctx, err)
+		}
+		rowsAffected = append(rowsAffected, affected)
+	}
+	return rowsAffected, nil
+}
+
+// batchSize returns the number of records or IDs sent in one statement by the bulk operations,
+// paramsPerRecord is the number of parameters bound for every record or ID.
+// Options.BatchSize is used when it is set, otherwise the batch is as large as the parser's limits allow.
+func (c *Executor) batchSize(opt sql.Options, paramsPerRecord int) int {
+	if opt.BatchSize > 0 {
+		return opt.BatchSize
+	}
+	size := c.parser.MaxParams() / max(paramsPerRecord, 1)
+	if maxRows := c.parser.MaxRows(); maxRows > 0 {
+		size = min(size, maxRows)
+	}
+	return max(size, 1)
+}
@@ -0,0 +1,128 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofreego/database/mocks"
+	sqlpkg "github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecutor_UpdateManyByID(t *testing.T) {
+	newRecords := func(t *testing.T, n int) []sqlpkg.Record {
+		records := make([]sqlpkg.Record, n)
+		for i := range records {
+			record := mocks.NewRecord(t)
+			// distinct values tell the mock records apart in the parser expectations
+			record.On("Values").Return([]any{"name", i}).Maybe()
+			records[i] = record
+		}
+		return records
+	}
+
+	t.Run("records are batched by the parser limits", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 5)
+
+		// 3 parameters for every record, 7 parameters fit 2 records
+		parser.On("MaxParams").Return(7)
+		parser.On("MaxRows").Return(0)
+		parser.On("ParseUpdateManyByIDQuery", records[0], records[1]).Return("batch 1", []any{1}, nil)
+		parser.On("ParseUpdateManyByIDQuery", records[2], records[3]).Return("batch 2", []any{2}, nil)
+		parser.On("ParseUpdateManyByIDQuery", records[4]).Return("batch 3", []any{3}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 2}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2", 2).Return(&mockResult{rowsAffected: 1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 3", 3).Return(&mockResult{rowsAffected: 1}, nil)
+
+		rowsAffected, err := executor.UpdateManyByID(context.Background(), records)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 1, 1}, rowsAffected)
+	})
+
+	t.Run("batch size is limited by the rows limit", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 2)
+
+		parser.On("MaxParams").Return(2100)
+		parser.On("MaxRows").Return(1)
+		parser.On("ParseUpdateManyByIDQuery", records[0]).Return("batch 1", []any{1}, nil)
+		parser.On("ParseUpdateManyByIDQuery", records[1]).Return("batch 2", []any{2}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2", 2).Return(&mockResult{rowsAffected: 0}, nil)
+
+		rowsAffected, err := executor.UpdateManyByID(context.Background(), records)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 0}, rowsAffected)
+	})
+
+	t.Run("batch size option overrides the parser limits", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 3)
+
+		parser.On("ParseUpdateManyByIDQuery", records[0], records[1], records[2]).Return("batch 1", []any{1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 3}, nil)
+
+		rowsAffected, err := executor.UpdateManyByID(context.Background(), records, sqlpkg.Options{BatchSize: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3}, rowsAffected)
+	})
+
+	t.Run("failed batch returns the rows affected by the executed batches", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 3)
+
+		parser.On("ParseUpdateManyByIDQuery", records[0]).Return("batch 1", []any{1}, nil)
+		parser.On("ParseUpdateManyByIDQuery", records[1]).Return("batch 2", []any{2}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2", 2).Return(nil, errors.New("connection reset"))
+
+		rowsAffected, err := executor.UpdateManyByID(context.Background(), records, sqlpkg.Options{BatchSize: 1})
+
+		assert.Error(t, err)
+		assert.Equal(t, []int64{1}, rowsAffected)
+	})
+
+	t.Run("no records", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		rowsAffected, err := executor.UpdateManyByID(context.Background(), nil)
+
+		assert.NoError(t, err)
+		assert.Nil(t, rowsAffected)
+	})
+}
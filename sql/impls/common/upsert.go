@@ -3,85 +3,251 @@ package common
 import (
 	"context"
 	driver "database/sql"
+	"slices"
 
 	"github.com/gofreego/database/sql"
 	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/goutils/logger"
 )
 
 /*
 Upsert inserts a record into the database if it doesn't exist, otherwise it updates the record.
-Returns the number of rows affected and an error if any.
-Returns 0, nil if no records are provided.
-Returns 0, sql.ErrNoRecordInserted if no records are inserted.
+The conflict target and the updates are set with Options.Conflict.
+Returns true if the record is inserted, false if the existing row is updated or left unchanged, and an error if any.
+The ID of the record is set to the ID of the inserted or updated row.
 */
-
 func (c *Executor) Upsert(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
-	opt := sql.GetOptions(options...)
-	var err error
-	var res driver.Result
-	var query string
-	var values []any
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
+	// the values are parsed for every upsert, they are not only the values of the record
+	query, values, err := c.parser.ParseUpsertQuery(opt.Conflict, record)
+	if err != nil {
+		return false, internal.HandleContextError(ctx, err)
+	}
+	var prepared *driver.Stmt
 	if opt.PreparedName != "" {
 		var stmt *internal.PreparedStatement
 		var ok bool
-		// if prepared statement is not found, parse the query and create a new prepared statement
+		// if prepared statement is not found, create a new prepared statement
 		{
 			if stmt, ok = c.preparedStatements.Get(opt.PreparedName); !ok {
-				query, values, err = c.parser.ParseUpsertQuery(record)
-				if err != nil {
-					return false, internal.HandleError(err)
-				}
 				ps, err := c.db.PrepareContext(ctx, query)
 				if err != nil {
-					return false, internal.HandleError(err)
+					return false, internal.HandleContextError(ctx, err)
 				}
-				stmt = internal.NewPreparedStatement(ps)
-				c.preparedStatements[opt.PreparedName] = stmt
+				stmt = internal.NewPreparedStatement(ps).WithQuery(query)
+				c.preparedStatements.Add(opt.PreparedName, stmt)
 			}
 		}
-		// if transaction is provided, use it to execute the query
-		if opt.Transaction != nil {
-			var txn *driver.Tx
-			txn, err = internal.GetTransaction(opt.Transaction)
-			if err != nil {
-				return false, err
+		prepared, query = stmt.GetStatement(), stmt.GetQuery()
+	}
+	// if transaction is provided, use it to execute the query
+	var txn *driver.Tx
+	if opt.Transaction != nil {
+		txn, err = internal.GetTransaction(opt.Transaction)
+		if err != nil {
+			return false, err
+		}
+	}
+	return c.execUpsert(ctx, txn, prepared, query, values, record)
+}
+
+/*
+execUpsert runs the upsert query of the record and sets its ID.
+The prepared statement is used if it is not nil, rebound to the transaction if the transaction is not nil,
+otherwise the query runs in the transaction if it is not nil, otherwise on the database.
+If the parser supports it the query returns the ID and whether the row was inserted, no row if the existing row is unchanged.
+Otherwise 1 row affected is an insert and 2 rows affected an update, as mysql counts an updated row twice,
+and the ID is LastInsertId which the query sets to the ID of the existing row on conflict.
+Returns true if the record is inserted.
+*/
+func (c *Executor) execUpsert(ctx context.Context, txn *driver.Tx, stmt *driver.Stmt, query string, values []any, record sql.Record) (bool, error) {
+	// run the prepared statement in the transaction
+	if txn != nil && stmt != nil {
+		stmt = txn.StmtContext(ctx, stmt)
+		defer stmt.Close()
+	}
+	if c.parser.InsertReturnsIDs() {
+		var row *driver.Row
+		switch {
+		case stmt != nil:
+			row = stmt.QueryRowContext(ctx, values...)
+		case txn != nil:
+			row = txn.QueryRowContext(ctx, query, values...)
+		default:
+			row = c.db.QueryRowContext(ctx, query, values...)
+		}
+		var id int64
+		var inserted bool
+		if err := row.Scan(&id, &inserted); err != nil {
+			// no row is returned if the existing row is left unchanged
+			if err == driver.ErrNoRows {
+				return false, nil
 			}
-			res, err = txn.ExecContext(ctx, stmt.GetQuery(), values...)
+			return false, internal.HandleContextError(ctx, err)
+		}
+		record.SetID(id)
+		return inserted, nil
+	}
+	var res driver.Result
+	var err error
+	switch {
+	case stmt != nil:
+		res, err = stmt.ExecContext(ctx, values...)
+	case txn != nil:
+		res, err = txn.ExecContext(ctx, query, values...)
+	default:
+		res, err = c.db.ExecContext(ctx, query, values...)
+	}
+	if err != nil {
+		return false, internal.HandleContextError(ctx, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, internal.HandleContextError(ctx, err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return false, internal.HandleContextError(ctx, err)
+	}
+	// the existing row is unchanged if no row is affected, its ID is still set by the query
+	if id != 0 {
+		record.SetID(id)
+	}
+	return rowsAffected == 1, nil
+}
+
+/*
+UpsertMany inserts the records or updates the rows they conflict with, see Upsert and Options.Conflict.
+The records are upserted in batches within the parser's parameter and row limits, with a single statement per batch, see Options.BatchSize.
+With Options.Atomic the batches run in one transaction, unless Options.Transaction is set.
+Returns the number of records inserted and updated, with the counts of the executed batches if a batch fails outside a transaction.
+The IDs of the records are set if the query returns them and no record of the batch leaves its existing row unchanged.
+On mysql the counts are derived from the rows affected, where an inserted row counts 1 and an updated row 2,
+they are exact unless the existing rows of some records are left unchanged, and the IDs are not set.
+Returns 0, 0, nil if no records are provided.
+Query will not be prepared because of variable length of records, if you want to prepare the query, use Upsert instead.
+*/
+func (c *Executor) UpsertMany(ctx context.Context, records []sql.Record, options ...sql.Options) (int64, int64, error) {
+	if len(records) == 0 {
+		return 0, 0, nil
+	}
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return 0, 0, err
+	}
+	ctx, cancel := WithTimeout(ctx, opt, c.timeouts.Write)
+	defer cancel()
+	// the id of every record can be bound as well as its values
+	size := c.batchSize(opt, len(records[0].Values())+1)
+	if opt.Transaction != nil {
+		txn, err := internal.GetTransaction(opt.Transaction)
+		if err != nil {
+			return 0, 0, err
+		}
+		return c.upsertBatches(ctx, txn, records, size, opt.Conflict)
+	}
+	if !opt.Atomic || len(records) <= size {
+		return c.upsertBatches(ctx, nil, records, size, opt.Conflict)
+	}
+	txn, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, internal.HandleContextError(ctx, err)
+	}
+	inserted, updated, err := c.upsertBatches(ctx, txn, records, size, opt.Conflict)
+	if err != nil {
+		// nothing is upserted once the transaction is rolled back
+		_ = txn.Rollback()
+		return 0, 0, err
+	}
+	if err := txn.Commit(); err != nil {
+		return 0, 0, internal.HandleContextError(ctx, err)
+	}
+	return inserted, updated, nil
+}
+
+// upsertBatches executes the upsert query of every batch, in the transaction if it is not nil.
+func (c *Executor) upsertBatches(ctx context.Context, txn *driver.Tx, records []sql.Record, size int, conflict *sql.Conflict) (int64, int64, error) {
+	var inserted, updated int64
+	for batch := range slices.Chunk(records, size) {
+		query, values, err := c.parser.ParseUpsertQuery(conflict, batch...)
+		if err != nil {
+			return inserted, updated, internal.HandleContextError(ctx, err)
+		}
+		logger.Debug(ctx, "UpsertMany query: %s", query)
+		batchInserted, batchUpdated, err := c.execUpsertBatch(ctx, txn, query, values, batch, conflict)
+		inserted += batchInserted
+		updated += batchUpdated
+		if err != nil {
+			return inserted, updated, err
+		}
+	}
+	return inserted, updated, nil
+}
+
+// execUpsertBatch runs the upsert query of the records in the transaction if it is not nil, otherwise on the database.
+// Returns the number of records inserted and updated.
+func (c *Executor) execUpsertBatch(ctx context.Context, txn *driver.Tx, query string, values []any, records []sql.Record, conflict *sql.Conflict) (int64, int64, error) {
+	if c.parser.InsertReturnsIDs() {
+		var rows *driver.Rows
+		var err error
+		if txn != nil {
+			rows, err = txn.QueryContext(ctx, query, values...)
 		} else {
-			res, err = stmt.GetStatement().ExecContext(ctx, values...)
+			rows, err = c.db.QueryContext(ctx, query, values...)
 		}
-	} else {
-		query, values, err = c.parser.ParseUpsertQuery(record)
 		if err != nil {
-			return false, internal.HandleError(err)
+			return 0, 0, internal.HandleContextError(ctx, err)
 		}
-		// if transaction is provided, use it to execute the query
-		if opt.Transaction != nil {
-			var txn *driver.Tx
-			txn, err = internal.GetTransaction(opt.Transaction)
-			if err != nil {
-				return false, err
+		defer rows.Close()
+		var inserted, updated int64
+		ids := make([]int64, 0, len(records))
+		for rows.Next() {
+			var id int64
+			var isInserted bool
+			if err := rows.Scan(&id, &isInserted); err != nil {
+				return inserted, updated, internal.HandleContextError(ctx, err)
 			}
-			res, err = txn.ExecContext(ctx, query, values...)
-		} else {
-			res, err = c.db.ExecContext(ctx, query, values...)
+			if isInserted {
+				inserted++
+			} else {
+				updated++
+			}
+			ids = append(ids, id)
 		}
+		if err := rows.Err(); err != nil {
+			return inserted, updated, internal.HandleContextError(ctx, err)
+		}
+		// the rows are returned in the order of the records, they can only be matched if every record returned its row
+		if len(ids) == len(records) {
+			for i, record := range records {
+				record.SetID(ids[i])
+			}
+		}
+		return inserted, updated, nil
+	}
+	var res driver.Result
+	var err error
+	if txn != nil {
+		res, err = txn.ExecContext(ctx, query, values...)
+	} else {
+		res, err = c.db.ExecContext(ctx, query, values...)
 	}
-	// handle error
 	if err != nil {
-		return false, internal.HandleError(err)
+		return 0, 0, internal.HandleContextError(ctx, err)
 	}
 	rowsAffected, err := res.RowsAffected()
 	if err != nil {
-		return false, internal.HandleError(err)
-	}
-	if rowsAffected == 0 {
-		return false, sql.ErrNoRecordInserted
+		return 0, 0, internal.HandleContextError(ctx, err)
 	}
-	id, err := res.LastInsertId()
-	if err != nil {
-		return false, internal.HandleError(err)
+	if conflict != nil && conflict.DoNothing {
+		return rowsAffected, 0, nil
 	}
-	record.SetID(id)
-	return true, nil
+	// rows affected = inserted + 2 * updated, assuming every record is either inserted or updated
+	updated := min(max(rowsAffected-int64(len(records)), 0), int64(len(records)))
+	return rowsAffected - 2*updated, updated, nil
 }
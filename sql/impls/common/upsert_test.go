@@ -0,0 +1,237 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofreego/database/mocks"
+	sqlpkg "github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecutor_Upsert(t *testing.T) {
+	tests := []struct {
+		name         string
+		result       *mockResult
+		wantInserted bool
+		wantID       int64
+	}{
+		{"one row affected is an insert", &mockResult{rowsAffected: 1, lastInsertId: 5}, true, 5},
+		{"two rows affected is an update", &mockResult{rowsAffected: 2, lastInsertId: 3}, false, 3},
+		{"no row affected leaves the existing row unchanged", &mockResult{rowsAffected: 0, lastInsertId: 3}, false, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := mocks.NewDB(t)
+			parser := mocks.NewParser(t)
+			record := mocks.NewRecord(t)
+			executor := &Executor{
+				db:                 db,
+				parser:             parser,
+				preparedStatements: internal.NewPreparedStatements(),
+			}
+			conflict := &sqlpkg.Conflict{Columns: []string{"email"}}
+
+			parser.On("InsertReturnsIDs").Return(false)
+			parser.On("ParseUpsertQuery", conflict, record).Return("upsert", []any{"John Doe"}, nil)
+			db.On("ExecContext", mock.Anything, "upsert", "John Doe").Return(tt.result, nil)
+			record.On("SetID", tt.wantID).Return()
+
+			inserted, err := executor.Upsert(context.Background(), record, sqlpkg.Options{Conflict: conflict})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantInserted, inserted)
+		})
+	}
+
+	t.Run("parser error", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		record := mocks.NewRecord(t)
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		parser.On("ParseUpsertQuery", (*sqlpkg.Conflict)(nil), record).Return("", nil, errors.New("no columns to update"))
+
+		inserted, err := executor.Upsert(context.Background(), record)
+
+		assert.Error(t, err)
+		assert.False(t, inserted)
+	})
+
+	t.Run("database execution error", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		record := mocks.NewRecord(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		parser.On("InsertReturnsIDs").Return(false)
+		parser.On("ParseUpsertQuery", (*sqlpkg.Conflict)(nil), record).Return("upsert", []any{"John Doe"}, nil)
+		db.On("ExecContext", mock.Anything, "upsert", "John Doe").Return(nil, errors.New("connection reset"))
+
+		inserted, err := executor.Upsert(context.Background(), record)
+
+		assert.Error(t, err)
+		assert.False(t, inserted)
+		record.AssertNotCalled(t, "SetID", mock.Anything)
+	})
+}
+
+func TestExecutor_UpsertMany(t *testing.T) {
+	newRecords := func(t *testing.T, n int) []sqlpkg.Record {
+		records := make([]sqlpkg.Record, n)
+		for i := range records {
+			record := mocks.NewRecord(t)
+			// distinct values tell the mock records apart in the parser expectations
+			record.On("Values").Return([]any{"name", i}).Maybe()
+			records[i] = record
+		}
+		return records
+	}
+	conflict := &sqlpkg.Conflict{Columns: []string{"email"}}
+
+	t.Run("records are upserted in batches and the counts are derived from the rows affected", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 3)
+
+		parser.On("InsertReturnsIDs").Return(false)
+		parser.On("ParseUpsertQuery", conflict, records[0], records[1]).Return("batch 1", []any{1}, nil)
+		parser.On("ParseUpsertQuery", conflict, records[2]).Return("batch 2", []any{2}, nil)
+		// 1 record inserted and 1 updated, counted twice
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 3}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2", 2).Return(&mockResult{rowsAffected: 1}, nil)
+
+		inserted, updated, err := executor.UpsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 2, Conflict: conflict})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), inserted)
+		assert.Equal(t, int64(1), updated)
+	})
+
+	t.Run("rows affected are inserts if the conflicts do nothing", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 3)
+		doNothing := &sqlpkg.Conflict{Columns: []string{"email"}, DoNothing: true}
+
+		parser.On("InsertReturnsIDs").Return(false)
+		parser.On("ParseUpsertQuery", doNothing, records[0], records[1], records[2]).Return("batch 1", []any{1}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 2}, nil)
+
+		inserted, updated, err := executor.UpsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 3, Conflict: doNothing})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), inserted)
+		assert.Equal(t, int64(0), updated)
+	})
+
+	t.Run("failed batch returns the counts of the executed batches", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 3)
+
+		parser.On("InsertReturnsIDs").Return(false)
+		parser.On("ParseUpsertQuery", conflict, records[0]).Return("batch 1", []any{1}, nil)
+		parser.On("ParseUpsertQuery", conflict, records[1]).Return("batch 2", []any{2}, nil)
+		db.On("ExecContext", mock.Anything, "batch 1", 1).Return(&mockResult{rowsAffected: 2}, nil)
+		db.On("ExecContext", mock.Anything, "batch 2", 2).Return(nil, errors.New("deadlock"))
+
+		inserted, updated, err := executor.UpsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 1, Conflict: conflict})
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), inserted)
+		assert.Equal(t, int64(1), updated)
+	})
+
+	t.Run("parser error", func(t *testing.T) {
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 1)
+
+		parser.On("ParseUpsertQuery", (*sqlpkg.Conflict)(nil), records[0]).Return("", nil, errors.New("no columns to update"))
+
+		_, _, err := executor.UpsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 1})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("returning query error", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		parser := mocks.NewParser(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             parser,
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 2)
+
+		parser.On("InsertReturnsIDs").Return(true)
+		parser.On("ParseUpsertQuery", conflict, records[0], records[1]).Return("batch 1", []any{1}, nil)
+		db.On("QueryContext", mock.Anything, "batch 1", 1).Return(nil, errors.New("database error"))
+
+		inserted, updated, err := executor.UpsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 2, Conflict: conflict})
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), inserted)
+		assert.Equal(t, int64(0), updated)
+	})
+
+	t.Run("atomic upsert fails if the transaction cannot begin", func(t *testing.T) {
+		db := mocks.NewDB(t)
+		executor := &Executor{
+			db:                 db,
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+		records := newRecords(t, 2)
+
+		db.On("BeginTx", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+		_, _, err := executor.UpsertMany(context.Background(), records, sqlpkg.Options{BatchSize: 1, Atomic: true})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("no records", func(t *testing.T) {
+		executor := &Executor{
+			db:                 mocks.NewDB(t),
+			parser:             mocks.NewParser(t),
+			preparedStatements: internal.NewPreparedStatements(),
+		}
+
+		inserted, updated, err := executor.UpsertMany(context.Background(), nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), inserted)
+		assert.Equal(t, int64(0), updated)
+	})
+}
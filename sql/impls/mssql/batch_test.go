@@ -0,0 +1,73 @@
+package mssql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofreego/database/mocks"
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/impls/common"
+	"github.com/gofreego/database/sql/impls/mssql/parser"
+	"github.com/gofreego/database/sql/tests/records"
+	"github.com/stretchr/testify/mock"
+)
+
+// maxRequestParams is the maximum number of parameters of a request, sp_executesql takes 2 of them for the query and its declarations
+const maxRequestParams = 2100
+
+// queryArgs returns the arguments matching a query with n parameters
+func queryArgs(n int) []any {
+	args := []any{mock.Anything, mock.Anything}
+	for range n {
+		args = append(args, mock.Anything)
+	}
+	return args
+}
+
+// TestBatchSize checks that the largest batches of the users table fit in a request with the arguments of sp_executesql
+func TestBatchSize(t *testing.T) {
+	errSent := errors.New("sent")
+	users := make([]sql.Record, 300)
+	for i := range users {
+		users[i] = &records.User{Id: int64(i + 1), Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123"}
+	}
+	tests := []struct {
+		name   string
+		params int
+		run    func(db sql.Database) error
+	}{
+		{
+			// 299 records of 7 values
+			name:   "insert many",
+			params: 2093,
+			run: func(db sql.Database) error {
+				_, err := db.InsertMany(context.Background(), users)
+				return err
+			},
+		},
+		{
+			// 262 records of 7 values and their id
+			name:   "upsert many",
+			params: 2096,
+			run: func(db sql.Database) error {
+				_, _, err := db.UpsertMany(context.Background(), users)
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.params+2 > maxRequestParams {
+				t.Fatalf("%d parameters exceed the limit of a request", tt.params)
+			}
+			conn := mocks.NewDB(t)
+			db := &MssqlDatabase{Executor: common.NewExecutor(conn, parser.NewParser())}
+			// the first batch is the largest, the executor stops once it is sent
+			conn.On("QueryContext", queryArgs(tt.params)...).Return(nil, errSent).Once()
+			if err := tt.run(db); !errors.Is(err, errSent) {
+				t.Errorf("error = %v, want the first batch sent with %d parameters", err, tt.params)
+			}
+		})
+	}
+}
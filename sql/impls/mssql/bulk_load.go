@@ -0,0 +1,51 @@
+package mssql
+
+import (
+	"context"
+	driver "database/sql"
+	"iter"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/impls/common"
+	"github.com/gofreego/database/sql/internal"
+	mssql "github.com/microsoft/go-mssqldb"
+)
+
+// BulkLoad loads the records of the source into the columns of the table with the bulk copy protocol.
+func (c *MssqlDatabase) BulkLoad(ctx context.Context, table *sql.Table, columns []string, source iter.Seq[sql.Record], options ...sql.Options) (int64, error) {
+	if err := common.ValidateBulkLoad(table, columns); err != nil {
+		return 0, err
+	}
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := common.WithTimeout(ctx, opt, c.Timeouts().BulkLoad)
+	defer cancel()
+	return common.InBulkTransaction(ctx, c.db, opt, func(txn *driver.Tx) (int64, error) {
+		// the table name is used in the queries of the bulk copy as it is, the columns are matched by name
+		query := mssql.CopyIn(sql.MSSQL.QuoteIdentifier(table.Name), mssql.BulkOptions{}, columns...)
+		stmt, err := txn.PrepareContext(ctx, query)
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		defer stmt.Close()
+		_, err = common.BulkRows(ctx, source, columns, opt, func(values []any) error {
+			_, err := stmt.ExecContext(ctx, values...)
+			return err
+		})
+		if err != nil {
+			return 0, err
+		}
+		// exec without values sends the buffered rows, its result has the number of rows copied
+		res, err := stmt.ExecContext(ctx)
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		return rows, nil
+	})
+}
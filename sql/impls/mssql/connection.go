@@ -5,6 +5,7 @@ import (
 	driver "database/sql"
 	"fmt"
 
+	"github.com/gofreego/database/sql"
 	"github.com/gofreego/database/sql/impls/common"
 	"github.com/gofreego/database/sql/impls/mssql/parser"
 	"github.com/gofreego/database/sql/internal"
@@ -17,6 +18,41 @@ type Config struct {
 	User     string `yaml:"User" json:"User"`
 	Password string `yaml:"Password" json:"Password"`
 	Database string `yaml:"Database" json:"Database"`
+	// Timeouts are the default timeouts of the operations in milliseconds, overridden by Options.Timeout
+	Timeouts sql.Timeouts `yaml:"Timeouts" json:"Timeouts"`
+	// Replicas are the read replicas of the database, Get and GetByID read from them with the routing
+	Replicas []Replica   `yaml:"Replicas" json:"Replicas"`
+	Routing  sql.Routing `yaml:"Routing" json:"Routing"`
+}
+
+// Replica is the connection of a read replica, the fields not set are the ones of the primary
+type Replica struct {
+	Host     string `yaml:"Host" json:"Host"`
+	Port     int    `yaml:"Port" json:"Port"`
+	User     string `yaml:"User" json:"User"`
+	Password string `yaml:"Password" json:"Password"`
+	Database string `yaml:"Database" json:"Database"`
+}
+
+// replica returns the config of the connection of the replica
+func (c *Config) replica(r Replica) *Config {
+	config := *c
+	if r.Host != "" {
+		config.Host = r.Host
+	}
+	if r.Port != 0 {
+		config.Port = r.Port
+	}
+	if r.User != "" {
+		config.User = r.User
+	}
+	if r.Password != "" {
+		config.Password = r.Password
+	}
+	if r.Database != "" {
+		config.Database = r.Database
+	}
+	return &config
 }
 
 func NewConnection(ctx context.Context, config *Config) (*driver.DB, error) {
@@ -35,12 +71,22 @@ type MssqlDatabase struct {
 }
 
 func NewMssqlDatabase(ctx context.Context, config *Config) (*MssqlDatabase, error) {
+	if err := config.Routing.Validate(); err != nil {
+		return nil, err
+	}
 	conn, err := NewConnection(ctx, config)
 	if err != nil {
 		return nil, err
 	}
+	replicas, err := common.OpenReplicas(len(config.Replicas), func(i int) (internal.DB, error) {
+		return NewConnection(ctx, config.replica(config.Replicas[i]))
+	})
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
 	return &MssqlDatabase{
-		Executor:           common.NewExecutor(conn, parser.NewParser()),
+		Executor:           common.NewExecutor(conn, parser.NewParser()).WithTimeouts(config.Timeouts).WithReplicas(replicas, config.Routing),
 		db:                 conn,
 		parser:             parser.NewParser(),
 		preparedStatements: internal.NewPreparedStatements(),
@@ -0,0 +1,66 @@
+package mssql
+
+import (
+	"context"
+	driver "database/sql"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/impls/common"
+	"github.com/gofreego/database/sql/impls/mssql/parser"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/goutils/logger"
+)
+
+// session is implemented by both *driver.Conn and *driver.Tx
+type session interface {
+	ExecContext(ctx context.Context, query string, args ...any) (driver.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *driver.Row
+}
+
+// Explain returns the query plan for the query Get would run for the filter.
+// SHOWPLAN_XML is a session setting, so the plan is taken on a dedicated connection
+// or on the transaction's connection and the setting is turned off before returning.
+func (c *MssqlDatabase) Explain(ctx context.Context, filter *sql.Filter, values []any, records sql.Records, options ...sql.Options) (*sql.QueryPlan, error) {
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := common.WithTimeout(ctx, opt, c.Timeouts().Read)
+	defer cancel()
+	// the explain query is the get query itself, render it to resolve the values
+	query, err := common.NewRenderer(c.parser).Get(filter, values, records)
+	if err != nil {
+		return nil, internal.HandleContextError(ctx, err)
+	}
+	logger.Debug(ctx, "Explain query: %s", query.SQL)
+
+	var s session
+	if opt.Transaction != nil {
+		var txn *driver.Tx
+		txn, err = internal.GetTransaction(opt.Transaction)
+		if err != nil {
+			return nil, err
+		}
+		s = txn
+	} else {
+		conn, err := c.db.Conn(ctx)
+		if err != nil {
+			return nil, internal.HandleContextError(ctx, err)
+		}
+		defer conn.Close()
+		s = conn
+	}
+	if _, err = s.ExecContext(ctx, parser.ShowPlanOnQuery); err != nil {
+		return nil, internal.HandleContextError(ctx, err)
+	}
+	var plan string
+	err = s.QueryRowContext(ctx, query.SQL, query.Args...).Scan(&plan)
+	// turn the showplan off even if the query failed, the session may be reused
+	if _, offErr := s.ExecContext(ctx, parser.ShowPlanOffQuery); err == nil {
+		err = offErr
+	}
+	if err != nil {
+		return nil, internal.HandleContextError(ctx, err)
+	}
+	return c.parser.ParseQueryPlan(plan)
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/gofreego/database/sql"
@@ -8,23 +9,46 @@ import (
 
 const (
 	deleteByIDQuery     = "DELETE FROM %s WHERE %s = @p1"
-	deleteQuery         = "DELETE FROM %s WHERE %s"
-	softDeleteByIDQuery = "UPDATE %s SET deleted = 1 WHERE %s = @p1"
-	softDeleteQuery     = "UPDATE %s SET deleted = 1 WHERE %s"
+	deleteQuery         = "DELETE FROM %s%s WHERE %s"
+	softDeleteByIDQuery = "UPDATE %s SET [deleted] = 1 WHERE %s = @p1"
+	softDeleteQuery     = "UPDATE %s SET [deleted] = 1 WHERE %s"
 )
 
 func (p *parser) ParseDeleteByIDQuery(record sql.Record) (string, error) {
 	var lastIndex int
-	tableName, err := parseTableName(record.Table(), &lastIndex)
+	tableName, _, err := parseTableName(record.Table(), &lastIndex)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(deleteByIDQuery, tableName, record.IdColumn()), nil
+	idColumn, err := parseIdColumn(record)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(deleteByIDQuery, tableName, idColumn), nil
+}
+
+func (p *parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []sql.Param, error) {
+	return parseDeleteQuery(table, condition, "")
+}
+
+// ParseDeleteReturningQuery returns the delete query of the table of the records that outputs the columns of the records of the deleted rows,
+// eg. DELETE FROM [users] OUTPUT DELETED.[id], DELETED.[name] WHERE [email] = @p1
+// OUTPUT without INTO fails on tables with triggers.
+func (p *parser) ParseDeleteReturningQuery(condition *sql.Condition, records sql.Records) (string, []sql.Param, error) {
+	if records == nil {
+		return "", nil, errors.New("records is nil")
+	}
+	output, err := parseOutputColumns("DELETED", records.Columns())
+	if err != nil {
+		return "", nil, err
+	}
+	return parseDeleteQuery(records.Table(), condition, output)
 }
 
-func (p *parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []int, error) {
+// parseDeleteQuery returns the delete query with the OUTPUT clause of the output columns if any
+func parseDeleteQuery(table *sql.Table, condition *sql.Condition, output string) (string, []sql.Param, error) {
 	var lastIndex int
-	tableName, err := parseTableName(table, &lastIndex)
+	tableName, tableParams, err := parseTableName(table, &lastIndex)
 	if err != nil {
 		return "", nil, err
 	}
@@ -32,21 +56,25 @@ func (p *parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (s
 	if err != nil {
 		return "", nil, err
 	}
-	return fmt.Sprintf(deleteQuery, tableName, conditionStr), values, nil
+	return fmt.Sprintf(deleteQuery, tableName, parseOutput(output), conditionStr), append(tableParams, values...), nil
 }
 
 func (p *parser) ParseSoftDeleteByIDQuery(table *sql.Table, record sql.Record) (string, error) {
 	var lastIndex int
-	tableName, err := parseTableName(table, &lastIndex)
+	tableName, _, err := parseTableName(table, &lastIndex)
+	if err != nil {
+		return "", err
+	}
+	idColumn, err := parseIdColumn(record)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(softDeleteByIDQuery, tableName, record.IdColumn()), nil
+	return fmt.Sprintf(softDeleteByIDQuery, tableName, idColumn), nil
 }
 
-func (p *parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []int, error) {
+func (p *parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []sql.Param, error) {
 	var lastIndex int
-	tableName, err := parseTableName(table, &lastIndex)
+	tableName, tableParams, err := parseTableName(table, &lastIndex)
 	if err != nil {
 		return "", nil, err
 	}
@@ -55,5 +83,5 @@ func (p *parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition
 	if err != nil {
 		return "", nil, err
 	}
-	return fmt.Sprintf(softDeleteQuery, tableName, conditionStr), values, nil
+	return fmt.Sprintf(softDeleteQuery, tableName, conditionStr), append(tableParams, values...), nil
 }
@@ -23,7 +23,7 @@ func TestParseDeleteByIDQuery(t *testing.T) {
 			args: args{
 				record: &records.User{Id: 1},
 			},
-			want:    "DELETE FROM users WHERE id = @p1",
+			want:    "DELETE FROM [users] WHERE [id] = @p1",
 			wantErr: false,
 		},
 		{
@@ -58,7 +58,7 @@ func TestParseDeleteQuery(t *testing.T) {
 		name    string
 		args    args
 		want    string
-		want1   []int
+		want1   []sql.Param
 		wantErr bool
 	}{
 		{
@@ -71,8 +71,8 @@ func TestParseDeleteQuery(t *testing.T) {
 					Operator: sql.EQ,
 				},
 			},
-			want:    "DELETE FROM users WHERE status = 'inactive'",
-			want1:   nil,
+			want:    "DELETE FROM [users] WHERE [status] = @p1",
+			want1:   []sql.Param{{Value: "inactive", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -85,8 +85,8 @@ func TestParseDeleteQuery(t *testing.T) {
 					Operator: sql.EQ,
 				},
 			},
-			want:    "DELETE FROM users WHERE email = @p1",
-			want1:   []int{0},
+			want:    "DELETE FROM [users] WHERE [email] = @p1",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -109,8 +109,8 @@ func TestParseDeleteQuery(t *testing.T) {
 					},
 				},
 			},
-			want:    "DELETE FROM users WHERE (is_active = 0 AND last_login < @p1)",
-			want1:   []int{0},
+			want:    "DELETE FROM [users] WHERE ([is_active] = @p1 AND [last_login] < @p2)",
+			want1:   []sql.Param{{Value: 0, Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -119,7 +119,7 @@ func TestParseDeleteQuery(t *testing.T) {
 				table:     sql.NewTable("users"),
 				condition: nil,
 			},
-			want:    "DELETE FROM users WHERE 1=1",
+			want:    "DELETE FROM [users] WHERE 1=1",
 			want1:   nil,
 			wantErr: false,
 		},
@@ -147,8 +147,8 @@ func TestParseDeleteQuery(t *testing.T) {
 					Operator: sql.LIKE,
 				},
 			},
-			want:    "DELETE FROM users WHERE email LIKE 'test%'",
-			want1:   nil,
+			want:    "DELETE FROM [users] WHERE [email] LIKE @p1",
+			want1:   []sql.Param{{Value: "test%", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -161,8 +161,8 @@ func TestParseDeleteQuery(t *testing.T) {
 					Operator: sql.IN,
 				},
 			},
-			want:    "DELETE FROM users WHERE status IN ('deleted', 'archived')",
-			want1:   nil,
+			want:    "DELETE FROM [users] WHERE [status] IN (@p1, @p2)",
+			want1:   []sql.Param{{Value: "deleted", Fixed: true}, {Value: "archived", Fixed: true}},
 			wantErr: false,
 		},
 	}
@@ -200,7 +200,7 @@ func TestParseSoftDeleteByIDQuery(t *testing.T) {
 				table:  sql.NewTable("users"),
 				record: &records.User{Id: 1},
 			},
-			want:    "UPDATE users SET deleted = 1 WHERE id = @p1",
+			want:    "UPDATE [users] SET [deleted] = 1 WHERE [id] = @p1",
 			wantErr: false,
 		},
 		{
@@ -218,7 +218,7 @@ func TestParseSoftDeleteByIDQuery(t *testing.T) {
 				table:  sql.NewTable("users"),
 				record: &mockNoTableRecord{},
 			},
-			want:    "UPDATE users SET deleted = 1 WHERE id = @p1",
+			want:    "UPDATE [users] SET [deleted] = 1 WHERE [id] = @p1",
 			wantErr: false,
 		},
 	}
@@ -245,7 +245,7 @@ func TestParseSoftDeleteQuery(t *testing.T) {
 		name    string
 		args    args
 		want    string
-		want1   []int
+		want1   []sql.Param
 		wantErr bool
 	}{
 		{
@@ -258,8 +258,8 @@ func TestParseSoftDeleteQuery(t *testing.T) {
 					Operator: sql.EQ,
 				},
 			},
-			want:    "UPDATE users SET deleted = 1 WHERE status = 'inactive'",
-			want1:   nil,
+			want:    "UPDATE [users] SET [deleted] = 1 WHERE [status] = @p1",
+			want1:   []sql.Param{{Value: "inactive", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -272,8 +272,8 @@ func TestParseSoftDeleteQuery(t *testing.T) {
 					Operator: sql.EQ,
 				},
 			},
-			want:    "UPDATE users SET deleted = 1 WHERE email = @p1",
-			want1:   []int{0},
+			want:    "UPDATE [users] SET [deleted] = 1 WHERE [email] = @p1",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -296,8 +296,8 @@ func TestParseSoftDeleteQuery(t *testing.T) {
 					},
 				},
 			},
-			want:    "UPDATE users SET deleted = 1 WHERE (is_active = 0 OR last_login < @p1)",
-			want1:   []int{0},
+			want:    "UPDATE [users] SET [deleted] = 1 WHERE ([is_active] = @p1 OR [last_login] < @p2)",
+			want1:   []sql.Param{{Value: 0, Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -306,7 +306,7 @@ func TestParseSoftDeleteQuery(t *testing.T) {
 				table:     sql.NewTable("users"),
 				condition: nil,
 			},
-			want:    "UPDATE users SET deleted = 1 WHERE 1=1",
+			want:    "UPDATE [users] SET [deleted] = 1 WHERE 1=1",
 			want1:   nil,
 			wantErr: false,
 		},
@@ -339,8 +339,8 @@ func TestParseSoftDeleteQuery(t *testing.T) {
 					},
 				},
 			},
-			want:    "UPDATE users SET deleted = 1 WHERE NOT (is_admin = 1)",
-			want1:   nil,
+			want:    "UPDATE [users] SET [deleted] = 1 WHERE NOT ([is_admin] = @p1)",
+			want1:   []sql.Param{{Value: 1, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -353,8 +353,8 @@ func TestParseSoftDeleteQuery(t *testing.T) {
 					Operator: sql.BETWEEN,
 				},
 			},
-			want:    "UPDATE users SET deleted = 1 WHERE created_at BETWEEN '2023-01-01' AND '2023-12-31'",
-			want1:   nil,
+			want:    "UPDATE [users] SET [deleted] = 1 WHERE [created_at] BETWEEN @p1 AND @p2",
+			want1:   []sql.Param{{Value: "2023-01-01", Fixed: true}, {Value: "2023-12-31", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -366,7 +366,7 @@ func TestParseSoftDeleteQuery(t *testing.T) {
 					Operator: sql.ISNULL,
 				},
 			},
-			want:    "UPDATE users SET deleted = 1 WHERE email IS NULL",
+			want:    "UPDATE [users] SET [deleted] = 1 WHERE [email] IS NULL",
 			want1:   nil,
 			wantErr: false,
 		},
@@ -451,3 +451,50 @@ func TestParseSoftDeleteQueryEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDeleteReturningQuery(t *testing.T) {
+	type args struct {
+		condition *sql.Condition
+		records   sql.Records
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		want1   []sql.Param
+		wantErr bool
+	}{
+		{
+			name: "test delete returning with simple condition",
+			args: args{
+				condition: sql.NewCondition("status", sql.EQ, sql.NewValue("inactive")),
+				records:   &records.Users{},
+			},
+			want:    "DELETE FROM [users] OUTPUT DELETED.[id], DELETED.[name], DELETED.[email], DELETED.[password_hash], DELETED.[score], DELETED.[is_active], DELETED.[created_at], DELETED.[updated_at] WHERE [status] = @p1",
+			want1:   []sql.Param{{Value: "inactive", Fixed: true}},
+			wantErr: false,
+		},
+		{
+			name:    "test delete returning with nil records",
+			args:    args{condition: sql.NewCondition("status", sql.EQ, sql.NewValue("inactive"))},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, err := prsr.ParseDeleteReturningQuery(tt.args.condition, tt.args.records)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDeleteReturningQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseDeleteReturningQuery() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("ParseDeleteReturningQuery() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
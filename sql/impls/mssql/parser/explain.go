@@ -0,0 +1,92 @@
+package parser
+
+import (
+	"encoding/xml"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gofreego/database/sql"
+)
+
+const (
+	ShowPlanOnQuery  = "SET SHOWPLAN_XML ON"
+	ShowPlanOffQuery = "SET SHOWPLAN_XML OFF"
+)
+
+// ParseExplainQuery returns the query Get would run.
+// mssql has no EXPLAIN statement, the plan is returned for the query itself
+// when SHOWPLAN_XML is enabled on the session with ShowPlanOnQuery.
+func (p *parser) ParseExplainQuery(filter *sql.Filter, records sql.Records) (string, []sql.Param, error) {
+	return p.ParseGetByFilterQuery(filter, records)
+}
+
+// physical operators that read every row of the table
+var scanOperators = []string{"Table Scan", "Clustered Index Scan"}
+
+// ParseQueryPlan summarises the showplan xml.
+func (p *parser) ParseQueryPlan(plan string) (*sql.QueryPlan, error) {
+	queryPlan := &sql.QueryPlan{
+		Raw: plan,
+	}
+	decoder := xml.NewDecoder(strings.NewReader(plan))
+	// physical operators of the RelOp elements enclosing the current element
+	var operators []string
+	var statementFound bool
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, sql.NewDatabaseError(err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "StmtSimple":
+				if statementFound {
+					continue
+				}
+				statementFound = true
+				if rows := getAttr(t, "StatementEstRows"); rows != "" {
+					if queryPlan.EstimatedRows, err = strconv.ParseFloat(rows, 64); err != nil {
+						return nil, sql.NewDatabaseError(err)
+					}
+				}
+			case "RelOp":
+				operator := getAttr(t, "PhysicalOp")
+				operators = append(operators, operator)
+				if slices.Contains(scanOperators, operator) {
+					queryPlan.SequentialScan = true
+				}
+			case "Object":
+				index := strings.Trim(getAttr(t, "Index"), "[]")
+				if index == "" || len(operators) == 0 || slices.Contains(scanOperators, operators[len(operators)-1]) {
+					continue
+				}
+				if !slices.Contains(queryPlan.Indexes, index) {
+					queryPlan.Indexes = append(queryPlan.Indexes, index)
+				}
+			}
+		case xml.EndElement:
+			if t.Name.Local == "RelOp" && len(operators) > 0 {
+				operators = operators[:len(operators)-1]
+			}
+		}
+	}
+	if !statementFound {
+		return nil, sql.NewInvalidQueryError("invalid query plan: statement not found")
+	}
+	return queryPlan, nil
+}
+
+func getAttr(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,77 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/tests/records"
+)
+
+func TestParseExplainQuery(t *testing.T) {
+	filter := &sql.Filter{
+		Condition: sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0)),
+	}
+	got, got1, err := prsr.ParseExplainQuery(filter, &records.Users{})
+	if err != nil {
+		t.Fatalf("ParseExplainQuery() error = %v", err)
+	}
+	if want := "SELECT [id], [name], [email], [password_hash], [score], [is_active], [created_at], [updated_at] FROM [users] WHERE [email] = @p1"; got != want {
+		t.Errorf("ParseExplainQuery() got = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(got1, []sql.Param{{Index: 0}}) {
+		t.Errorf("ParseExplainQuery() got1 = %v, want %v", got1, []sql.Param{{Index: 0}})
+	}
+}
+
+func TestParseQueryPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    string
+		want    *sql.QueryPlan
+		wantErr bool
+	}{
+		{
+			name: "clustered index scan",
+			plan: `<ShowPlanXML xmlns="http://schemas.microsoft.com/sqlserver/2004/07/showplan"><BatchSequence><Batch><Statements><StmtSimple StatementEstRows="2.5"><QueryPlan><RelOp PhysicalOp="Clustered Index Scan" EstimateRows="2.5"><IndexScan><Object Database="[master]" Schema="[dbo]" Table="[users]" Index="[PK__users__3213E83F]"/></IndexScan></RelOp></QueryPlan></StmtSimple></Statements></Batch></BatchSequence></ShowPlanXML>`,
+			want: &sql.QueryPlan{
+				EstimatedRows:  2.5,
+				SequentialScan: true,
+			},
+		},
+		{
+			name: "index seek with key lookup",
+			plan: `<ShowPlanXML><BatchSequence><Batch><Statements><StmtSimple StatementEstRows="1"><QueryPlan><RelOp PhysicalOp="Nested Loops"><NestedLoops><RelOp PhysicalOp="Index Seek"><IndexScan><Object Table="[users]" Index="[idx_users_email]"/></IndexScan></RelOp><RelOp PhysicalOp="Clustered Index Seek"><IndexScan><Object Table="[users]" Index="[PK__users__3213E83F]"/></IndexScan></RelOp></NestedLoops></RelOp></QueryPlan></StmtSimple></Statements></Batch></BatchSequence></ShowPlanXML>`,
+			want: &sql.QueryPlan{
+				EstimatedRows: 1,
+				Indexes:       []string{"idx_users_email", "PK__users__3213E83F"},
+			},
+		},
+		{
+			name:    "missing statement",
+			plan:    `<ShowPlanXML></ShowPlanXML>`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid xml",
+			plan:    `<ShowPlanXML>`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := prsr.ParseQueryPlan(tt.plan)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseQueryPlan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			tt.want.Raw = tt.plan
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseQueryPlan() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -12,12 +12,12 @@ import (
 // string :: condition string
 // []any :: values
 // error :: error if any
-func parseFilter(filter *sql.Filter, lastIndex *int) (string, []int, error) {
+func parseFilter(filter *sql.Filter, lastIndex *int) (string, []sql.Param, error) {
 	if filter == nil {
 		return "", nil, nil
 	}
 	var filterStrings []string
-	var filterValues []int
+	var filterValues []sql.Param
 	// condition
 	condition, values, err := parseCondition(filter.Condition, lastIndex)
 	if err != nil {
@@ -27,7 +27,10 @@ func parseFilter(filter *sql.Filter, lastIndex *int) (string, []int, error) {
 	filterValues = append(filterValues, values...)
 
 	// group by
-	groupBy := parseGroupBy(filter.GroupBy)
+	groupBy, err := parseGroupBy(filter.GroupBy)
+	if err != nil {
+		return "", nil, err
+	}
 	if groupBy != "" {
 		filterStrings = append(filterStrings, groupBy)
 	}
@@ -55,7 +58,7 @@ func parseFilter(filter *sql.Filter, lastIndex *int) (string, []int, error) {
 			} else {
 				*lastIndex++
 				filterStrings = append(filterStrings, fmt.Sprintf("OFFSET @p%d ROWS", *lastIndex))
-				filterValues = append(filterValues, filter.Offset.Index)
+				filterValues = append(filterValues, sql.Param{Index: filter.Offset.Index})
 			}
 		} else {
 			filterStrings = append(filterStrings, "OFFSET 0 ROWS")
@@ -71,7 +74,7 @@ func parseFilter(filter *sql.Filter, lastIndex *int) (string, []int, error) {
 			} else {
 				*lastIndex++
 				filterStrings = append(filterStrings, fmt.Sprintf("FETCH NEXT @p%d ROWS ONLY", *lastIndex))
-				filterValues = append(filterValues, filter.Limit.Index)
+				filterValues = append(filterValues, sql.Param{Index: filter.Limit.Index})
 			}
 		} else {
 			filterStrings = append(filterStrings, "FETCH NEXT 10 ROWS ONLY")
@@ -81,11 +84,14 @@ func parseFilter(filter *sql.Filter, lastIndex *int) (string, []int, error) {
 	return strings.Join(filterStrings, " "), filterValues, nil
 }
 
-func parseGroupBy(groupBy *sql.GroupBy) string {
+func parseGroupBy(groupBy *sql.GroupBy) (string, error) {
 	if groupBy == nil {
-		return ""
+		return "", nil
 	}
-	return fmt.Sprintf("GROUP BY (%s)", strings.Join(groupBy.Fields(), ", "))
+	if err := groupBy.Validate(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("GROUP BY (%s)", quoteAll(groupBy.Fields())), nil
 }
 
 var orderToStringMap = map[sql.Order]string{
@@ -93,19 +99,46 @@ var orderToStringMap = map[sql.Order]string{
 	sql.Desc: "DESC",
 }
 
+// nulls placement is emulated by sorting on whether the value is NULL before sorting on the value
+var nullsToCaseMap = map[sql.Nulls]string{
+	sql.NullsFirst: "CASE WHEN %s IS NULL THEN 0 ELSE 1 END",
+	sql.NullsLast:  "CASE WHEN %s IS NULL THEN 1 ELSE 0 END",
+}
+
 func parseOrderBy(orderBy *sql.Sort) (string, error) {
 	if orderBy == nil {
 		return "", nil
 	}
+	if err := orderBy.Validate(); err != nil {
+		return "", err
+	}
 	var orderByStrings []string
 	var orderStr string
 	var ok bool
 	for _, field := range orderBy.Fields() {
-
+		sortField := parseSortField(field)
 		if orderStr, ok = orderToStringMap[field.Order]; !ok {
-			return "", fmt.Errorf("invalid order: %d for field: %s", field.Order, field.Field)
+			return "", fmt.Errorf("invalid order: %d for field: %s", field.Order, sortField)
+		}
+		if nullsCase, ok := nullsToCaseMap[field.Nulls]; ok {
+			orderByStrings = append(orderByStrings, fmt.Sprintf(nullsCase, sortField))
 		}
-		orderByStrings = append(orderByStrings, fmt.Sprintf("%s %s", field.Field, orderStr))
+		orderByStrings = append(orderByStrings, fmt.Sprintf("%s %s", sortField, orderStr))
 	}
 	return fmt.Sprintf("ORDER BY %s", strings.Join(orderByStrings, ", ")), nil
 }
+
+// parseSortField returns the field, aggregate or expression the criterion sorts by
+func parseSortField(orderBy sql.OrderBy) string {
+	switch {
+	case orderBy.Aggregate != nil:
+		// the alias belongs to the select list, not to the sort
+		aggregate := *orderBy.Aggregate
+		aggregate.Alias = ""
+		return parseField(&aggregate)
+	case orderBy.Expression != "":
+		return orderBy.Expression
+	default:
+		return quote(orderBy.Field)
+	}
+}
@@ -7,22 +7,35 @@ import (
 )
 
 const (
-	mssqlGetByIDQuery = "SELECT %s FROM %s WHERE id = @p1"
+	mssqlGetByIDQuery = "SELECT %s FROM %s WHERE [id] = @p1"
 	mssqlGetQuery     = "SELECT %s FROM %s"
 )
 
-func (p *parser) ParseGetByIDQuery(record sql.Record) (string, error) {
+func (p *parser) ParseGetByIDQuery(record sql.Record, lock sql.LockMode) (string, error) {
 	var lastIndex int
-	tableName, err := parseTableName(record.Table(), &lastIndex)
+	tableName, _, err := parseLockedTableName(record.Table(), lock, &lastIndex)
 	if err != nil {
 		return "", err
 	}
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", err
+	}
 	return fmt.Sprintf(mssqlGetByIDQuery, parseColumns(record.Columns()), tableName), nil
 }
 
-func (p *parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records) (string, []int, error) {
+func (p *parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records) (string, []sql.Param, error) {
+	if err := filter.Validate(); err != nil {
+		return "", nil, err
+	}
+	if filter != nil && filter.DistinctOn != nil {
+		return parseDistinctOnQuery(filter, records)
+	}
 	var lastIndex int
-	tableName, err := parseTableName(records.Table(), &lastIndex)
+	lock := sql.NoLock
+	if filter != nil {
+		lock = filter.Lock
+	}
+	tableName, tableParams, err := parseLockedTableName(records.Table(), lock, &lastIndex)
 	if err != nil {
 		return "", nil, err
 	}
@@ -31,9 +44,16 @@ func (p *parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records)
 		return "", nil, err
 	}
 
-	query := fmt.Sprintf(mssqlGetQuery, parseColumns(records.Columns()), tableName)
+	if err := validateColumns(records.Columns()); err != nil {
+		return "", nil, err
+	}
+	columns := parseColumns(records.Columns())
+	if filter != nil && filter.Distinct {
+		columns = "DISTINCT " + columns
+	}
+	query := fmt.Sprintf(mssqlGetQuery, columns, tableName)
 	if filterString != "" {
 		query += " " + filterString
 	}
-	return query, values, nil
+	return query, append(tableParams, values...), nil
 }
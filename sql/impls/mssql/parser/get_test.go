@@ -20,6 +20,7 @@ func (m *mockRecords) Scan(rows sql.Rows) error { return nil }
 func TestParseGetByIDQuery(t *testing.T) {
 	type args struct {
 		record sql.Record
+		lock   sql.LockMode
 	}
 	tests := []struct {
 		name    string
@@ -32,7 +33,7 @@ func TestParseGetByIDQuery(t *testing.T) {
 			args: args{
 				record: &records.User{Id: 1},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE id = @p1",
+			want:    "SELECT [id], [name], [email], [password_hash], [score], [is_active], [created_at], [updated_at] FROM [users] WHERE [id] = @p1",
 			wantErr: false,
 		},
 		{
@@ -43,10 +44,27 @@ func TestParseGetByIDQuery(t *testing.T) {
 			want:    "",
 			wantErr: true,
 		},
+		{
+			name: "record with for update lock",
+			args: args{
+				record: &records.User{Id: 1},
+				lock:   sql.ForUpdate,
+			},
+			want: "SELECT [id], [name], [email], [password_hash], [score], [is_active], [created_at], [updated_at] FROM [users] WITH (UPDLOCK, ROWLOCK) WHERE [id] = @p1",
+		},
+		{
+			name: "record with invalid lock",
+			args: args{
+				record: &records.User{Id: 1},
+				lock:   sql.LockMode(100),
+			},
+			want:    "",
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := prsr.ParseGetByIDQuery(tt.args.record)
+			got, err := prsr.ParseGetByIDQuery(tt.args.record, tt.args.lock)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseGetByIDQuery() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -67,7 +85,7 @@ func TestParseGetByFilterQuery(t *testing.T) {
 		name    string
 		args    args
 		want    string
-		want1   []int
+		want1   []sql.Param
 		wantErr bool
 	}{
 		{
@@ -76,7 +94,7 @@ func TestParseGetByFilterQuery(t *testing.T) {
 				filter:  nil,
 				records: &records.Users{},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users",
+			want:    "SELECT [id], [name], [email], [password_hash], [score], [is_active], [created_at], [updated_at] FROM [users]",
 			want1:   nil,
 			wantErr: false,
 		},
@@ -92,8 +110,8 @@ func TestParseGetByFilterQuery(t *testing.T) {
 				},
 				records: &records.Users{},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE name = @p1",
-			want1:   []int{0},
+			want:    "SELECT [id], [name], [email], [password_hash], [score], [is_active], [created_at], [updated_at] FROM [users] WHERE [name] = @p1",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -127,8 +145,8 @@ func TestParseGetByFilterQuery(t *testing.T) {
 				},
 				records: &records.Users{},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE (email = @p1 AND is_active = 1 AND name LIKE @p2) GROUP BY (is_active) ORDER BY created_at DESC OFFSET @p3 ROWS FETCH NEXT 10 ROWS ONLY",
-			want1:   []int{0, 2, 1},
+			want:    "SELECT [id], [name], [email], [password_hash], [score], [is_active], [created_at], [updated_at] FROM [users] WHERE ([email] = @p1 AND [is_active] = @p2 AND [name] LIKE @p3) GROUP BY ([is_active]) ORDER BY [created_at] DESC OFFSET @p4 ROWS FETCH NEXT 10 ROWS ONLY",
+			want1:   []sql.Param{{Index: 0}, {Value: 1, Fixed: true}, {Index: 2}, {Index: 1}},
 			wantErr: false,
 		},
 		{
@@ -141,6 +159,71 @@ func TestParseGetByFilterQuery(t *testing.T) {
 			want1:   nil,
 			wantErr: true,
 		},
+		{
+			name: "filter with skip locked lock",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("status", sql.EQ, sql.NewIndexedValue(0)),
+					Limit:     sql.NewValue(int64(10)),
+					Lock:      sql.ForUpdateSkipLocked,
+				},
+				records: &records.Users{},
+			},
+			want:  "SELECT [id], [name], [email], [password_hash], [score], [is_active], [created_at], [updated_at] FROM [users] WITH (UPDLOCK, READPAST, ROWLOCK) WHERE [status] = @p1 ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY",
+			want1: []sql.Param{{Index: 0}},
+		},
+		{
+			name: "filter with distinct",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("is_active", sql.EQ, sql.NewIndexedValue(0)),
+					Distinct:  true,
+				},
+				records: &records.Users{},
+			},
+			want:  "SELECT DISTINCT [id], [name], [email], [password_hash], [score], [is_active], [created_at], [updated_at] FROM [users] WHERE [is_active] = @p1",
+			want1: []sql.Param{{Index: 0}},
+		},
+		{
+			name: "filter with distinct on",
+			args: args{
+				filter: &sql.Filter{
+					Condition:  sql.NewCondition("is_active", sql.EQ, sql.NewIndexedValue(0)),
+					DistinctOn: sql.DistinctOn("email"),
+					Sort:       sql.NewSort().Add("created_at", sql.Desc),
+					Limit:      sql.NewIndexedValue(1),
+				},
+				records: &records.Users{},
+			},
+			want:  "SELECT distinct_col_1, distinct_col_2, distinct_col_3, distinct_col_4, distinct_col_5, distinct_col_6, distinct_col_7, distinct_col_8 FROM (SELECT [id] AS distinct_col_1, [name] AS distinct_col_2, [email] AS distinct_col_3, [password_hash] AS distinct_col_4, [score] AS distinct_col_5, [is_active] AS distinct_col_6, [created_at] AS distinct_col_7, [updated_at] AS distinct_col_8, [email] AS distinct_sort_1, [created_at] AS distinct_sort_2, ROW_NUMBER() OVER (PARTITION BY [email] ORDER BY [created_at] DESC) AS distinct_row_num FROM [users] WHERE [is_active] = @p1) AS distinct_rows WHERE distinct_row_num = 1 ORDER BY distinct_sort_1 ASC, distinct_sort_2 DESC OFFSET 0 ROWS FETCH NEXT @p2 ROWS ONLY",
+			want1: []sql.Param{{Index: 0}, {Index: 1}},
+		},
+		{
+			name: "filter with distinct and distinct on",
+			args: args{
+				filter: &sql.Filter{
+					Distinct:   true,
+					DistinctOn: sql.DistinctOn("email"),
+				},
+				records: &records.Users{},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "filter with distinct and lock",
+			args: args{
+				filter: &sql.Filter{
+					Distinct: true,
+					Lock:     sql.ForUpdate,
+				},
+				records: &records.Users{},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
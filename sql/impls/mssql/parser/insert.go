@@ -3,12 +3,20 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/gofreego/database/sql"
 )
 
 const (
 	insertQuery = "INSERT INTO %s (%s) VALUES %s"
+	// the order of the rows of OUTPUT is not guaranteed, the IDs are output with the position of their row and selected in that order
+	mssqlInsertReturningQuery = "DECLARE @inserted TABLE (id BIGINT, ordinal INT); " +
+		"MERGE INTO %s USING (VALUES %s) AS insert_values (%s, insert_ordinal) ON 1 = 0 " +
+		"WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s) OUTPUT INSERTED.%s, insert_values.insert_ordinal INTO @inserted; " +
+		"SELECT id FROM @inserted ORDER BY ordinal"
+	mssqlInsertIgnoreQuery = "DECLARE @inserted TABLE (id BIGINT, ordinal INT); " +
+		"%s OUTPUT INSERTED.%s, %s.insert_ordinal INTO @inserted; SELECT id FROM @inserted ORDER BY ordinal"
 )
 
 func (p *parser) ParseInsertQuery(record ...sql.Record) (string, []any, error) {
@@ -16,11 +24,91 @@ func (p *parser) ParseInsertQuery(record ...sql.Record) (string, []any, error) {
 		return "", nil, errors.New("no record provided")
 	}
 	var lastIndex int
-	tableName, err := parseTableName(record[0].Table(), &lastIndex)
+	tableName, _, err := parseTableName(record[0].Table(), &lastIndex)
 	if err != nil {
 		return "", nil, err
 	}
+	if err := validateColumns(record[0].Columns()); err != nil {
+		return "", nil, err
+	}
 
 	placehodlers, values := getValuesPlaceHolders(&lastIndex, record...)
 	return fmt.Sprintf(insertQuery, tableName, parseInsertColumns(record[0]), placehodlers), values, nil
 }
+
+/*
+ParseInsertReturningIDsQuery returns the query inserting the records that returns their IDs in the order of the records.
+The records are inserted with a MERGE that never matches, as OUTPUT of MERGE can output the position of the source row with the ID.
+eg. DECLARE @inserted TABLE (id BIGINT, ordinal INT); MERGE INTO [users] USING (VALUES (@p1, 0), (@p2, 1)) AS insert_values ([name], insert_ordinal)
+ON 1 = 0 WHEN NOT MATCHED THEN INSERT ([name]) VALUES (insert_values.[name]) OUTPUT INSERTED.[id], insert_values.insert_ordinal INTO @inserted;
+SELECT id FROM @inserted ORDER BY ordinal
+*/
+func (p *parser) ParseInsertReturningIDsQuery(records ...sql.Record) (string, []any, error) {
+	if len(records) == 0 {
+		return "", nil, errors.New("no record provided")
+	}
+	record := records[0]
+	var lastIndex int
+	tableName, _, err := parseTableName(record.Table(), &lastIndex)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", nil, err
+	}
+	idColumn, err := parseIdColumn(record)
+	if err != nil {
+		return "", nil, err
+	}
+	sourceColumns := []string{}
+	for _, column := range record.Columns() {
+		if column.Name == record.IdColumn() {
+			continue
+		}
+		sourceColumns = append(sourceColumns, "insert_values."+quote(column.Name))
+	}
+	rows := make([]string, len(records))
+	values := make([]any, 0, len(records)*len(sourceColumns))
+	for i, r := range records {
+		if len(r.Values()) != len(sourceColumns) {
+			return "", nil, sql.NewInvalidQueryError("insert returning query:: every record should have %d values", len(sourceColumns))
+		}
+		rows[i] = fmt.Sprintf("(%s, %d)", getPlaceHolders(len(sourceColumns), &lastIndex), i)
+		values = append(values, r.Values()...)
+	}
+	columns := parseInsertColumns(record)
+	return fmt.Sprintf(mssqlInsertReturningQuery, tableName, strings.Join(rows, ", "), columns, columns,
+		strings.Join(sourceColumns, ", "), idColumn), values, nil
+}
+
+/*
+ParseInsertIgnoreQuery returns the query inserting the records that do not conflict with existing rows,
+and returns the IDs of the inserted rows in the order of the records.
+The records are inserted with a MERGE that does nothing on the rows they match with the conflict columns of the conflict options,
+the other conflict options are not used. The conflict columns are required, the MERGE does not detect the conflicts
+on the unique constraints of the table, and mssql cannot name a constraint of the conflict.
+eg. DECLARE @inserted TABLE (id BIGINT, ordinal INT); MERGE INTO [users] WITH (HOLDLOCK) USING (VALUES (@p1, @p2, 0)) AS [excluded] ([name], [email], insert_ordinal)
+ON [users].[email] = [excluded].[email] WHEN NOT MATCHED THEN INSERT ([name], [email]) VALUES ([excluded].[name], [excluded].[email])
+OUTPUT INSERTED.[id], [excluded].insert_ordinal INTO @inserted; SELECT id FROM @inserted ORDER BY ordinal
+*/
+func (p *parser) ParseInsertIgnoreQuery(conflict *sql.Conflict, records ...sql.Record) (string, []any, error) {
+	if len(records) == 0 || records[0] == nil {
+		return "", nil, errors.New("no record provided")
+	}
+	if err := conflict.Validate(); err != nil {
+		return "", nil, err
+	}
+	if conflict == nil || len(conflict.Columns) == 0 {
+		return "", nil, sql.NewInvalidQueryError("invalid conflict: mssql requires Conflict.Columns to insert ignoring the conflicts")
+	}
+	target := &sql.Conflict{DoNothing: true, Columns: conflict.Columns, Constraint: conflict.Constraint}
+	merge, values, err := parseMergeQuery(target, records)
+	if err != nil {
+		return "", nil, err
+	}
+	idColumn, err := parseIdColumn(records[0])
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf(mssqlInsertIgnoreQuery, merge, idColumn, quote(sql.Excluded)), values, nil
+}
@@ -29,14 +29,14 @@ func TestParseInsertQuery(t *testing.T) {
 		{
 			name:    "single record, single column (id only)",
 			args:    args{record: []sql.Record{&mockIdOnlyRecord{Id: 1}}},
-			want:    "INSERT INTO mock () VALUES ()",
+			want:    "INSERT INTO [mock] () VALUES ()",
 			want1:   []any{},
 			wantErr: false,
 		},
 		{
 			name:    "multiple records, single column (id only batch)",
 			args:    args{record: []sql.Record{&mockIdOnlyRecord{Id: 1}, &mockIdOnlyRecord{Id: 2}}},
-			want:    "INSERT INTO mock () VALUES (), ()",
+			want:    "INSERT INTO [mock] () VALUES (), ()",
 			want1:   []any{},
 			wantErr: false,
 		},
@@ -46,7 +46,7 @@ func TestParseInsertQuery(t *testing.T) {
 				Id: 1, Name: "Alice", Email: "alice@example.com", PasswordHash: "hash123",
 				IsActive: 1, CreatedAt: 123456789, UpdatedAt: 987654321,
 			}}},
-			want:    "INSERT INTO users (name, email, password_hash, score, is_active, created_at, updated_at) VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7)",
+			want:    "INSERT INTO [users] ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at]) VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7)",
 			want1:   []any{"Alice", "alice@example.com", "hash123", 0, 1, int64(123456789), int64(987654321)},
 			wantErr: false,
 		},
@@ -56,7 +56,7 @@ func TestParseInsertQuery(t *testing.T) {
 				&records.User{Id: 1, Name: "Alice", Email: "alice@example.com", PasswordHash: "hash123", IsActive: 1, CreatedAt: 123456789, UpdatedAt: 987654321},
 				&records.User{Id: 2, Name: "Bob", Email: "bob@example.com", PasswordHash: "hash456", IsActive: 0, CreatedAt: 123456790, UpdatedAt: 987654322},
 			}},
-			want:    "INSERT INTO users (name, email, password_hash, score, is_active, created_at, updated_at) VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7), (@p8, @p9, @p10, @p11, @p12, @p13, @p14)",
+			want:    "INSERT INTO [users] ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at]) VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7), (@p8, @p9, @p10, @p11, @p12, @p13, @p14)",
 			want1:   []any{"Alice", "alice@example.com", "hash123", 0, 1, int64(123456789), int64(987654321), "Bob", "bob@example.com", "hash456", 0, 0, int64(123456790), int64(987654322)},
 			wantErr: false,
 		},
@@ -84,3 +84,129 @@ func TestParseInsertQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestParseInsertReturningIDsQuery(t *testing.T) {
+	type args struct {
+		records []sql.Record
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		want1   []any
+		wantErr bool
+	}{
+		{
+			name:    "no records (error)",
+			args:    args{records: nil},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "multiple records",
+			args: args{records: []sql.Record{
+				&records.User{Id: 1, Name: "Alice", Email: "alice@example.com", PasswordHash: "hash123", IsActive: 1, CreatedAt: 123456789, UpdatedAt: 987654321},
+				&records.User{Id: 2, Name: "Bob", Email: "bob@example.com", PasswordHash: "hash456", IsActive: 0, CreatedAt: 123456790, UpdatedAt: 987654322},
+			}},
+			want:    "DECLARE @inserted TABLE (id BIGINT, ordinal INT); MERGE INTO [users] USING (VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7, 0), (@p8, @p9, @p10, @p11, @p12, @p13, @p14, 1)) AS insert_values ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at], insert_ordinal) ON 1 = 0 WHEN NOT MATCHED THEN INSERT ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at]) VALUES (insert_values.[name], insert_values.[email], insert_values.[password_hash], insert_values.[score], insert_values.[is_active], insert_values.[created_at], insert_values.[updated_at]) OUTPUT INSERTED.[id], insert_values.insert_ordinal INTO @inserted; SELECT id FROM @inserted ORDER BY ordinal",
+			want1:   []any{"Alice", "alice@example.com", "hash123", 0, 1, int64(123456789), int64(987654321), "Bob", "bob@example.com", "hash456", 0, 0, int64(123456790), int64(987654322)},
+			wantErr: false,
+		},
+		{
+			name:    "missing table",
+			args:    args{records: []sql.Record{&mockNoTableRecord{}}},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, err := prsr.ParseInsertReturningIDsQuery(tt.args.records...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseInsertReturningIDsQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseInsertReturningIDsQuery() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("ParseInsertReturningIDsQuery() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestParseInsertIgnoreQuery(t *testing.T) {
+	alice := &records.User{Id: 1, Name: "Alice", Email: "alice@example.com", PasswordHash: "hash123", IsActive: 1, CreatedAt: 123456789, UpdatedAt: 987654321}
+	type args struct {
+		conflict *sql.Conflict
+		records  []sql.Record
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		want1   []any
+		wantErr bool
+	}{
+		{
+			name:    "no records (error)",
+			args:    args{records: nil},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "default conflict (error)",
+			args:    args{records: []sql.Record{alice}},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "no conflict columns (error)",
+			args:    args{conflict: &sql.Conflict{}, records: []sql.Record{alice}},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "conflict columns",
+			args:    args{conflict: &sql.Conflict{Columns: []string{"email"}}, records: []sql.Record{alice}},
+			want:    "DECLARE @inserted TABLE (id BIGINT, ordinal INT); MERGE INTO [users] WITH (HOLDLOCK) USING (VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7, 0)) AS [excluded] ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at], insert_ordinal) ON [users].[email] = [excluded].[email] WHEN NOT MATCHED THEN INSERT ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at]) VALUES ([excluded].[name], [excluded].[email], [excluded].[password_hash], [excluded].[score], [excluded].[is_active], [excluded].[created_at], [excluded].[updated_at]) OUTPUT INSERTED.[id], [excluded].insert_ordinal INTO @inserted; SELECT id FROM @inserted ORDER BY ordinal",
+			want1:   []any{"Alice", "alice@example.com", "hash123", 0, 1, int64(123456789), int64(987654321)},
+			wantErr: false,
+		},
+		{
+			name:    "conflict constraint",
+			args:    args{conflict: &sql.Conflict{Constraint: "users_email_key"}, records: []sql.Record{alice}},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "missing table",
+			args:    args{records: []sql.Record{&mockNoTableRecord{}}},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, err := prsr.ParseInsertIgnoreQuery(tt.args.conflict, tt.args.records...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseInsertIgnoreQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseInsertIgnoreQuery() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("ParseInsertIgnoreQuery() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
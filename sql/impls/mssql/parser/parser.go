@@ -1,6 +1,9 @@
 package parser
 
-import "github.com/gofreego/database/sql/impls/common"
+import (
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/impls/common"
+)
 
 var (
 	prsr = &parser{}
@@ -12,3 +15,28 @@ type parser struct {
 func NewParser() common.Parser {
 	return &parser{}
 }
+
+func (p *parser) Dialect() sql.Dialect {
+	return sql.MSSQL
+}
+
+// MaxParams returns the maximum number of parameters in a query, the server supports a maximum of 2100 parameters in a request
+// and the parameterized queries are run with sp_executesql, whose @stmt and @params arguments count towards the limit
+func (p *parser) MaxParams() int {
+	return 2098
+}
+
+// MaxRows returns the maximum number of rows in a VALUES list, 0 when unlimited, a table value constructor accepts a maximum of 1000 rows
+func (p *parser) MaxRows() int {
+	return 1000
+}
+
+// InsertReturnsIDs returns true as the inserted and upserted IDs are output with OUTPUT INSERTED
+func (p *parser) InsertReturnsIDs() bool {
+	return true
+}
+
+// ReturnsModifiedRows returns true as UPDATE and DELETE output the modified rows with OUTPUT INSERTED and OUTPUT DELETED
+func (p *parser) ReturnsModifiedRows() bool {
+	return true
+}
@@ -3,6 +3,7 @@ package parser
 import (
 	"testing"
 
+	"github.com/gofreego/database/sql"
 	"github.com/gofreego/database/sql/impls/common"
 )
 
@@ -17,3 +18,9 @@ func TestNewParser(t *testing.T) {
 	// Check that it implements the common.Parser interface
 	var _ common.Parser = parser
 }
+
+func TestParser_Dialect(t *testing.T) {
+	if got := NewParser().Dialect(); got != sql.MSSQL {
+		t.Errorf("Dialect() = %v, want %v", got, sql.MSSQL)
+	}
+}
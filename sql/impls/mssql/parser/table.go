@@ -15,39 +15,72 @@ var (
 	}
 )
 
-func parseTableName(table *sql.Table, lastIndex *int) (string, error) {
+func parseTableName(table *sql.Table, lastIndex *int) (string, []sql.Param, error) {
+	return parseLockedTableName(table, sql.NoLock, lastIndex)
+}
+
+// parseLockedTableName parses the table name with the table hints for the lock mode.
+// mssql takes row locks through table hints on the locked table instead of a locking clause.
+func parseLockedTableName(table *sql.Table, lock sql.LockMode, lastIndex *int) (string, []sql.Param, error) {
 	if table == nil {
-		return "", sql.NewInvalidQueryError("invalid table: table cannot be nil")
+		return "", nil, sql.NewInvalidQueryError("invalid table: table cannot be nil")
+	}
+	if err := table.Validate(); err != nil {
+		return "", nil, err
 	}
-	joinString, err := parseJoin(table.Join, lastIndex)
+	hint, err := parseLockHint(lock)
 	if err != nil {
+		return "", nil, err
+	}
+	joinString, params, err := parseJoin(table.Join, lastIndex)
+	if err != nil {
+		return "", nil, err
+	}
+	return quote(table.Name) + getAlias(table.Alias) + hint + joinString, params, nil
+}
+
+var lockToHintMap = map[sql.LockMode]string{
+	sql.ForUpdate:           "WITH (UPDLOCK, ROWLOCK)",
+	sql.ForUpdateSkipLocked: "WITH (UPDLOCK, READPAST, ROWLOCK)",
+	sql.ForUpdateNoWait:     "WITH (UPDLOCK, ROWLOCK, NOWAIT)",
+}
+
+func parseLockHint(lock sql.LockMode) (string, error) {
+	if lock == sql.NoLock {
 		return "", nil
 	}
-	return table.Name + getAlias(table.Alias) + joinString, nil
+	hint, ok := lockToHintMap[lock]
+	if !ok {
+		return "", sql.NewInvalidQueryError("invalid lock mode: %d", lock)
+	}
+	return " " + hint, nil
 }
 
 func getAlias(alias string) string {
 	if alias == "" {
 		return ""
 	}
-	return " " + alias
+	return " " + quote(alias)
 }
 
-func parseJoin(join []sql.Join, lastIndex *int) (string, error) {
+// parseJoin parses the joins and returns the params of the join conditions in placeholder order
+func parseJoin(join []sql.Join, lastIndex *int) (string, []sql.Param, error) {
 	if len(join) == 0 {
-		return "", nil
+		return "", nil, nil
 	}
 	joins := ""
+	var params []sql.Param
 	for _, j := range join {
-		conditionString, _, err := parseCondition(j.On, lastIndex)
+		tableName, tableParams, err := parseTableName(j.Table, lastIndex)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
-		tableName, err := parseTableName(j.Table, lastIndex)
+		conditionString, conditionParams, err := parseCondition(j.On, lastIndex)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
+		params = append(append(params, tableParams...), conditionParams...)
 		joins += fmt.Sprintf(" %s %s ON %s", joinTypes[j.Type], tableName, conditionString)
 	}
-	return joins, nil
+	return joins, params, nil
 }
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/gofreego/database/sql"
@@ -8,11 +9,12 @@ import (
 
 func TestParseTableName(t *testing.T) {
 	tests := []struct {
-		name      string
-		table     *sql.Table
-		lastIndex int
-		want      string
-		wantErr   bool
+		name       string
+		table      *sql.Table
+		lastIndex  int
+		want       string
+		wantParams []sql.Param
+		wantErr    bool
 	}{
 		{
 			name:      "nil table",
@@ -27,7 +29,7 @@ func TestParseTableName(t *testing.T) {
 				Name: "users",
 			},
 			lastIndex: 0,
-			want:      "users",
+			want:      "[users]",
 			wantErr:   false,
 		},
 		{
@@ -37,7 +39,7 @@ func TestParseTableName(t *testing.T) {
 				Alias: "u",
 			},
 			lastIndex: 0,
-			want:      "users u",
+			want:      "[users] [u]",
 			wantErr:   false,
 		},
 		{
@@ -59,14 +61,27 @@ func TestParseTableName(t *testing.T) {
 				},
 			},
 			lastIndex: 0,
-			want:      "users INNER JOIN orders ON users.id = orders.user_id",
+			want:      "[users] INNER JOIN [orders] ON [users].[id] = [orders].[user_id]",
 			wantErr:   false,
 		},
+		{
+			name: "table with fixed value in join condition",
+			table: func() *sql.Table {
+				t1 := sql.NewTable("users")
+				t2 := sql.NewTable("orders")
+				t1.WithInnerJoin(t2, sql.NewCondition("users.id", sql.EQ, sql.NewColumnValue("orders.user_id")).
+					And(sql.NewCondition("orders.status", sql.EQ, sql.NewValue("paid"))))
+				return t1
+			}(),
+			want:       "[users] INNER JOIN [orders] ON ([users].[id] = [orders].[user_id] AND [orders].[status] = @p1)",
+			wantParams: []sql.Param{{Value: "paid", Fixed: true}},
+			wantErr:    false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := parseTableName(tt.table, &tt.lastIndex)
+			got, got1, err := parseTableName(tt.table, &tt.lastIndex)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("parseTableName() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -74,6 +89,9 @@ func TestParseTableName(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("parseTableName() = %v, want %v", got, tt.want)
 			}
+			if !reflect.DeepEqual(got1, tt.wantParams) {
+				t.Errorf("parseTableName() got1 = %v, want %v", got1, tt.wantParams)
+			}
 		})
 	}
 }
@@ -92,7 +110,7 @@ func TestGetAlias(t *testing.T) {
 		{
 			name:  "non-empty alias",
 			alias: "u",
-			want:  " u",
+			want:  " [u]",
 		},
 	}
 
@@ -137,7 +155,7 @@ func TestParseJoin(t *testing.T) {
 				},
 			},
 			lastIndex: 0,
-			want:      " INNER JOIN orders ON users.id = orders.user_id",
+			want:      " INNER JOIN [orders] ON [users].[id] = [orders].[user_id]",
 			wantErr:   false,
 		},
 		{
@@ -167,14 +185,14 @@ func TestParseJoin(t *testing.T) {
 				},
 			},
 			lastIndex: 0,
-			want:      " LEFT JOIN orders ON users.id = orders.user_id RIGHT JOIN payments ON orders.id = payments.order_id",
+			want:      " LEFT JOIN [orders] ON [users].[id] = [orders].[user_id] RIGHT JOIN [payments] ON [orders].[id] = [payments].[order_id]",
 			wantErr:   false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := parseJoin(tt.joins, &tt.lastIndex)
+			got, _, err := parseJoin(tt.joins, &tt.lastIndex)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("parseJoin() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -231,7 +249,7 @@ func TestParseJoinEdgeCases(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := parseJoin(tt.joins, &tt.lastIndex)
+			_, _, err := parseJoin(tt.joins, &tt.lastIndex)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("parseJoin() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/gofreego/database/sql"
+)
+
+// ParseTransactionQuery returns no statement as the driver begins the transaction with the isolation level,
+// mssql has no read-only and no deferrable transactions.
+func (p *parser) ParseTransactionQuery(options *sql.TxOptions) (string, error) {
+	if options == nil {
+		return "", nil
+	}
+	if options.ReadOnly {
+		return "", sql.NewInvalidQueryError("invalid transaction options: mssql does not support read-only transactions")
+	}
+	if options.Deferrable {
+		return "", sql.NewInvalidQueryError("invalid transaction options: mssql does not support deferrable transactions")
+	}
+	return "", nil
+}
+
+const (
+	savepointQuery           = "SAVE TRANSACTION %s"
+	rollbackToSavepointQuery = "ROLLBACK TRANSACTION %s"
+	// the savepoint names are truncated to 32 characters by mssql
+	maxSavepointLength = 32
+)
+
+// ParseSavepointQuery returns the query setting the savepoint
+func (p *parser) ParseSavepointQuery(name string) (string, error) {
+	if err := validateSavepoint(name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(savepointQuery, quote(name)), nil
+}
+
+// ParseRollbackToSavepointQuery returns the query rolling the transaction back to the savepoint
+func (p *parser) ParseRollbackToSavepointQuery(name string) (string, error) {
+	if err := validateSavepoint(name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(rollbackToSavepointQuery, quote(name)), nil
+}
+
+// ParseReleaseSavepointQuery returns no query as mssql cannot release a savepoint, it is kept until the transaction ends
+func (p *parser) ParseReleaseSavepointQuery(name string) (string, error) {
+	if err := validateSavepoint(name); err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+// validateSavepoint validates the name of the savepoint, which cannot be longer than mssql keeps it
+func validateSavepoint(name string) error {
+	if err := sql.ValidateSavepoint(name); err != nil {
+		return err
+	}
+	if len(name) > maxSavepointLength {
+		return sql.NewInvalidQueryError("invalid savepoint: %q is longer than %d characters", name, maxSavepointLength)
+	}
+	return nil
+}
@@ -16,7 +16,14 @@ func (p *parser) ParseUpdateByIDQuery(record sql.Record) (string, error) {
 		return "", sql.NewInvalidQueryError("update query:: record cannot be nil")
 	}
 	var lastIndex int
-	tableName, err := parseTableName(record.Table(), &lastIndex)
+	tableName, _, err := parseTableName(record.Table(), &lastIndex)
+	if err != nil {
+		return "", err
+	}
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", err
+	}
+	idColumn, err := parseIdColumn(record)
 	if err != nil {
 		return "", err
 	}
@@ -25,7 +32,7 @@ func (p *parser) ParseUpdateByIDQuery(record sql.Record) (string, error) {
 		return "", sql.NewInvalidQueryError("update query:: no columns to update")
 	}
 	lastIndex++
-	return fmt.Sprintf(mssqlUpdateByIDQuery, tableName, updateString, record.IdColumn(), lastIndex), nil
+	return fmt.Sprintf(mssqlUpdateByIDQuery, tableName, updateString, idColumn, lastIndex), nil
 }
 
 func getUpdatesString(record sql.Record, lastIndex *int) string {
@@ -36,7 +43,7 @@ func getUpdatesString(record sql.Record, lastIndex *int) string {
 			continue // Skip the ID column in the update
 		}
 		*lastIndex++
-		updates = append(updates, fmt.Sprintf("%s = @p%d", column.Name, *lastIndex))
+		updates = append(updates, fmt.Sprintf("%s = @p%d", quote(column.Name), *lastIndex))
 	}
 	return strings.Join(updates, ", ")
 }
@@ -0,0 +1,67 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofreego/database/sql"
+)
+
+const (
+	mssqlUpdateManyByIDQuery = "UPDATE %s SET %s FROM %s INNER JOIN (VALUES %s) AS update_values (%s) ON %s.%s = update_values.%s"
+)
+
+/*
+ParseUpdateManyByIDQuery returns the query to update every record by its ID with its own values.
+The values of every record are a row of a table value constructor joined on the id column, the values are id first and then the record's values.
+eg. UPDATE [users] SET [name] = update_values.[name] FROM [users] INNER JOIN (VALUES (@p1, @p2), (@p3, @p4))
+AS update_values ([id], [name]) ON [users].[id] = update_values.[id]
+*/
+func (p *parser) ParseUpdateManyByIDQuery(records ...sql.Record) (string, []any, error) {
+	if len(records) == 0 {
+		return "", nil, errors.New("no record provided")
+	}
+	record := records[0]
+	var lastIndex int
+	tableName, _, err := parseTableName(record.Table(), &lastIndex)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", nil, err
+	}
+	idColumn, err := parseIdColumn(record)
+	if err != nil {
+		return "", nil, err
+	}
+	// the table is updated by its alias when it has one
+	target := quote(record.Table().Name)
+	if record.Table().Alias != "" {
+		target = quote(record.Table().Alias)
+	}
+	columns := []string{idColumn}
+	updates := []string{}
+	for _, column := range record.Columns() {
+		if column.Name == record.IdColumn() {
+			continue // the id column is the first column of the values
+		}
+		name := quote(column.Name)
+		columns = append(columns, name)
+		updates = append(updates, fmt.Sprintf("%s = update_values.%s", name, name))
+	}
+	if len(updates) == 0 {
+		return "", nil, sql.NewInvalidQueryError("update many query:: no columns to update")
+	}
+	rows := make([]string, len(records))
+	values := make([]any, 0, len(records)*len(columns))
+	for i, r := range records {
+		if len(r.Values()) != len(columns)-1 {
+			return "", nil, sql.NewInvalidQueryError("update many query:: every record should have %d values", len(columns)-1)
+		}
+		rows[i] = fmt.Sprintf("(%s)", getPlaceHolders(len(columns), &lastIndex))
+		values = append(append(values, r.ID()), r.Values()...)
+	}
+	return fmt.Sprintf(mssqlUpdateManyByIDQuery, target, strings.Join(updates, ", "), tableName, strings.Join(rows, ", "),
+		strings.Join(columns, ", "), target, idColumn, idColumn), values, nil
+}
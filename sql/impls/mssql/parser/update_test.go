@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/tests/records"
 )
 
 func TestParseUpdateQuery(t *testing.T) {
@@ -17,7 +18,7 @@ func TestParseUpdateQuery(t *testing.T) {
 		name    string
 		args    args
 		want    string
-		want1   []int
+		want1   []sql.Param
 		wantErr bool
 	}{
 		{
@@ -33,8 +34,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Operator: sql.EQ,
 				},
 			},
-			want:    "UPDATE users SET name = 'Alice Updated', email = 'alice.updated@example.com' WHERE id = @p1",
-			want1:   []int{0},
+			want:    "UPDATE [users] SET [name] = @p1, [email] = @p2 WHERE [id] = @p3",
+			want1:   []sql.Param{{Value: "Alice Updated", Fixed: true}, {Value: "alice.updated@example.com", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -60,8 +61,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					},
 				},
 			},
-			want:    "UPDATE users SET is_active = 0, updated_at = 123456789 WHERE (email LIKE @p1 AND is_active = 1)",
-			want1:   []int{0},
+			want:    "UPDATE [users] SET [is_active] = @p1, [updated_at] = @p2 WHERE ([email] LIKE @p3 AND [is_active] = @p4)",
+			want1:   []sql.Param{{Value: 0, Fixed: true}, {Value: int64(123456789), Fixed: true}, {Index: 0}, {Value: 1, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -72,8 +73,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("updated_at", sql.NewValue(int64(987654321))),
 				condition: nil,
 			},
-			want:    "UPDATE users SET updated_at = 987654321 WHERE 1=1",
-			want1:   nil,
+			want:    "UPDATE [users] SET [updated_at] = @p1 WHERE 1=1",
+			want1:   []sql.Param{{Value: int64(987654321), Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -229,7 +230,7 @@ func TestParseUpdatesAdditionalCases(t *testing.T) {
 				},
 			},
 			lastIndex: 0,
-			want:      "name = 'John', age = @p1, email = users.email",
+			want:      "[name] = @p1, [age] = @p2, [email] = [users].[email]",
 			wantErr:   false,
 		},
 		{
@@ -240,7 +241,7 @@ func TestParseUpdatesAdditionalCases(t *testing.T) {
 				},
 			},
 			lastIndex: 0,
-			want:      "name = @p1",
+			want:      "[name] = @p1",
 			wantErr:   false,
 		},
 	}
@@ -258,3 +259,55 @@ func TestParseUpdatesAdditionalCases(t *testing.T) {
 		})
 	}
 }
+
+func TestParseUpdateReturningQuery(t *testing.T) {
+	type args struct {
+		updates   *sql.Updates
+		condition *sql.Condition
+		records   sql.Records
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		want1   []sql.Param
+		wantErr bool
+	}{
+		{
+			name: "test update returning with indexed condition",
+			args: args{
+				updates:   sql.NewUpdates().Add("is_active", sql.NewValue(0)),
+				condition: sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0)),
+				records:   &records.Users{},
+			},
+			want:    "UPDATE [users] SET [is_active] = @p1 OUTPUT INSERTED.[id], INSERTED.[name], INSERTED.[email], INSERTED.[password_hash], INSERTED.[score], INSERTED.[is_active], INSERTED.[created_at], INSERTED.[updated_at] WHERE [email] = @p2",
+			want1:   []sql.Param{{Value: 0, Fixed: true}, {Index: 0}},
+			wantErr: false,
+		},
+		{
+			name: "test update returning with nil records",
+			args: args{
+				updates:   sql.NewUpdates().Add("is_active", sql.NewValue(0)),
+				condition: sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0)),
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, err := prsr.ParseUpdateReturningQuery(tt.args.updates, tt.args.condition, tt.args.records)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseUpdateReturningQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseUpdateReturningQuery() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("ParseUpdateReturningQuery() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
@@ -3,45 +3,235 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/gofreego/database/sql"
 )
 
 const (
-	upsertQuery = "INSERT INTO %s (%s) VALUES %s ON DUPLICATE KEY UPDATE %s"
+	// HOLDLOCK keeps the matched range locked until the insert, so concurrent upserts of the same row do not both insert it.
+	// The id, the action and the position of the merged rows are output into a table variable, OUTPUT without INTO fails on tables with triggers
+	upsertQuery = "DECLARE @upserted TABLE (id BIGINT, inserted BIT, ordinal INT); " +
+		"%s OUTPUT INSERTED.%s, CASE WHEN $action = 'INSERT' THEN 1 ELSE 0 END, %s.insert_ordinal INTO @upserted; " +
+		"SELECT id, inserted FROM @upserted ORDER BY ordinal"
+	mergeQuery = "MERGE INTO %s WITH (HOLDLOCK)%s USING (VALUES %s) AS %s (%s, insert_ordinal) ON %s%s WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s)"
+	// upsertMatchedClause updates the matched row, with the condition of the conflict options if any
+	upsertMatchedClause = " WHEN MATCHED%s THEN UPDATE SET %s"
 )
 
-func (p *parser) ParseUpsertQuery(record sql.Record) (string, []any, error) {
-	if record == nil {
+/*
+ParseUpsertQuery returns the MERGE inserting the records or updating the rows they conflict with.
+The records are the source of the MERGE, named sql.Excluded, they match the rows with the conflict columns of the conflict options,
+the id column by default which is matched with the IDs of the records. mssql cannot name a constraint of the conflict.
+The query returns the id of every merged row and whether it was inserted, in the order of the records,
+no row is returned for a record whose existing row is left unchanged.
+eg. DECLARE @upserted TABLE (id BIGINT, inserted BIT, ordinal INT);
+MERGE INTO [users] WITH (HOLDLOCK) USING (VALUES (@p1, @p2, 0)) AS [excluded] ([name], [email], insert_ordinal) ON [users].[email] = [excluded].[email]
+WHEN MATCHED THEN UPDATE SET [name] = [excluded].[name] WHEN NOT MATCHED THEN INSERT ([name], [email]) VALUES ([excluded].[name], [excluded].[email])
+OUTPUT INSERTED.[id], CASE WHEN $action = 'INSERT' THEN 1 ELSE 0 END, [excluded].insert_ordinal INTO @upserted; SELECT id, inserted FROM @upserted ORDER BY ordinal
+*/
+func (p *parser) ParseUpsertQuery(conflict *sql.Conflict, records ...sql.Record) (string, []any, error) {
+	if len(records) == 0 || records[0] == nil {
 		return "", nil, errors.New("no record provided")
 	}
-	var lastIndex int
-	tableName, err := parseTableName(record.Table(), &lastIndex)
+	if err := conflict.Validate(); err != nil {
+		return "", nil, err
+	}
+	merge, values, err := parseMergeQuery(conflict, records)
+	if err != nil {
+		return "", nil, err
+	}
+	idColumn, err := parseIdColumn(records[0])
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf(upsertQuery, merge, idColumn, quote(sql.Excluded)), values, nil
+}
+
+/*
+parseMergeQuery returns the MERGE of the records into their table without its OUTPUT clause, see ParseUpsertQuery.
+The records are the source of the MERGE, named sql.Excluded, with their position in the column insert_ordinal.
+*/
+func parseMergeQuery(conflict *sql.Conflict, records []sql.Record) (string, []any, error) {
+	if conflict != nil && conflict.Constraint != "" {
+		return "", nil, sql.NewInvalidQueryError("invalid conflict: mssql does not support conflicts on a named constraint")
+	}
+	record := records[0]
+	table := record.Table()
+	if table == nil {
+		return "", nil, sql.NewInvalidQueryError("invalid table: table cannot be nil")
+	}
+	if err := table.Validate(); err != nil {
+		return "", nil, err
+	}
+	if len(table.Join) > 0 {
+		return "", nil, sql.NewInvalidQueryError("invalid table: upsert table cannot have joins")
+	}
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", nil, err
+	}
+	idColumn, err := parseIdColumn(record)
 	if err != nil {
 		return "", nil, err
 	}
-	placeholders, values := getValuesPlaceHolders(&lastIndex, record)
-	if len(values) == 0 {
+	if len(record.Values()) == 0 {
 		return "", nil, errors.New("no values provided for upsert")
 	}
+	target := upsertTable(table)
+	excluded := quote(sql.Excluded)
 
-	updates := parseUpsertUpdates(record)
-	if updates == "" {
-		return "", nil, errors.New("no columns to update")
+	insertColumns := []string{}
+	for _, column := range record.Columns() {
+		if column.Name != record.IdColumn() {
+			insertColumns = append(insertColumns, column.Name)
+		}
+	}
+	// the id is not a value of the records, it is added to the source if the rows are matched with the IDs of the records
+	matchID := false
+	on := []string{}
+	for _, column := range conflict.ConflictColumns(record) {
+		switch {
+		case column == record.IdColumn():
+			matchID = true
+			on = append(on, fmt.Sprintf("%s.%s = %s.%s", target, idColumn, excluded, idColumn))
+		case slices.Contains(insertColumns, column):
+			on = append(on, fmt.Sprintf("%s.%s = %s.%s", target, quote(column), excluded, quote(column)))
+		default:
+			return "", nil, sql.NewInvalidQueryError("invalid conflict: conflict column %s is not a column of the record", column)
+		}
 	}
 
-	return fmt.Sprintf(upsertQuery, tableName, parseInsertColumns(record), placeholders, updates), values, nil
+	var lastIndex int
+	rows := make([]string, len(records))
+	values := make([]any, 0, len(records)*(len(insertColumns)+1))
+	for i, r := range records {
+		if len(r.Values()) != len(insertColumns) {
+			return "", nil, sql.NewInvalidQueryError("upsert query:: every record should have %d values", len(insertColumns))
+		}
+		values = append(values, r.Values()...)
+		placeholders := getPlaceHolders(len(insertColumns), &lastIndex)
+		if matchID {
+			lastIndex++
+			placeholders += fmt.Sprintf(", @p%d", lastIndex)
+			values = append(values, r.ID())
+		}
+		rows[i] = fmt.Sprintf("(%s, %d)", placeholders, i)
+	}
+	sourceColumns := quoteAll(insertColumns)
+	if matchID {
+		sourceColumns += ", " + idColumn
+	}
+
+	matched := ""
+	if conflict == nil || !conflict.DoNothing {
+		updates, err := parseUpsertUpdates(record, conflict)
+		if err != nil {
+			return "", nil, err
+		}
+		if updates == "" {
+			return "", nil, errors.New("no columns to update")
+		}
+		condition := ""
+		if conflict != nil && conflict.Where != nil {
+			where, whereValues, err := parseUpsertCondition(conflict.Where, target, &lastIndex)
+			if err != nil {
+				return "", nil, err
+			}
+			condition = " AND " + where
+			values = append(values, whereValues...)
+		}
+		matched = fmt.Sprintf(upsertMatchedClause, condition, updates)
+	}
+
+	insertValues := make([]string, len(insertColumns))
+	for i, column := range insertColumns {
+		insertValues[i] = excluded + "." + quote(column)
+	}
+	return fmt.Sprintf(mergeQuery, quote(table.Name), getAlias(table.Alias), strings.Join(rows, ", "), excluded, sourceColumns,
+		strings.Join(on, " AND "), matched, quoteAll(insertColumns), strings.Join(insertValues, ", ")), values, nil
 }
 
-func parseUpsertUpdates(record sql.Record) string {
+func parseUpsertUpdates(record sql.Record, conflict *sql.Conflict) (string, error) {
+	columns, err := conflict.UpdateColumns(record)
+	if err != nil {
+		return "", err
+	}
 	updates := []string{}
-	idColumn := record.IdColumn()
-	for _, col := range record.Columns() {
-		if col.Name == idColumn {
-			continue // Skip the ID column in the update
+	for _, column := range columns {
+		updates = append(updates, fmt.Sprintf("%s = %s.%s", quote(column), quote(sql.Excluded), quote(column)))
+	}
+	return strings.Join(updates, ", "), nil
+}
+
+// upsertTable returns the quoted name the columns of the existing row are qualified with, the alias of the table if it has one
+func upsertTable(table *sql.Table) string {
+	if table.Alias != "" {
+		return quote(table.Alias)
+	}
+	return quote(table.Name)
+}
+
+/*
+parseUpsertCondition parses the condition of the update on conflict, the columns are qualified with the table
+as the unqualified columns are ambiguous with the columns of the excluded row.
+Only fixed values can be used in the condition, their values are returned in placeholder order.
+*/
+func parseUpsertCondition(condition *sql.Condition, table string, lastIndex *int) (string, []any, error) {
+	qualified, err := qualifyUpsertCondition(*condition, table)
+	if err != nil {
+		return "", nil, err
+	}
+	where, params, err := parseCondition(&qualified, lastIndex)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, param := range params {
+		if !param.Fixed {
+			return "", nil, sql.NewInvalidQueryError("invalid conflict: only fixed values can be used in the condition")
+		}
+	}
+	return where, sql.GetParamValues(params, nil), nil
+}
+
+// qualifyUpsertCondition returns a copy of the condition with its fields and column values rendered as qualified columns
+func qualifyUpsertCondition(condition sql.Condition, table string) (sql.Condition, error) {
+	if len(condition.Conditions) > 0 {
+		conditions := make([]sql.Condition, len(condition.Conditions))
+		for i, subCondition := range condition.Conditions {
+			qualified, err := qualifyUpsertCondition(subCondition, table)
+			if err != nil {
+				return sql.Condition{}, err
+			}
+			conditions[i] = qualified
+		}
+		condition.Conditions = conditions
+		return condition, nil
+	}
+	if condition.Field != "" && !condition.RawField {
+		column, err := qualifyUpsertColumn(condition.Field, table)
+		if err != nil {
+			return sql.Condition{}, err
+		}
+		condition.Field, condition.RawField = column, true
+	}
+	if condition.Value != nil && condition.Value.IsColumn() && condition.Value.IsStringValue() {
+		column, err := qualifyUpsertColumn(condition.Value.Value.(string), table)
+		if err != nil {
+			return sql.Condition{}, err
 		}
-		updates = append(updates, fmt.Sprintf("%s = VALUES(%s)", col.Name, col.Name))
+		condition.Value = sql.NewExpressionValue(column)
+	}
+	return condition, nil
+}
+
+// qualifyUpsertColumn quotes the column, qualified with the table unless it is already qualified, eg. with sql.Excluded
+func qualifyUpsertColumn(column, table string) (string, error) {
+	if err := sql.ValidateIdentifier(column); err != nil {
+		return "", err
+	}
+	if strings.Contains(column, ".") {
+		return quote(column), nil
 	}
-	return strings.Join(updates, ", ")
+	return table + "." + quote(column), nil
 }
@@ -10,7 +10,8 @@ import (
 
 func TestParseUpsertQuery(t *testing.T) {
 	type args struct {
-		record sql.Record
+		record   sql.Record
+		conflict *sql.Conflict
 	}
 	tests := []struct {
 		name    string
@@ -32,8 +33,8 @@ func TestParseUpsertQuery(t *testing.T) {
 					UpdatedAt:    456,
 				},
 			},
-			want:    "INSERT INTO users (name, email, password_hash, score, is_active, created_at, updated_at) VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7) ON DUPLICATE KEY UPDATE name = VALUES(name), email = VALUES(email), password_hash = VALUES(password_hash), score = VALUES(score), is_active = VALUES(is_active), created_at = VALUES(created_at), updated_at = VALUES(updated_at)",
-			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456)},
+			want:    "DECLARE @upserted TABLE (id BIGINT, inserted BIT, ordinal INT); MERGE INTO [users] WITH (HOLDLOCK) USING (VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7, @p8, 0)) AS [excluded] ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at], [id], insert_ordinal) ON [users].[id] = [excluded].[id] WHEN MATCHED THEN UPDATE SET [name] = [excluded].[name], [email] = [excluded].[email], [password_hash] = [excluded].[password_hash], [score] = [excluded].[score], [is_active] = [excluded].[is_active], [created_at] = [excluded].[created_at], [updated_at] = [excluded].[updated_at] WHEN NOT MATCHED THEN INSERT ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at]) VALUES ([excluded].[name], [excluded].[email], [excluded].[password_hash], [excluded].[score], [excluded].[is_active], [excluded].[created_at], [excluded].[updated_at]) OUTPUT INSERTED.[id], CASE WHEN $action = 'INSERT' THEN 1 ELSE 0 END, [excluded].insert_ordinal INTO @upserted; SELECT id, inserted FROM @upserted ORDER BY ordinal",
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456), int64(1)},
 			wantErr: false,
 		},
 		{
@@ -63,10 +64,154 @@ func TestParseUpsertQuery(t *testing.T) {
 			want1:   nil,
 			wantErr: true,
 		},
+		{
+			name: "conflict columns",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}},
+			},
+			want:    "DECLARE @upserted TABLE (id BIGINT, inserted BIT, ordinal INT); MERGE INTO [users] WITH (HOLDLOCK) USING (VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7, 0)) AS [excluded] ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at], insert_ordinal) ON [users].[email] = [excluded].[email] WHEN MATCHED THEN UPDATE SET [name] = [excluded].[name], [password_hash] = [excluded].[password_hash], [score] = [excluded].[score], [is_active] = [excluded].[is_active], [created_at] = [excluded].[created_at], [updated_at] = [excluded].[updated_at] WHEN NOT MATCHED THEN INSERT ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at]) VALUES ([excluded].[name], [excluded].[email], [excluded].[password_hash], [excluded].[score], [excluded].[is_active], [excluded].[created_at], [excluded].[updated_at]) OUTPUT INSERTED.[id], CASE WHEN $action = 'INSERT' THEN 1 ELSE 0 END, [excluded].insert_ordinal INTO @upserted; SELECT id, inserted FROM @upserted ORDER BY ordinal",
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456)},
+			wantErr: false,
+		},
+		{
+			name: "update columns with condition",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}, Update: []string{"updated_at", "name"}, Where: sql.NewCondition("updated_at", sql.LT, sql.NewColumnValue(sql.ExcludedColumn("updated_at"))).And(sql.NewCondition("is_active", sql.EQ, sql.NewValue(1)))},
+			},
+			want:    "DECLARE @upserted TABLE (id BIGINT, inserted BIT, ordinal INT); MERGE INTO [users] WITH (HOLDLOCK) USING (VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7, 0)) AS [excluded] ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at], insert_ordinal) ON [users].[email] = [excluded].[email] WHEN MATCHED AND ([users].[updated_at] < [excluded].[updated_at] AND [users].[is_active] = @p8) THEN UPDATE SET [updated_at] = [excluded].[updated_at], [name] = [excluded].[name] WHEN NOT MATCHED THEN INSERT ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at]) VALUES ([excluded].[name], [excluded].[email], [excluded].[password_hash], [excluded].[score], [excluded].[is_active], [excluded].[created_at], [excluded].[updated_at]) OUTPUT INSERTED.[id], CASE WHEN $action = 'INSERT' THEN 1 ELSE 0 END, [excluded].insert_ordinal INTO @upserted; SELECT id, inserted FROM @upserted ORDER BY ordinal",
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456), 1},
+			wantErr: false,
+		},
+		{
+			name: "do nothing",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}, DoNothing: true},
+			},
+			want:    "DECLARE @upserted TABLE (id BIGINT, inserted BIT, ordinal INT); MERGE INTO [users] WITH (HOLDLOCK) USING (VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7, 0)) AS [excluded] ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at], insert_ordinal) ON [users].[email] = [excluded].[email] WHEN NOT MATCHED THEN INSERT ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at]) VALUES ([excluded].[name], [excluded].[email], [excluded].[password_hash], [excluded].[score], [excluded].[is_active], [excluded].[created_at], [excluded].[updated_at]) OUTPUT INSERTED.[id], CASE WHEN $action = 'INSERT' THEN 1 ELSE 0 END, [excluded].insert_ordinal INTO @upserted; SELECT id, inserted FROM @upserted ORDER BY ordinal",
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456)},
+			wantErr: false,
+		},
+		{
+			name: "constraint",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Constraint: "users_email_key"},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "indexed value in condition",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Where: sql.NewCondition("score", sql.GT, sql.NewIndexedValue(0))},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "update column is not a value of the record",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Update: []string{"id"}},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "columns and constraint",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}, Constraint: "users_email_key"},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "do nothing with update columns",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{DoNothing: true, Update: []string{"name"}},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, got1, err := prsr.ParseUpsertQuery(tt.args.record)
+			got, got1, err := prsr.ParseUpsertQuery(tt.args.conflict, tt.args.record)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseUpsertQuery() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -101,10 +246,29 @@ func TestParseUpsertQueryEdgeCases(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, _, err := prsr.ParseUpsertQuery(tt.record)
+			_, _, err := prsr.ParseUpsertQuery(nil, tt.record)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseUpsertQuery() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
+
+func TestParseUpsertQueryManyRecords(t *testing.T) {
+	users := []sql.Record{
+		&records.User{Name: "John", Email: "john@example.com", PasswordHash: "hash", UpdatedAt: 1},
+		&records.User{Name: "Jane", Email: "jane@example.com", PasswordHash: "hash", UpdatedAt: 2},
+	}
+	got, got1, err := prsr.ParseUpsertQuery(&sql.Conflict{Columns: []string{"email"}, Update: []string{"name"}}, users...)
+	if err != nil {
+		t.Fatalf("ParseUpsertQuery() error = %v", err)
+	}
+	want := `DECLARE @upserted TABLE (id BIGINT, inserted BIT, ordinal INT); MERGE INTO [users] WITH (HOLDLOCK) USING (VALUES (@p1, @p2, @p3, @p4, @p5, @p6, @p7, 0), (@p8, @p9, @p10, @p11, @p12, @p13, @p14, 1)) AS [excluded] ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at], insert_ordinal) ON [users].[email] = [excluded].[email] WHEN MATCHED THEN UPDATE SET [name] = [excluded].[name] WHEN NOT MATCHED THEN INSERT ([name], [email], [password_hash], [score], [is_active], [created_at], [updated_at]) VALUES ([excluded].[name], [excluded].[email], [excluded].[password_hash], [excluded].[score], [excluded].[is_active], [excluded].[created_at], [excluded].[updated_at]) OUTPUT INSERTED.[id], CASE WHEN $action = 'INSERT' THEN 1 ELSE 0 END, [excluded].insert_ordinal INTO @upserted; SELECT id, inserted FROM @upserted ORDER BY ordinal`
+	if got != want {
+		t.Errorf("ParseUpsertQuery() got = %v, want %v", got, want)
+	}
+	want1 := []any{"John", "john@example.com", "hash", 0, 0, int64(0), int64(1), "Jane", "jane@example.com", "hash", 0, 0, int64(0), int64(2)}
+	if !reflect.DeepEqual(got1, want1) {
+		t.Errorf("ParseUpsertQuery() got1 = %v, want %v", got1, want1)
+	}
+}
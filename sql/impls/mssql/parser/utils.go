@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -29,11 +30,10 @@ func parseInsertColumns(record sql.Record) string {
 	columns := []string{}
 	idColumn := record.IdColumn()
 	for _, col := range record.Columns() {
-		c := parseField(col)
-		if c == idColumn {
+		if col.Name == idColumn {
 			continue
 		}
-		columns = append(columns, c)
+		columns = append(columns, quote(col.Name))
 	}
 	return strings.Join(columns, ", ")
 }
@@ -60,6 +60,39 @@ func parseColumns(fields []*sql.Field) string {
 	return strings.Join(columnStrings, ", ")
 }
 
+// validateColumns validates the names and aliases of the columns, they are quoted when they are rendered
+func validateColumns(fields []*sql.Field) error {
+	for _, field := range fields {
+		if err := field.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseIdColumn validates and quotes the id column of the record
+func parseIdColumn(record sql.Record) (string, error) {
+	idColumn := record.IdColumn()
+	if err := sql.ValidateIdentifier(idColumn); err != nil {
+		return "", err
+	}
+	return quote(idColumn), nil
+}
+
+// quote quotes the identifier for mssql, eg. users.id is rendered as [users].[id]
+func quote(identifier string) string {
+	return sql.MSSQL.QuoteIdentifier(identifier)
+}
+
+// quoteAll quotes the identifiers and joins them into a comma separated list
+func quoteAll(identifiers []string) string {
+	quoted := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		quoted[i] = quote(identifier)
+	}
+	return strings.Join(quoted, ", ")
+}
+
 var (
 	aggregateFuncMap = map[sql.AggregateFunc]string{
 		sql.Count: "COUNT",
@@ -75,6 +108,9 @@ var (
 func parseField(field *sql.Field) string {
 	if field.Name != "" {
 		res := field.Name
+		if !field.Raw && field.Name != "*" {
+			res = quote(field.Name)
+		}
 		if field.Distinct {
 			res = "DISTINCT " + res
 		}
@@ -82,7 +118,7 @@ func parseField(field *sql.Field) string {
 			res = fmt.Sprintf("%s(%s)", aggregateFuncMap[field.Func], res)
 		}
 		if field.Alias != "" {
-			res += " AS " + field.Alias
+			res += " AS " + quote(field.Alias)
 		}
 		return res
 	}
@@ -95,9 +131,44 @@ func parseField(field *sql.Field) string {
 			res = fmt.Sprintf("%s(%s)", aggregateFuncMap[field.Func], res)
 		}
 		if field.Alias != "" {
-			res += " AS " + field.Alias
+			res += " AS " + quote(field.Alias)
 		}
 		return res
 	}
 	return ""
 }
+
+// parseOutputColumns returns the columns of the fields qualified with the INSERTED or DELETED row of an OUTPUT clause,
+// the fields should be columns of the table, a qualified column is output by the name of its last part.
+func parseOutputColumns(row string, fields []*sql.Field) (string, error) {
+	if len(fields) == 0 {
+		return "", errors.New("no columns to output")
+	}
+	if err := validateColumns(fields); err != nil {
+		return "", err
+	}
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		if field.Name == "" || field.Raw || field.Func != sql.None || field.Distinct {
+			return "", sql.NewInvalidQueryError("invalid output column: only the columns of the table can be output")
+		}
+		name := field.Name[strings.LastIndex(field.Name, ".")+1:]
+		if name == "*" {
+			columns[i] = row + ".*"
+		} else {
+			columns[i] = row + "." + quote(name)
+		}
+		if field.Alias != "" {
+			columns[i] += " AS " + quote(field.Alias)
+		}
+	}
+	return strings.Join(columns, ", "), nil
+}
+
+// parseOutput returns the OUTPUT clause of the columns, with a leading space, empty if there are no columns
+func parseOutput(columns string) string {
+	if columns == "" {
+		return ""
+	}
+	return " OUTPUT " + columns
+}
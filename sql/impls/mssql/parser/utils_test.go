@@ -17,7 +17,7 @@ func TestParseField(t *testing.T) {
 			field: &sql.Field{
 				Name: "id",
 			},
-			want: "id",
+			want: "[id]",
 		},
 		{
 			name: "field with alias",
@@ -25,7 +25,7 @@ func TestParseField(t *testing.T) {
 				Name:  "name",
 				Alias: "user_name",
 			},
-			want: "name AS user_name",
+			want: "[name] AS [user_name]",
 		},
 		{
 			name: "field with distinct",
@@ -33,7 +33,7 @@ func TestParseField(t *testing.T) {
 				Name:     "email",
 				Distinct: true,
 			},
-			want: "DISTINCT email",
+			want: "DISTINCT [email]",
 		},
 		{
 			name: "field with function",
@@ -41,7 +41,7 @@ func TestParseField(t *testing.T) {
 				Name: "age",
 				Func: sql.Count,
 			},
-			want: "COUNT(age)",
+			want: "COUNT([age])",
 		},
 		{
 			name: "field with distinct and function",
@@ -50,7 +50,7 @@ func TestParseField(t *testing.T) {
 				Distinct: true,
 				Func:     sql.Count,
 			},
-			want: "COUNT(DISTINCT age)",
+			want: "COUNT(DISTINCT [age])",
 		},
 		{
 			name: "field with function and alias",
@@ -59,7 +59,7 @@ func TestParseField(t *testing.T) {
 				Func:  sql.Avg,
 				Alias: "average_age",
 			},
-			want: "AVG(age) AS average_age",
+			want: "AVG([age]) AS [average_age]",
 		},
 		{
 			name: "field with distinct, function and alias",
@@ -69,7 +69,7 @@ func TestParseField(t *testing.T) {
 				Func:     sql.Sum,
 				Alias:    "total_age",
 			},
-			want: "SUM(DISTINCT age) AS total_age",
+			want: "SUM(DISTINCT [age]) AS [total_age]",
 		},
 		{
 			name: "nested field",
@@ -78,7 +78,7 @@ func TestParseField(t *testing.T) {
 					Name: "sub_field",
 				},
 			},
-			want: "sub_field",
+			want: "[sub_field]",
 		},
 		{
 			name: "nested field with distinct",
@@ -88,7 +88,7 @@ func TestParseField(t *testing.T) {
 				},
 				Distinct: true,
 			},
-			want: "DISTINCT sub_field",
+			want: "DISTINCT [sub_field]",
 		},
 		{
 			name: "nested field with function",
@@ -98,7 +98,7 @@ func TestParseField(t *testing.T) {
 				},
 				Func: sql.Max,
 			},
-			want: "MAX(sub_field)",
+			want: "MAX([sub_field])",
 		},
 		{
 			name: "nested field with distinct, function and alias",
@@ -110,7 +110,7 @@ func TestParseField(t *testing.T) {
 				Func:     sql.Min,
 				Alias:    "min_value",
 			},
-			want: "MIN(DISTINCT sub_field) AS min_value",
+			want: "MIN(DISTINCT [sub_field]) AS [min_value]",
 		},
 		{
 			name:  "empty field",
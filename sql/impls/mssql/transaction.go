@@ -0,0 +1,36 @@
+package mssql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofreego/database/sql"
+)
+
+const (
+	// the transaction was chosen as the deadlock victim
+	deadlockVictim = 1205
+	// a snapshot isolation transaction updated a row changed by another transaction
+	snapshotUpdateConflict = 3960
+)
+
+// BeginTransaction begins a transaction, the operations of the returned handle run in it.
+func (c *MssqlDatabase) BeginTransaction(ctx context.Context, options ...sql.Options) (sql.Tx, error) {
+	return c.Executor.BeginTransaction(ctx, c, options...)
+}
+
+// RunInTransaction runs fn in a transaction, retried with Options.Retry on deadlocks and snapshot update conflicts.
+func (c *MssqlDatabase) RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx sql.Tx) error, options ...sql.Options) error {
+	return c.Executor.RunInTransaction(ctx, c, fn, isRetryable, options...)
+}
+
+// isRetryable reports whether the transaction failed on a deadlock or a snapshot update conflict,
+// the driver returns its errors by value, read through SQLErrorNumber to match pointers as well.
+func isRetryable(err error) bool {
+	var mssqlErr interface{ SQLErrorNumber() int32 }
+	if !errors.As(err, &mssqlErr) {
+		return false
+	}
+	number := mssqlErr.SQLErrorNumber()
+	return number == deadlockVictim || number == snapshotUpdateConflict
+}
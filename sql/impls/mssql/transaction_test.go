@@ -0,0 +1,30 @@
+package mssql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofreego/database/sql"
+	mssql "github.com/microsoft/go-mssqldb"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"deadlock victim", sql.NewDatabaseError(mssql.Error{Number: 1205}), true},
+		{"deadlock victim pointer", sql.NewDatabaseError(&mssql.Error{Number: 1205}), true},
+		{"snapshot update conflict", sql.NewDatabaseError(mssql.Error{Number: 3960}), true},
+		{"unique violation", sql.NewDatabaseError(mssql.Error{Number: 2627}), false},
+		{"other error", errors.New("connection refused"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package mysql
+
+import (
+	"bufio"
+	"context"
+	driver "database/sql"
+	sqldriver "database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/impls/common"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/gofreego/goutils/logger"
+)
+
+const (
+	// the rows are sent in the default text format of LOAD DATA, set explicitly as the server defaults can be changed
+	mysqlLoadDataQuery = "LOAD DATA LOCAL INFILE 'Reader::%s' INTO TABLE %s FIELDS TERMINATED BY '\\t' ESCAPED BY '\\\\' LINES TERMINATED BY '\\n' (%s)"
+	// showWarningsQuery returns the warnings of the load data query, run on the connection of its transaction
+	showWarningsQuery = "SHOW WARNINGS"
+)
+
+var (
+	// loadDataReaders numbers the reader handlers, every bulk load registers a handler of its own
+	loadDataReaders atomic.Int64
+	// errLoadDataDone stops sending the rows once the load data query fails
+	errLoadDataDone = errors.New("load data query failed")
+)
+
+/*
+BulkLoad loads the records of the source into the columns of the table with LOAD DATA LOCAL INFILE.
+The rows are streamed to the driver through a reader handler, local_infile must be enabled on the server.
+LOAD DATA LOCAL skips the rows of duplicate keys and converts the invalid values with warnings instead of failing,
+so the load fails if a row is skipped or a warning is reported, and its transaction is rolled back unless it is Options.Transaction.
+Options.Progress is called from the goroutine sending the rows.
+*/
+func (c *MysqlDatabase) BulkLoad(ctx context.Context, table *sql.Table, columns []string, source iter.Seq[sql.Record], options ...sql.Options) (int64, error) {
+	if err := common.ValidateBulkLoad(table, columns); err != nil {
+		return 0, err
+	}
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := common.WithTimeout(ctx, opt, c.Timeouts().BulkLoad)
+	defer cancel()
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = sql.MySQL.QuoteIdentifier(column)
+	}
+	return common.InBulkTransaction(ctx, c.db, opt, func(txn *driver.Tx) (int64, error) {
+		reader, writer := io.Pipe()
+		name := fmt.Sprintf("bulk_load_%d", loadDataReaders.Add(1))
+		mysql.RegisterReaderHandler(name, func() io.Reader { return reader })
+		defer mysql.DeregisterReaderHandler(name)
+
+		var sentRows int64
+		var sendErr error
+		// sent is closed once the rows are sent or sending them failed, before the end of the file
+		sent := make(chan struct{})
+		go func() {
+			w := bufio.NewWriter(writer)
+			sentRows, sendErr = common.BulkRows(ctx, source, columns, opt, func(values []any) error {
+				return writeLoadDataRow(w, values)
+			})
+			if sendErr == nil {
+				sendErr = internal.HandleContextError(ctx, w.Flush())
+			}
+			close(sent)
+			// a nil error ends the file
+			writer.CloseWithError(sendErr)
+		}()
+
+		query := fmt.Sprintf(mysqlLoadDataQuery, name, sql.MySQL.QuoteIdentifier(table.Name), strings.Join(quoted, ", "))
+		logger.Debug(ctx, "BulkLoad query: %s", query)
+		res, err := txn.ExecContext(ctx, query)
+		select {
+		case <-sent:
+		default:
+			// the query failed before the end of the file, stop sending the rows
+			reader.CloseWithError(errLoadDataDone)
+			<-sent
+			sendErr = nil
+		}
+		if sendErr != nil {
+			return 0, sendErr
+		}
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		if err := checkLoadData(ctx, txn, sentRows, rows); err != nil {
+			return 0, err
+		}
+		return rows, nil
+	})
+}
+
+// checkLoadData returns an error if the load data query of the transaction loaded fewer rows than sent or reported a warning,
+// the notes are ignored.
+func checkLoadData(ctx context.Context, txn *driver.Tx, sent, loaded int64) error {
+	rows, err := txn.QueryContext(ctx, showWarningsQuery)
+	if err != nil {
+		return internal.HandleContextError(ctx, err)
+	}
+	defer rows.Close()
+	warning := ""
+	for rows.Next() {
+		var level, message string
+		var code int64
+		if err := rows.Scan(&level, &code, &message); err != nil {
+			return internal.HandleContextError(ctx, err)
+		}
+		if level != "Note" {
+			warning = fmt.Sprintf("%s %d: %s", level, code, message)
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return internal.HandleContextError(ctx, err)
+	}
+	switch {
+	case loaded != sent && warning != "":
+		return sql.NewDatabaseError(fmt.Errorf("bulk load:: %d of the %d rows sent are loaded, %s", loaded, sent, warning))
+	case loaded != sent:
+		return sql.NewDatabaseError(fmt.Errorf("bulk load:: %d of the %d rows sent are loaded", loaded, sent))
+	case warning != "":
+		return sql.NewDatabaseError(fmt.Errorf("bulk load:: %s", warning))
+	}
+	return nil
+}
+
+// writeLoadDataRow writes the values as a line of tab separated fields.
+func writeLoadDataRow(w *bufio.Writer, values []any) error {
+	for i, value := range values {
+		if i > 0 {
+			w.WriteByte('\t')
+		}
+		if err := writeLoadDataField(w, value); err != nil {
+			return err
+		}
+	}
+	return w.WriteByte('\n')
+}
+
+// writeLoadDataField writes the value as a field, NULL is written as \N and the special characters are escaped.
+func writeLoadDataField(w *bufio.Writer, value any) error {
+	// converts pointers, driver.Valuer and the named types to the driver's value types
+	value, err := sqldriver.DefaultParameterConverter.ConvertValue(value)
+	if err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case nil:
+		_, err = w.WriteString(`\N`)
+	case string:
+		writeLoadDataString(w, v)
+	case []byte:
+		writeLoadDataString(w, string(v))
+	case bool:
+		if v {
+			err = w.WriteByte('1')
+		} else {
+			err = w.WriteByte('0')
+		}
+	case int64:
+		_, err = w.WriteString(strconv.FormatInt(v, 10))
+	case float64:
+		_, err = w.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+	case time.Time:
+		// the driver binds the times of the other queries in UTC, the location of the connections
+		_, err = w.WriteString(v.UTC().Format("2006-01-02 15:04:05.999999"))
+	default:
+		writeLoadDataString(w, fmt.Sprint(v))
+	}
+	return err
+}
+
+// writeLoadDataString writes the string with the escape sequences of LOAD DATA, write errors are returned by the next write or flush.
+func writeLoadDataString(w *bufio.Writer, s string) {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			w.WriteString(`\\`)
+		case '\t':
+			w.WriteString(`\t`)
+		case '\n':
+			w.WriteString(`\n`)
+		case '\r':
+			w.WriteString(`\r`)
+		case 0:
+			w.WriteString(`\0`)
+		default:
+			w.WriteByte(c)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package mysql
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	driver "database/sql"
+	sqldriver "database/sql/driver"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteLoadDataRow(t *testing.T) {
+	name := "Alice"
+	var nilName *string
+	tests := []struct {
+		name   string
+		values []any
+		want   string
+	}{
+		{
+			name:   "plain values",
+			values: []any{"Alice", 30, int64(1), 1.5, true, false},
+			want:   "Alice\t30\t1\t1.5\t1\t0\n",
+		},
+		{
+			name:   "null values",
+			values: []any{nil, nilName},
+			want:   "\\N\t\\N\n",
+		},
+		{
+			name:   "pointer value",
+			values: []any{&name},
+			want:   "Alice\n",
+		},
+		{
+			name:   "special characters are escaped",
+			values: []any{"a\tb\nc\\d\re\x00f", []byte("g\th")},
+			want:   "a\\tb\\nc\\\\d\\re\\0f\tg\\th\n",
+		},
+		{
+			name:   "time value",
+			values: []any{time.Date(2024, 1, 2, 3, 4, 5, 600000000, time.UTC)},
+			want:   "2024-01-02 03:04:05.6\n",
+		},
+		{
+			name:   "time value is written in UTC",
+			values: []any{time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("UTC+2", 2*60*60))},
+			want:   "2024-01-02 01:04:05\n",
+		},
+		{
+			name:   "null looking string is escaped",
+			values: []any{"\\N"},
+			want:   "\\\\N\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := bufio.NewWriter(&buf)
+			if err := writeLoadDataRow(w, tt.values); err != nil {
+				t.Fatalf("writeLoadDataRow() error = %v", err)
+			}
+			w.Flush()
+			if got := buf.String(); got != tt.want {
+				t.Errorf("writeLoadDataRow() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// warningsConnector opens connections whose queries return the warnings, as SHOW WARNINGS
+type warningsConnector struct {
+	warnings [][]sqldriver.Value
+}
+
+func (c warningsConnector) Connect(context.Context) (sqldriver.Conn, error) {
+	return warningsConn(c), nil
+}
+func (warningsConnector) Driver() sqldriver.Driver { return nil }
+
+type warningsConn warningsConnector
+
+func (c warningsConn) Prepare(string) (sqldriver.Stmt, error) { return warningsStmt(c), nil }
+func (warningsConn) Close() error                             { return nil }
+func (warningsConn) Begin() (sqldriver.Tx, error)             { return warningsTx{}, nil }
+
+type warningsTx struct{}
+
+func (warningsTx) Commit() error   { return nil }
+func (warningsTx) Rollback() error { return nil }
+
+type warningsStmt warningsConnector
+
+func (warningsStmt) Close() error  { return nil }
+func (warningsStmt) NumInput() int { return 0 }
+func (warningsStmt) Exec([]sqldriver.Value) (sqldriver.Result, error) {
+	return sqldriver.RowsAffected(0), nil
+}
+func (s warningsStmt) Query([]sqldriver.Value) (sqldriver.Rows, error) {
+	return &warningsRows{warnings: s.warnings}, nil
+}
+
+type warningsRows struct {
+	warnings [][]sqldriver.Value
+}
+
+func (*warningsRows) Columns() []string { return []string{"Level", "Code", "Message"} }
+func (*warningsRows) Close() error      { return nil }
+func (r *warningsRows) Next(dest []sqldriver.Value) error {
+	if len(r.warnings) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.warnings[0])
+	r.warnings = r.warnings[1:]
+	return nil
+}
+
+func TestCheckLoadData(t *testing.T) {
+	duplicate := []sqldriver.Value{"Warning", int64(1062), "Duplicate entry '1' for key 'users.PRIMARY'"}
+	note := []sqldriver.Value{"Note", int64(1051), "Unknown table"}
+	tests := []struct {
+		name     string
+		warnings [][]sqldriver.Value
+		sent     int64
+		loaded   int64
+		wantErr  string
+	}{
+		{name: "every row loaded", sent: 3, loaded: 3},
+		{name: "notes are ignored", warnings: [][]sqldriver.Value{note}, sent: 3, loaded: 3},
+		{name: "skipped rows", warnings: [][]sqldriver.Value{note, duplicate}, sent: 3, loaded: 2, wantErr: "2 of the 3 rows sent are loaded, Warning 1062: Duplicate entry"},
+		{name: "skipped rows without warnings", sent: 3, loaded: 2, wantErr: "2 of the 3 rows sent are loaded"},
+		{name: "converted value", warnings: [][]sqldriver.Value{{"Warning", int64(1366), "Incorrect integer value: 'abc' for column 'score' at row 1"}}, sent: 3, loaded: 3, wantErr: "Warning 1366: Incorrect integer value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := driver.OpenDB(warningsConnector{warnings: tt.warnings})
+			defer db.Close()
+			txn, err := db.Begin()
+			if err != nil {
+				t.Fatalf("Begin() error = %v", err)
+			}
+			defer txn.Rollback()
+
+			err = checkLoadData(context.Background(), txn, tt.sent, tt.loaded)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("checkLoadData() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("checkLoadData() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -40,7 +40,7 @@ returns
 string :: condition string
 []any :: values
 */
-func parseCondition(condition *sql.Condition) (string, []int, error) {
+func parseCondition(condition *sql.Condition) (string, []sql.Param, error) {
 	if condition == nil {
 		// if condition is nil, return a condition that always returns true
 		return "1", nil, nil
@@ -49,20 +49,25 @@ func parseCondition(condition *sql.Condition) (string, []int, error) {
 	if err := condition.Validate(); err != nil {
 		return "", nil, err
 	}
+	field := parseConditionField(condition)
 	switch condition.Operator {
 	case sql.EQ, sql.NEQ, sql.GT, sql.GTE, sql.LT, sql.LTE:
 		if condition.Value.IsValue() {
 			if condition.Value.IsColumn() {
 				if condition.Value.IsStringValue() {
-					return fmt.Sprintf("%s %s %s", condition.Field, operatorToStringMap[condition.Operator], condition.Value.Value), nil, nil
+					return fmt.Sprintf("%s %s %s", field, operatorToStringMap[condition.Operator], quote(condition.Value.Value.(string))), nil, nil
 				} else {
 					return "", nil, sql.NewInvalidQueryError("invalid condition, error: value for %s operator must be a string, field: %s", operatorToStringMap[condition.Operator], condition.Field)
 				}
 			}
-			// If the value is a fixed value, we use it directly
-			return fmt.Sprintf("%s %s %s", condition.Field, operatorToStringMap[condition.Operator], getValue(condition.Value.Value)), nil, nil
+			if condition.Value.IsExpression() {
+				return fmt.Sprintf("%s %s %v", field, operatorToStringMap[condition.Operator], condition.Value.Value), nil, nil
+			}
+			// If the value is a fixed value, it is bound as a parameter
+			placeHolder, params := parseFixedValues(condition.Value, condition.Value.Value)
+			return fmt.Sprintf("%s %s %s", field, operatorToStringMap[condition.Operator], placeHolder), params, nil
 		}
-		return fmt.Sprintf("%s %s ?", condition.Field, operatorToStringMap[condition.Operator]), []int{condition.Value.Index}, nil
+		return fmt.Sprintf("%s %s ?", field, operatorToStringMap[condition.Operator]), []sql.Param{{Index: condition.Value.Index}}, nil
 	case sql.IN, sql.NOTIN:
 		if condition.Value.Value != nil {
 			// check if value is a slice
@@ -70,14 +75,15 @@ func parseCondition(condition *sql.Condition) (string, []int, error) {
 				if len(slice) == 0 {
 					return "", nil, sql.NewInvalidQueryError("invalid condition, error: value for IN/NOTIN must be a non-empty slice, field: %s", condition.Field)
 				}
-				// If the value is fixed we use it directly
-				return fmt.Sprintf("%s %s (%s)", condition.Field, operatorToStringMap[condition.Operator], getValueString(slice...)), nil, nil
+				// If the value is fixed, every item is bound as a parameter
+				placeHolders, params := parseFixedValues(condition.Value, slice...)
+				return fmt.Sprintf("%s %s (%s)", field, operatorToStringMap[condition.Operator], placeHolders), params, nil
 			} else {
 				return "", nil, sql.NewInvalidQueryError("invalid condition, error: value for IN/NOTIN must be a slice, field: %s", condition.Field)
 			}
 		} else {
 			if condition.Value.Count > 0 {
-				return fmt.Sprintf("%s %s (%s)", condition.Field, operatorToStringMap[condition.Operator], getPlaceHolders(condition.Value.Count)), []int{condition.Value.Index}, nil
+				return fmt.Sprintf("%s %s (%s)", field, operatorToStringMap[condition.Operator], getPlaceHolders(condition.Value.Count)), []sql.Param{{Index: condition.Value.Index}}, nil
 			} else {
 				return "", nil, sql.NewInvalidQueryError("invalid condition, error: value indexes for IN/NOTIN must be a non-empty slice, field: %s", condition.Field)
 			}
@@ -89,22 +95,23 @@ func parseCondition(condition *sql.Condition) (string, []int, error) {
 				if str == "" {
 					return "", nil, sql.NewInvalidQueryError("invalid condition, error: value for LIKE/NOTLIKE must be a non-empty string, field: %s", condition.Field)
 				}
-				return fmt.Sprintf("%s %s %s", condition.Field, operatorToStringMap[condition.Operator], getValue(str)), nil, nil
+				placeHolder, params := parseFixedValues(condition.Value, str)
+				return fmt.Sprintf("%s %s %s", field, operatorToStringMap[condition.Operator], placeHolder), params, nil
 			} else {
 				return "", nil, sql.NewInvalidQueryError("invalid condition, error: value for LIKE/NOTLIKE must be a string, field: %s", condition.Field)
 			}
 		} else {
-			return fmt.Sprintf("%s %s ?", condition.Field, operatorToStringMap[condition.Operator]), []int{condition.Value.Index}, nil
+			return fmt.Sprintf("%s %s ?", field, operatorToStringMap[condition.Operator]), []sql.Param{{Index: condition.Value.Index}}, nil
 		}
 	case sql.ISNULL, sql.ISNOTNULL:
-		return fmt.Sprintf("%s %s", condition.Field, operatorToStringMap[condition.Operator]), nil, nil
+		return fmt.Sprintf("%s %s", field, operatorToStringMap[condition.Operator]), nil, nil
 		// ISNULL and ISNOTNULL do not require a value, so we do not append anything to conditionValues
 	case sql.EXISTS, sql.NOTEXISTS:
 		// not implemented in this parser, but can be added later
 		return "", nil, sql.NewInvalidQueryError("invalid condition, error: EXISTS and NOTEXISTS operators are not implemented in this parser")
 	case sql.AND, sql.OR:
 		var conditionStrings []string
-		var conditionValues []int
+		var conditionValues []sql.Param
 		for _, subCondition := range condition.Conditions {
 			subConditionString, subConditionValues, err := parseCondition(&subCondition)
 			if err != nil {
@@ -138,19 +145,44 @@ func parseCondition(condition *sql.Condition) (string, []int, error) {
 				if len(slice) != 2 {
 					return "", nil, sql.NewInvalidQueryError("invalid condition, error: value for BETWEEN/NOTBETWEEN must be a slice of length 2, field: %s", condition.Field)
 				}
-				// If the value is fixed we use it directly
-				return fmt.Sprintf("%s %s %s AND %s", condition.Field, operatorToStringMap[condition.Operator], getValueString(slice[0]), getValueString(slice[1])), nil, nil
+				// If the value is fixed, both bounds are bound as parameters
+				from, fromParams := parseFixedValues(condition.Value, slice[0])
+				to, toParams := parseFixedValues(condition.Value, slice[1])
+				return fmt.Sprintf("%s %s %s AND %s", field, operatorToStringMap[condition.Operator], from, to), append(fromParams, toParams...), nil
 			} else {
 				return "", nil, sql.NewInvalidQueryError("invalid condition, error: value for BETWEEN/NOTBETWEEN must be a slice of length 2, field: %s", condition.Field)
 			}
 		} else {
-			return fmt.Sprintf("(%s %s ? AND ?)", condition.Field, operatorToStringMap[condition.Operator]), []int{condition.Value.Index}, nil
+			return fmt.Sprintf("(%s %s ? AND ?)", field, operatorToStringMap[condition.Operator]), []sql.Param{{Index: condition.Value.Index}}, nil
 		}
 	default:
 		return "", nil, sql.NewInvalidQueryError("invalid condition, error: invalid operator: %d, for field: %s", condition.Operator, condition.Field)
 	}
 }
 
+// parseConditionField returns the quoted field of the condition, raw fields are expressions and are returned as they are
+func parseConditionField(condition *sql.Condition) string {
+	if condition.RawField {
+		return condition.Field
+	}
+	return quote(condition.Field)
+}
+
+/*
+parseFixedValues returns the placeholders for the fixed values and the params they are bound to.
+Raw literals are rendered in the query instead and have no params.
+*/
+func parseFixedValues(value *sql.Value, values ...any) (string, []sql.Param) {
+	if value.IsRawLiteral() {
+		return getValueString(values...), nil
+	}
+	params := make([]sql.Param, len(values))
+	for i, v := range values {
+		params[i] = sql.Param{Value: v, Fixed: true}
+	}
+	return getPlaceHolders(len(values)), params
+}
+
 func getValueString(values ...any) string {
 	var valueStrings []string
 	for _, value := range values {
@@ -159,10 +191,13 @@ func getValueString(values ...any) string {
 	return strings.Join(valueStrings, ", ")
 }
 
+// mysql treats the backslash as an escape character in string literals
+var literalReplacer = strings.NewReplacer(`\`, `\\`, "'", "''")
+
 func getValue(value any) string {
 	switch v := value.(type) {
 	case string:
-		return fmt.Sprintf("'%s'", v)
+		return fmt.Sprintf("'%s'", literalReplacer.Replace(v))
 	case time.Time:
 		return fmt.Sprintf("'%s'", v.Format(time.RFC3339))
 	default:
@@ -16,7 +16,7 @@ func Test_parseCondition(t *testing.T) {
 		name    string
 		args    args
 		want    string
-		want1   []int
+		want1   []sql.Param
 		wantErr bool
 	}{
 		{
@@ -37,8 +37,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.EQ,
 				},
 			},
-			want:    "name = ?",
-			want1:   []int{0},
+			want:    "`name` = ?",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -50,8 +50,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.IN,
 				},
 			},
-			want:    "id IN (?, ?, ?)",
-			want1:   []int{0},
+			want:    "`id` IN (?, ?, ?)",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -76,8 +76,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.NOTIN,
 				},
 			},
-			want:    "id NOT IN (?, ?, ?)",
-			want1:   []int{0},
+			want:    "`id` NOT IN (?, ?, ?)",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -89,8 +89,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.LIKE,
 				},
 			},
-			want:    "name LIKE ?",
-			want1:   []int{0},
+			want:    "`name` LIKE ?",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -102,8 +102,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.LIKE,
 				},
 			},
-			want:    "name LIKE ?",
-			want1:   []int{0},
+			want:    "`name` LIKE ?",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -115,8 +115,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.NOTLIKE,
 				},
 			},
-			want:    "name NOT LIKE ?",
-			want1:   []int{0},
+			want:    "`name` NOT LIKE ?",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -127,7 +127,7 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.ISNULL,
 				},
 			},
-			want:    "name IS NULL",
+			want:    "`name` IS NULL",
 			want1:   nil,
 			wantErr: false,
 		},
@@ -139,7 +139,7 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.ISNOTNULL,
 				},
 			},
-			want:    "name IS NOT NULL",
+			want:    "`name` IS NOT NULL",
 			want1:   nil,
 			wantErr: false,
 		},
@@ -188,8 +188,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.REGEXP,
 				},
 			},
-			want:    "name REGEXP ?",
-			want1:   []int{0},
+			want:    "`name` REGEXP ?",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -201,8 +201,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.BETWEEN,
 				},
 			},
-			want:    "(age BETWEEN ? AND ?)",
-			want1:   []int{0},
+			want:    "(`age` BETWEEN ? AND ?)",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -214,8 +214,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.NOTBETWEEN,
 				},
 			},
-			want:    "(age NOT BETWEEN ? AND ?)",
-			want1:   []int{0},
+			want:    "(`age` NOT BETWEEN ? AND ?)",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -237,8 +237,8 @@ func Test_parseCondition(t *testing.T) {
 					},
 				},
 			},
-			want:    "(name = ? AND age > ?)",
-			want1:   []int{0, 1},
+			want:    "(`name` = ? AND `age` > ?)",
+			want1:   []sql.Param{{Index: 0}, {Index: 1}},
 			wantErr: false,
 		},
 		{
@@ -260,8 +260,8 @@ func Test_parseCondition(t *testing.T) {
 					},
 				},
 			},
-			want:    "(name = ? OR age > ?)",
-			want1:   []int{0, 1},
+			want:    "(`name` = ? OR `age` > ?)",
+			want1:   []sql.Param{{Index: 0}, {Index: 1}},
 			wantErr: false,
 		},
 		{
@@ -278,8 +278,8 @@ func Test_parseCondition(t *testing.T) {
 					},
 				},
 			},
-			want:    "NOT (name = ?)",
-			want1:   []int{0},
+			want:    "NOT (`name` = ?)",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -331,8 +331,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.EQ,
 				},
 			},
-			want:    "age = 25",
-			want1:   nil,
+			want:    "`age` = ?",
+			want1:   []sql.Param{{Value: 25, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -344,8 +344,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.NEQ,
 				},
 			},
-			want:    "status <> 'inactive'",
-			want1:   nil,
+			want:    "`status` <> ?",
+			want1:   []sql.Param{{Value: "inactive", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -357,8 +357,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.GT,
 				},
 			},
-			want:    "score > 100.5",
-			want1:   nil,
+			want:    "`score` > ?",
+			want1:   []sql.Param{{Value: 100.5, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -370,8 +370,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.GTE,
 				},
 			},
-			want:    "price >= 99.99",
-			want1:   nil,
+			want:    "`price` >= ?",
+			want1:   []sql.Param{{Value: 99.99, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -383,8 +383,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.LT,
 				},
 			},
-			want:    "quantity < 50",
-			want1:   nil,
+			want:    "`quantity` < ?",
+			want1:   []sql.Param{{Value: 50, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -396,8 +396,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.LTE,
 				},
 			},
-			want:    "weight <= 10.5",
-			want1:   nil,
+			want:    "`weight` <= ?",
+			want1:   []sql.Param{{Value: 10.5, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -409,8 +409,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.IN,
 				},
 			},
-			want:    "category IN ('electronics', 'books', 'clothing')",
-			want1:   nil,
+			want:    "`category` IN (?, ?, ?)",
+			want1:   []sql.Param{{Value: "electronics", Fixed: true}, {Value: "books", Fixed: true}, {Value: "clothing", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -422,8 +422,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.NOTIN,
 				},
 			},
-			want:    "status NOT IN ('deleted', 'archived')",
-			want1:   nil,
+			want:    "`status` NOT IN (?, ?)",
+			want1:   []sql.Param{{Value: "deleted", Fixed: true}, {Value: "archived", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -435,8 +435,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.LIKE,
 				},
 			},
-			want:    "name LIKE 'john%'",
-			want1:   nil,
+			want:    "`name` LIKE ?",
+			want1:   []sql.Param{{Value: "john%", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -448,8 +448,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.NOTLIKE,
 				},
 			},
-			want:    "email NOT LIKE '%spam.com'",
-			want1:   nil,
+			want:    "`email` NOT LIKE ?",
+			want1:   []sql.Param{{Value: "%spam.com", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -461,8 +461,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.REGEXP,
 				},
 			},
-			want:    "phone REGEXP '^\\d{3}-\\d{3}-\\d{4}$'",
-			want1:   nil,
+			want:    "`phone` REGEXP ?",
+			want1:   []sql.Param{{Value: "^\\d{3}-\\d{3}-\\d{4}$", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -474,8 +474,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.BETWEEN,
 				},
 			},
-			want:    "age BETWEEN 18 AND 65",
-			want1:   nil,
+			want:    "`age` BETWEEN ? AND ?",
+			want1:   []sql.Param{{Value: 18, Fixed: true}, {Value: 65, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -487,8 +487,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.NOTBETWEEN,
 				},
 			},
-			want:    "price NOT BETWEEN 10 AND 100",
-			want1:   nil,
+			want:    "`price` NOT BETWEEN ? AND ?",
+			want1:   []sql.Param{{Value: 10.0, Fixed: true}, {Value: 100.0, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -500,8 +500,8 @@ func Test_parseCondition(t *testing.T) {
 					Operator: sql.GT,
 				},
 			},
-			want:    "created_at > '2023-01-01T00:00:00Z'",
-			want1:   nil,
+			want:    "`created_at` > ?",
+			want1:   []sql.Param{{Value: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -657,8 +657,8 @@ func Test_parseCondition(t *testing.T) {
 					},
 				},
 			},
-			want:    "(status = ? AND (age > ? OR role = ?))",
-			want1:   []int{0, 1, 2},
+			want:    "(`status` = ? AND (`age` > ? OR `role` = ?))",
+			want1:   []sql.Param{{Index: 0}, {Index: 1}, {Index: 2}},
 			wantErr: false,
 		},
 		{
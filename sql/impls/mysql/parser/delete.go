@@ -9,20 +9,24 @@ import (
 const (
 	deleteByIDQuery     = "DELETE FROM %s WHERE %s = ?"
 	deleteQuery         = "DELETE FROM %s WHERE %s"
-	softDeleteByIDQuery = "UPDATE %s SET deleted = 1 WHERE %s = ?"
-	softDeleteQuery     = "UPDATE %s SET deleted = 1 WHERE %s"
+	softDeleteByIDQuery = "UPDATE %s SET `deleted` = 1 WHERE %s = ?"
+	softDeleteQuery     = "UPDATE %s SET `deleted` = 1 WHERE %s"
 )
 
 func (p *parser) ParseDeleteByIDQuery(record sql.Record) (string, error) {
-	tableName, err := parseTableName(record.Table())
+	tableName, _, err := parseTableName(record.Table())
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(deleteByIDQuery, tableName, record.IdColumn()), nil
+	idColumn, err := parseIdColumn(record)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(deleteByIDQuery, tableName, idColumn), nil
 }
 
-func (p *parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []int, error) {
-	tableName, err := parseTableName(table)
+func (p *parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []sql.Param, error) {
+	tableName, tableParams, err := parseTableName(table)
 	if err != nil {
 		return "", nil, err
 	}
@@ -30,11 +34,11 @@ func (p *parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (s
 	if err != nil {
 		return "", nil, err
 	}
-	return fmt.Sprintf(deleteQuery, tableName, conditionStr), values, nil
+	return fmt.Sprintf(deleteQuery, tableName, conditionStr), append(tableParams, values...), nil
 }
 
-func (p *parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []int, error) {
-	tableName, err := parseTableName(table)
+func (p *parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []sql.Param, error) {
+	tableName, tableParams, err := parseTableName(table)
 	if err != nil {
 		return "", nil, err
 	}
@@ -42,13 +46,23 @@ func (p *parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition
 	if err != nil {
 		return "", nil, err
 	}
-	return fmt.Sprintf(softDeleteQuery, tableName, conditionStr), values, nil
+	return fmt.Sprintf(softDeleteQuery, tableName, conditionStr), append(tableParams, values...), nil
 }
 
 func (p *parser) ParseSoftDeleteByIDQuery(table *sql.Table, record sql.Record) (string, error) {
-	tableName, err := parseTableName(table)
+	tableName, _, err := parseTableName(table)
+	if err != nil {
+		return "", err
+	}
+	idColumn, err := parseIdColumn(record)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(softDeleteByIDQuery, tableName, record.IdColumn()), nil
+	return fmt.Sprintf(softDeleteByIDQuery, tableName, idColumn), nil
+}
+
+// ParseDeleteReturningQuery is not supported, mysql has no clause returning the deleted rows,
+// they are selected before the delete in the same transaction.
+func (p *parser) ParseDeleteReturningQuery(condition *sql.Condition, records sql.Records) (string, []sql.Param, error) {
+	return "", nil, sql.NewInvalidQueryError("delete returning query:: mysql does not return the deleted rows")
 }
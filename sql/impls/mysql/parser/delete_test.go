@@ -18,7 +18,7 @@ func TestParseDeleteByIDQuery(t *testing.T) {
 		{
 			name:    "normal user record",
 			record:  &records.User{Id: 1, Name: "Alice", Email: "alice@example.com"},
-			want:    "DELETE FROM users WHERE id = ?",
+			want:    "DELETE FROM `users` WHERE `id` = ?",
 			wantErr: false,
 		},
 		{
@@ -49,31 +49,31 @@ func TestParseDeleteQuery(t *testing.T) {
 		table     *sql.Table
 		condition *sql.Condition
 		want      string
-		want1     []int
+		want1     []sql.Param
 		wantErr   bool
 	}{
 		{
 			name:      "simple delete with EQ condition",
 			table:     sql.NewTable("users"),
 			condition: sql.NewCondition("name", sql.EQ, sql.NewIndexedValue(0)),
-			want:      "DELETE FROM users WHERE name = ?",
-			want1:     []int{0},
+			want:      "DELETE FROM `users` WHERE `name` = ?",
+			want1:     []sql.Param{{Index: 0}},
 			wantErr:   false,
 		},
 		{
 			name:      "delete with AND condition",
 			table:     sql.NewTable("users"),
 			condition: sql.NewCondition("name", sql.EQ, sql.NewIndexedValue(0)).And(sql.NewCondition("is_active", sql.EQ, sql.NewIndexedValue(1))),
-			want:      "DELETE FROM users WHERE (name = ? AND is_active = ?)",
-			want1:     []int{0, 1},
+			want:      "DELETE FROM `users` WHERE (`name` = ? AND `is_active` = ?)",
+			want1:     []sql.Param{{Index: 0}, {Index: 1}},
 			wantErr:   false,
 		},
 		{
 			name:      "delete with IN condition",
 			table:     sql.NewTable("users"),
 			condition: sql.NewCondition("id", sql.IN, sql.NewIndexedValue(0).WithCount(3)),
-			want:      "DELETE FROM users WHERE id IN (?, ?, ?)",
-			want1:     []int{0},
+			want:      "DELETE FROM `users` WHERE `id` IN (?, ?, ?)",
+			want1:     []sql.Param{{Index: 0}},
 			wantErr:   false,
 		},
 		{
@@ -117,30 +117,30 @@ func TestParseSoftDeleteQuery(t *testing.T) {
 		table     *sql.Table
 		condition *sql.Condition
 		want      string
-		want1     []int
+		want1     []sql.Param
 		wantErr   bool
 	}{
 		{
 			name:      "simple soft delete with EQ condition",
 			table:     sql.NewTable("users"),
 			condition: sql.NewCondition("name", sql.EQ, sql.NewIndexedValue(0)),
-			want:      "UPDATE users SET deleted = 1 WHERE name = ?",
-			want1:     []int{0},
+			want:      "UPDATE `users` SET `deleted` = 1 WHERE `name` = ?",
+			want1:     []sql.Param{{Index: 0}},
 			wantErr:   false,
 		},
 		{
 			name:      "soft delete with OR condition",
 			table:     sql.NewTable("users"),
 			condition: sql.NewCondition("name", sql.EQ, sql.NewIndexedValue(0)).Or(sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(1))),
-			want:      "UPDATE users SET deleted = 1 WHERE (name = ? OR email = ?)",
-			want1:     []int{0, 1},
+			want:      "UPDATE `users` SET `deleted` = 1 WHERE (`name` = ? OR `email` = ?)",
+			want1:     []sql.Param{{Index: 0}, {Index: 1}},
 			wantErr:   false,
 		},
 		{
 			name:      "soft delete with IS NULL condition",
 			table:     sql.NewTable("users"),
 			condition: sql.NewCondition("deleted_at", sql.ISNULL, nil),
-			want:      "UPDATE users SET deleted = 1 WHERE deleted_at IS NULL",
+			want:      "UPDATE `users` SET `deleted` = 1 WHERE `deleted_at` IS NULL",
 			want1:     nil,
 			wantErr:   false,
 		},
@@ -191,7 +191,7 @@ func TestParseSoftDeleteByIDQuery(t *testing.T) {
 			name:    "normal user record",
 			table:   sql.NewTable("users"),
 			record:  &records.User{Id: 1, Name: "Alice", Email: "alice@example.com"},
-			want:    "UPDATE users SET deleted = 1 WHERE id = ?",
+			want:    "UPDATE `users` SET `deleted` = 1 WHERE `id` = ?",
 			wantErr: false,
 		},
 		{
@@ -216,3 +216,50 @@ func TestParseSoftDeleteByIDQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDeleteReturningQuery(t *testing.T) {
+	type args struct {
+		condition *sql.Condition
+		records   sql.Records
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		want1   []sql.Param
+		wantErr bool
+	}{
+		{
+			name: "test delete returning with simple condition",
+			args: args{
+				condition: sql.NewCondition("status", sql.EQ, sql.NewValue("inactive")),
+				records:   &records.Users{},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "test delete returning with nil records",
+			args:    args{condition: sql.NewCondition("status", sql.EQ, sql.NewValue("inactive"))},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, err := prsr.ParseDeleteReturningQuery(tt.args.condition, tt.args.records)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDeleteReturningQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseDeleteReturningQuery() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("ParseDeleteReturningQuery() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofreego/database/sql"
+)
+
+const (
+	distinctOnQuery = "SELECT %s FROM (SELECT %s, ROW_NUMBER() OVER (PARTITION BY %s%s) AS distinct_row_num FROM %s %s) AS distinct_rows %s"
+)
+
+/*
+parseDistinctOnQuery emulates DISTINCT ON, which mysql does not support.
+The rows of every partition are numbered in the filter's sort order and only the first row is kept.
+The columns and sort fields are aliased in the inner query so the outer query does not depend on
+table qualifiers or expressions, the result is ordered the same way as a postgresql DISTINCT ON query.
+The generated aliases are plain identifiers and are not quoted.
+eg. DistinctOn("user_id") with Sort created_at DESC:
+SELECT distinct_col_1, distinct_col_2 FROM (SELECT user_id AS distinct_col_1, title AS distinct_col_2, user_id AS distinct_sort_1, created_at AS distinct_sort_2,
+ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC) AS distinct_row_num FROM posts WHERE 1) AS distinct_rows
+WHERE distinct_row_num = 1 ORDER BY distinct_sort_1 ASC, distinct_sort_2 DESC
+*/
+func parseDistinctOnQuery(filter *sql.Filter, records sql.Records) (string, []sql.Param, error) {
+	tableName, tableParams, err := parseTableName(records.Table())
+	if err != nil {
+		return "", nil, err
+	}
+	// the condition and grouping are applied in the inner query
+	innerFilter, innerValues, err := parseFilter(&sql.Filter{Condition: filter.Condition, GroupBy: filter.GroupBy})
+	if err != nil {
+		return "", nil, err
+	}
+	fields := records.Columns()
+	if err := validateColumns(fields); err != nil {
+		return "", nil, err
+	}
+	innerColumns := make([]string, 0, len(fields))
+	outerColumns := make([]string, 0, len(fields))
+	for i, field := range fields {
+		if field.Alias != "" {
+			innerColumns = append(innerColumns, parseField(field))
+			outerColumns = append(outerColumns, quote(field.Alias))
+			continue
+		}
+		alias := fmt.Sprintf("distinct_col_%d", i+1)
+		innerColumns = append(innerColumns, fmt.Sprintf("%s AS %s", parseField(field), alias))
+		outerColumns = append(outerColumns, alias)
+	}
+	// the distinct fields lead the sort, the rest of the sort picks the first row of every partition
+	sort := filter.DistinctOn.Sort(filter.Sort)
+	outerSort := sql.NewSort()
+	for i, orderBy := range sort.Fields() {
+		alias := fmt.Sprintf("distinct_sort_%d", i+1)
+		innerColumns = append(innerColumns, fmt.Sprintf("%s AS %s", parseSortField(orderBy), alias))
+		outerSort.AddOrderBy(&sql.OrderBy{Expression: alias, Order: orderBy.Order, Nulls: orderBy.Nulls})
+	}
+	var partitionOrder string
+	if rest := sort.Fields()[len(filter.DistinctOn.Fields()):]; len(rest) > 0 {
+		restSort := sql.NewSort()
+		for _, orderBy := range rest {
+			restSort.AddOrderBy(&orderBy)
+		}
+		partitionOrder, err = parseOrderBy(restSort)
+		if err != nil {
+			return "", nil, err
+		}
+		partitionOrder = " " + partitionOrder
+	}
+	// the first row of every partition is kept, the sort and pagination are applied in the outer query
+	outerFilter, outerValues, err := parseFilter(&sql.Filter{
+		Condition: sql.NewRawCondition("distinct_row_num", sql.EQ, sql.RawLiteral(1)),
+		Sort:      outerSort,
+		Limit:     filter.Limit,
+		Offset:    filter.Offset,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	query := fmt.Sprintf(distinctOnQuery, strings.Join(outerColumns, ", "), strings.Join(innerColumns, ", "), quoteAll(filter.DistinctOn.Fields()), partitionOrder, tableName, innerFilter, outerFilter)
+	return query, append(append(tableParams, innerValues...), outerValues...), nil
+}
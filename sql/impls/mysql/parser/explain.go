@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/gofreego/database/sql"
+)
+
+const (
+	explainQuery = "EXPLAIN FORMAT=JSON %s"
+)
+
+func (p *parser) ParseExplainQuery(filter *sql.Filter, records sql.Records) (string, []sql.Param, error) {
+	query, values, err := p.ParseGetByFilterQuery(filter, records)
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf(explainQuery, query), values, nil
+}
+
+// ParseQueryPlan summarises the output of EXPLAIN FORMAT=JSON.
+// Tables can be nested under nested_loop, ordering_operation, grouping_operation etc.
+// so the whole document is walked and every "table" object is summarised.
+func (p *parser) ParseQueryPlan(plan string) (*sql.QueryPlan, error) {
+	var document map[string]any
+	if err := json.Unmarshal([]byte(plan), &document); err != nil {
+		return nil, sql.NewDatabaseError(err)
+	}
+	if _, ok := document["query_block"]; !ok {
+		return nil, sql.NewInvalidQueryError("invalid query plan: query_block not found")
+	}
+	queryPlan := &sql.QueryPlan{
+		Raw: plan,
+	}
+	summarisePlanNode(document, queryPlan)
+	return queryPlan, nil
+}
+
+func summarisePlanNode(node any, queryPlan *sql.QueryPlan) {
+	switch n := node.(type) {
+	case []any:
+		for _, child := range n {
+			summarisePlanNode(child, queryPlan)
+		}
+	case map[string]any:
+		if table, ok := n["table"].(map[string]any); ok {
+			summariseTable(table, queryPlan)
+		}
+		// sort the keys to get the same summary for the same plan
+		keys := make([]string, 0, len(n))
+		for key := range n {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			summarisePlanNode(n[key], queryPlan)
+		}
+	}
+}
+
+func summariseTable(table map[string]any, queryPlan *sql.QueryPlan) {
+	if accessType, _ := table["access_type"].(string); accessType == "ALL" {
+		queryPlan.SequentialScan = true
+	}
+	if key, _ := table["key"].(string); key != "" && !slices.Contains(queryPlan.Indexes, key) {
+		queryPlan.Indexes = append(queryPlan.Indexes, key)
+	}
+	// mysql does not report an estimate for the whole query,
+	// the largest estimate produced by a join step is used instead
+	if rows, ok := table["rows_produced_per_join"].(float64); ok && rows > queryPlan.EstimatedRows {
+		queryPlan.EstimatedRows = rows
+	}
+}
@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/tests/records"
+)
+
+func TestParseExplainQuery(t *testing.T) {
+	filter := &sql.Filter{
+		Condition: sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0)),
+	}
+	got, got1, err := prsr.ParseExplainQuery(filter, &records.Users{})
+	if err != nil {
+		t.Fatalf("ParseExplainQuery() error = %v", err)
+	}
+	if want := "EXPLAIN FORMAT=JSON SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE `email` = ?"; got != want {
+		t.Errorf("ParseExplainQuery() got = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(got1, []sql.Param{{Index: 0}}) {
+		t.Errorf("ParseExplainQuery() got1 = %v, want %v", got1, []sql.Param{{Index: 0}})
+	}
+}
+
+func TestParseQueryPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    string
+		want    *sql.QueryPlan
+		wantErr bool
+	}{
+		{
+			name: "full table scan",
+			plan: `{"query_block": {"select_id": 1, "table": {"table_name": "users", "access_type": "ALL", "rows_examined_per_scan": 20, "rows_produced_per_join": 2}}}`,
+			want: &sql.QueryPlan{
+				EstimatedRows:  2,
+				SequentialScan: true,
+			},
+		},
+		{
+			name: "index lookup under ordering operation",
+			plan: `{"query_block": {"select_id": 1, "ordering_operation": {"using_filesort": true, "table": {"table_name": "users", "access_type": "ref", "key": "idx_users_email", "rows_examined_per_scan": 1, "rows_produced_per_join": 1}}}}`,
+			want: &sql.QueryPlan{
+				EstimatedRows: 1,
+				Indexes:       []string{"idx_users_email"},
+			},
+		},
+		{
+			name: "nested loop join",
+			plan: `{"query_block": {"select_id": 1, "nested_loop": [{"table": {"table_name": "u", "access_type": "ALL", "rows_produced_per_join": 20}}, {"table": {"table_name": "p", "access_type": "ref", "key": "idx_posts_user_id", "rows_produced_per_join": 40}}]}}`,
+			want: &sql.QueryPlan{
+				EstimatedRows:  40,
+				SequentialScan: true,
+				Indexes:        []string{"idx_posts_user_id"},
+			},
+		},
+		{
+			name:    "missing query block",
+			plan:    `{}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			plan:    `not json`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := prsr.ParseQueryPlan(tt.plan)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseQueryPlan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			tt.want.Raw = tt.plan
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseQueryPlan() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -12,12 +12,12 @@ import (
 // string :: condition string
 // []any :: values
 // error :: error if any
-func parseFilter(filter *sql.Filter) (string, []int, error) {
+func parseFilter(filter *sql.Filter) (string, []sql.Param, error) {
 	if filter == nil {
 		return "", nil, nil
 	}
 	var filterStrings []string
-	var filterValues []int
+	var filterValues []sql.Param
 	// condition
 	condition, values, err := parseCondition(filter.Condition)
 	if err != nil {
@@ -27,7 +27,10 @@ func parseFilter(filter *sql.Filter) (string, []int, error) {
 	filterValues = append(filterValues, values...)
 
 	// group by
-	groupBy := parseGroupBy(filter.GroupBy)
+	groupBy, err := parseGroupBy(filter.GroupBy)
+	if err != nil {
+		return "", nil, err
+	}
 	if groupBy != "" {
 		filterStrings = append(filterStrings, groupBy)
 	}
@@ -49,7 +52,7 @@ func parseFilter(filter *sql.Filter) (string, []int, error) {
 			}
 		} else {
 			filterStrings = append(filterStrings, "LIMIT ?")
-			filterValues = append(filterValues, filter.Limit.Index)
+			filterValues = append(filterValues, sql.Param{Index: filter.Limit.Index})
 		}
 	}
 	// offset
@@ -62,18 +65,29 @@ func parseFilter(filter *sql.Filter) (string, []int, error) {
 			}
 		} else {
 			filterStrings = append(filterStrings, "OFFSET ?")
-			filterValues = append(filterValues, filter.Offset.Index)
+			filterValues = append(filterValues, sql.Param{Index: filter.Offset.Index})
 		}
 	}
+	// lock
+	lock, err := parseLock(filter.Lock)
+	if err != nil {
+		return "", nil, err
+	}
+	if lock != "" {
+		filterStrings = append(filterStrings, lock)
+	}
 
 	return strings.Join(filterStrings, " "), filterValues, nil
 }
 
-func parseGroupBy(groupBy *sql.GroupBy) string {
+func parseGroupBy(groupBy *sql.GroupBy) (string, error) {
 	if groupBy == nil {
-		return ""
+		return "", nil
+	}
+	if err := groupBy.Validate(); err != nil {
+		return "", err
 	}
-	return fmt.Sprintf("GROUP BY (%s)", strings.Join(groupBy.Fields(), ", "))
+	return fmt.Sprintf("GROUP BY (%s)", quoteAll(groupBy.Fields())), nil
 }
 
 var orderToStringMap = map[sql.Order]string{
@@ -81,19 +95,64 @@ var orderToStringMap = map[sql.Order]string{
 	sql.Desc: "DESC",
 }
 
+// nulls placement is emulated by sorting on whether the value is NULL before sorting on the value
+var nullsToCaseMap = map[sql.Nulls]string{
+	sql.NullsFirst: "CASE WHEN %s IS NULL THEN 0 ELSE 1 END",
+	sql.NullsLast:  "CASE WHEN %s IS NULL THEN 1 ELSE 0 END",
+}
+
 func parseOrderBy(orderBy *sql.Sort) (string, error) {
 	if orderBy == nil {
 		return "", nil
 	}
+	if err := orderBy.Validate(); err != nil {
+		return "", err
+	}
 	var orderByStrings []string
 	var orderStr string
 	var ok bool
 	for _, field := range orderBy.Fields() {
-
+		sortField := parseSortField(field)
 		if orderStr, ok = orderToStringMap[field.Order]; !ok {
-			return "", fmt.Errorf("invalid order: %d for field: %s", field.Order, field.Field)
+			return "", fmt.Errorf("invalid order: %d for field: %s", field.Order, sortField)
+		}
+		if nullsCase, ok := nullsToCaseMap[field.Nulls]; ok {
+			orderByStrings = append(orderByStrings, fmt.Sprintf(nullsCase, sortField))
 		}
-		orderByStrings = append(orderByStrings, fmt.Sprintf("%s %s", field.Field, orderStr))
+		orderByStrings = append(orderByStrings, fmt.Sprintf("%s %s", sortField, orderStr))
 	}
 	return fmt.Sprintf("ORDER BY %s", strings.Join(orderByStrings, ", ")), nil
 }
+
+// parseSortField returns the field, aggregate or expression the criterion sorts by
+func parseSortField(orderBy sql.OrderBy) string {
+	switch {
+	case orderBy.Aggregate != nil:
+		// the alias belongs to the select list, not to the sort
+		aggregate := *orderBy.Aggregate
+		aggregate.Alias = ""
+		return parseField(&aggregate)
+	case orderBy.Expression != "":
+		return orderBy.Expression
+	default:
+		return quote(orderBy.Field)
+	}
+}
+
+var lockToStringMap = map[sql.LockMode]string{
+	sql.ForUpdate:           "FOR UPDATE",
+	sql.ForUpdateSkipLocked: "FOR UPDATE SKIP LOCKED",
+	sql.ForUpdateNoWait:     "FOR UPDATE NOWAIT",
+}
+
+// parseLock returns the locking clause appended at the end of the select query
+func parseLock(lock sql.LockMode) (string, error) {
+	if lock == sql.NoLock {
+		return "", nil
+	}
+	lockStr, ok := lockToStringMap[lock]
+	if !ok {
+		return "", sql.NewInvalidQueryError("invalid lock mode: %d", lock)
+	}
+	return lockStr, nil
+}
@@ -16,7 +16,7 @@ func Test_parseFilter(t *testing.T) {
 		name    string
 		args    args
 		want    string
-		want1   []int
+		want1   []sql.Param
 		wantErr bool
 	}{
 		{
@@ -39,8 +39,8 @@ func Test_parseFilter(t *testing.T) {
 					},
 				},
 			},
-			want:    "WHERE name = ?",
-			want1:   []int{0},
+			want:    "WHERE `name` = ?",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -73,8 +73,8 @@ func Test_parseFilter(t *testing.T) {
 					Offset:  sql.NewIndexedValue(1),
 				},
 			},
-			want:    "WHERE (email = ? AND age > 30 AND name LIKE ?) GROUP BY (city, country) ORDER BY age ASC LIMIT 10 OFFSET ?",
-			want1:   []int{0, 2, 1},
+			want:    "WHERE (`email` = ? AND `age` > ? AND `name` LIKE ?) GROUP BY (`city`, `country`) ORDER BY `age` ASC LIMIT 10 OFFSET ?",
+			want1:   []sql.Param{{Index: 0}, {Value: 30, Fixed: true}, {Index: 2}, {Index: 1}},
 			wantErr: false,
 		},
 		{
@@ -88,8 +88,8 @@ func Test_parseFilter(t *testing.T) {
 					},
 				},
 			},
-			want:    "WHERE name LIKE 'john%'",
-			want1:   nil,
+			want:    "WHERE `name` LIKE ?",
+			want1:   []sql.Param{{Value: "john%", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -103,8 +103,8 @@ func Test_parseFilter(t *testing.T) {
 					},
 				},
 			},
-			want:    "WHERE status IN ('active', 'pending')",
-			want1:   nil,
+			want:    "WHERE `status` IN (?, ?)",
+			want1:   []sql.Param{{Value: "active", Fixed: true}, {Value: "pending", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -117,7 +117,7 @@ func Test_parseFilter(t *testing.T) {
 					},
 				},
 			},
-			want:    "WHERE deleted_at IS NULL",
+			want:    "WHERE `deleted_at` IS NULL",
 			want1:   nil,
 			wantErr: false,
 		},
@@ -132,8 +132,8 @@ func Test_parseFilter(t *testing.T) {
 					},
 				},
 			},
-			want:    "WHERE age BETWEEN 18 AND 65",
-			want1:   nil,
+			want:    "WHERE `age` BETWEEN ? AND ?",
+			want1:   []sql.Param{{Value: 18, Fixed: true}, {Value: 65, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -147,8 +147,8 @@ func Test_parseFilter(t *testing.T) {
 					},
 				},
 			},
-			want:    "WHERE created_at > '2023-01-01T00:00:00Z'",
-			want1:   nil,
+			want:    "WHERE `created_at` > ?",
+			want1:   []sql.Param{{Value: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -173,6 +173,88 @@ func Test_parseFilter(t *testing.T) {
 			want1:   nil,
 			wantErr: true,
 		},
+		{
+			name: "test with fixed value containing a quote",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("name", sql.LIKE, sql.NewValue("O'Brien%")).
+						And(sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0))),
+				},
+			},
+			want:    "WHERE (`name` LIKE ? AND `email` = ?)",
+			want1:   []sql.Param{{Value: "O'Brien%", Fixed: true}, {Index: 0}},
+			wantErr: false,
+		},
+		{
+			name: "test with raw literals",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("name", sql.EQ, sql.RawLiteral("O'Brien")).
+						And(sql.NewCondition("id", sql.IN, sql.RawLiteral([]any{1, 2}))),
+				},
+			},
+			want:    "WHERE (`name` = 'O''Brien' AND `id` IN (1, 2))",
+			want1:   nil,
+			wantErr: false,
+		},
+		{
+			name: "test with raw literal with backslash",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("path", sql.EQ, sql.RawLiteral(`C:\temp\`)),
+				},
+			},
+			want:    "WHERE `path` = 'C:\\\\temp\\\\'",
+			want1:   nil,
+			wantErr: false,
+		},
+		{
+			name: "test with reserved word and mixed case identifiers",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("order", sql.EQ, sql.NewIndexedValue(0)),
+					GroupBy:   sql.NewGroupBy("user"),
+					Sort:      sql.NewSort().Add("CreatedAt", sql.Desc),
+				},
+			},
+			want:    "WHERE `order` = ? GROUP BY (`user`) ORDER BY `CreatedAt` DESC",
+			want1:   []sql.Param{{Index: 0}},
+			wantErr: false,
+		},
+		{
+			name: "test with raw condition and expression value",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewRawCondition("LOWER(email)", sql.EQ, sql.NewIndexedValue(0)).
+						And(sql.NewCondition("updated_at", sql.GT, sql.NewExpressionValue("created_at + 1"))),
+				},
+			},
+			want:    "WHERE (LOWER(email) = ? AND `updated_at` > created_at + 1)",
+			want1:   []sql.Param{{Index: 0}},
+			wantErr: false,
+		},
+		{
+			name: "test with injected condition field",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("name = name OR 1", sql.EQ, sql.NewIndexedValue(0)),
+				},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "test with injected group by field",
+			args: args{
+				filter: &sql.Filter{
+					GroupBy: sql.NewGroupBy("name; DROP TABLE users"),
+				},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -190,3 +272,65 @@ func Test_parseFilter(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseOrderBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		sort    *sql.Sort
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "nulls placement",
+			sort: sql.NewSort().Add("score", sql.Desc).NullsLast().Add("name", sql.Asc).NullsFirst(),
+			want: "ORDER BY CASE WHEN `score` IS NULL THEN 1 ELSE 0 END, `score` DESC, CASE WHEN `name` IS NULL THEN 0 ELSE 1 END, `name` ASC",
+		},
+		{
+			name: "aggregate field, alias is not rendered",
+			sort: sql.NewSort().AddAggregate(sql.CountOf(sql.NewField("id")).As("total"), sql.Desc),
+			want: "ORDER BY COUNT(`id`) DESC",
+		},
+		{
+			name: "table qualified field",
+			sort: sql.NewSort().AddOrderBy(sql.NewASCOrder("u.name")),
+			want: "ORDER BY `u`.`name` ASC",
+		},
+		{
+			name: "expression",
+			sort: sql.NewSort().AddExpression("LENGTH(name)", sql.Asc),
+			want: "ORDER BY LENGTH(name) ASC",
+		},
+		{
+			name:    "invalid field name",
+			sort:    sql.NewSort().Add("name; DROP TABLE users", sql.Asc),
+			wantErr: true,
+		},
+		{
+			name:    "invalid aggregate field name",
+			sort:    sql.NewSort().AddAggregate(sql.MaxOf(sql.NewField("score)")), sql.Asc),
+			wantErr: true,
+		},
+		{
+			name:    "field and expression",
+			sort:    sql.NewSort().AddOrderBy(&sql.OrderBy{Field: "name", Expression: "LENGTH(name)"}),
+			wantErr: true,
+		},
+		{
+			name:    "invalid order",
+			sort:    sql.NewSort().Add("name", sql.Order(100)),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOrderBy(tt.sort)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseOrderBy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parseOrderBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,30 +7,54 @@ import (
 )
 
 const (
-	mysqlGetByIDQuery = "SELECT %s FROM %s WHERE id = ?"
+	mysqlGetByIDQuery = "SELECT %s FROM %s WHERE `id` = ?"
 	mysqlGetQuery     = "SELECT %s FROM %s"
 )
 
-func (p *parser) ParseGetByIDQuery(record sql.Record) (string, error) {
-	tableName, err := parseTableName(record.Table())
+func (p *parser) ParseGetByIDQuery(record sql.Record, lock sql.LockMode) (string, error) {
+	tableName, _, err := parseTableName(record.Table())
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(mysqlGetByIDQuery, parseColumns(record.Columns()), tableName), nil
+	lockClause, err := parseLock(lock)
+	if err != nil {
+		return "", err
+	}
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", err
+	}
+	query := fmt.Sprintf(mysqlGetByIDQuery, parseColumns(record.Columns()), tableName)
+	if lockClause != "" {
+		query += " " + lockClause
+	}
+	return query, nil
 }
 
-func (p *parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records) (string, []int, error) {
+func (p *parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records) (string, []sql.Param, error) {
+	if err := filter.Validate(); err != nil {
+		return "", nil, err
+	}
+	if filter != nil && filter.DistinctOn != nil {
+		return parseDistinctOnQuery(filter, records)
+	}
 	filterString, values, err := parseFilter(filter)
 	if err != nil {
 		return "", nil, err
 	}
-	tableName, err := parseTableName(records.Table())
+	tableName, tableParams, err := parseTableName(records.Table())
 	if err != nil {
 		return "", nil, err
 	}
-	query := fmt.Sprintf(mysqlGetQuery, parseColumns(records.Columns()), tableName)
+	if err := validateColumns(records.Columns()); err != nil {
+		return "", nil, err
+	}
+	columns := parseColumns(records.Columns())
+	if filter != nil && filter.Distinct {
+		columns = "DISTINCT " + columns
+	}
+	query := fmt.Sprintf(mysqlGetQuery, columns, tableName)
 	if filterString != "" {
 		query += " " + filterString
 	}
-	return query, values, nil
+	return query, append(tableParams, values...), nil
 }
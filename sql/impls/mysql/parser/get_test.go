@@ -12,6 +12,7 @@ import (
 func TestParseGetByIDQuery(t *testing.T) {
 	type args struct {
 		record sql.Record
+		lock   sql.LockMode
 	}
 	tests := []struct {
 		name    string
@@ -26,12 +27,29 @@ func TestParseGetByIDQuery(t *testing.T) {
 					Id: 1,
 				},
 			},
-			want: "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE id = ?",
+			want: "SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE `id` = ?",
+		},
+		{
+			name: "record with for update lock",
+			args: args{
+				record: &records.User{Id: 1},
+				lock:   sql.ForUpdate,
+			},
+			want: "SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE `id` = ? FOR UPDATE",
+		},
+		{
+			name: "record with invalid lock",
+			args: args{
+				record: &records.User{Id: 1},
+				lock:   sql.LockMode(100),
+			},
+			want:    "",
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := prsr.ParseGetByIDQuery(tt.args.record)
+			got, err := prsr.ParseGetByIDQuery(tt.args.record, tt.args.lock)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseGetByIDQuery() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -52,7 +70,7 @@ func TestParseGetByFilterQuery(t *testing.T) {
 		name    string
 		args    args
 		want    string
-		want1   []int
+		want1   []sql.Param
 		wantErr bool
 	}{
 		{
@@ -61,7 +79,7 @@ func TestParseGetByFilterQuery(t *testing.T) {
 				filter:  nil,
 				records: &records.Users{},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users",
+			want:    "SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users`",
 			want1:   nil,
 			wantErr: false,
 		},
@@ -77,8 +95,8 @@ func TestParseGetByFilterQuery(t *testing.T) {
 				},
 				records: &records.Users{},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE id = ?",
-			want1:   []int{0},
+			want:    "SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE `id` = ?",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -93,8 +111,8 @@ func TestParseGetByFilterQuery(t *testing.T) {
 				},
 				records: &records.Users{},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE name LIKE 'john%'",
-			want1:   nil,
+			want:    "SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE `name` LIKE ?",
+			want1:   []sql.Param{{Value: "john%", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -109,8 +127,8 @@ func TestParseGetByFilterQuery(t *testing.T) {
 				},
 				records: &records.Users{},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE status IN ('active', 'pending')",
-			want1:   nil,
+			want:    "SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE `status` IN (?, ?)",
+			want1:   []sql.Param{{Value: "active", Fixed: true}, {Value: "pending", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -124,7 +142,7 @@ func TestParseGetByFilterQuery(t *testing.T) {
 				},
 				records: &records.Users{},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE deleted_at IS NULL",
+			want:    "SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE `deleted_at` IS NULL",
 			want1:   nil,
 			wantErr: false,
 		},
@@ -140,8 +158,8 @@ func TestParseGetByFilterQuery(t *testing.T) {
 				},
 				records: &records.Users{},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE age BETWEEN 18 AND 65",
-			want1:   nil,
+			want:    "SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE `age` BETWEEN ? AND ?",
+			want1:   []sql.Param{{Value: 18, Fixed: true}, {Value: 65, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -156,8 +174,8 @@ func TestParseGetByFilterQuery(t *testing.T) {
 				},
 				records: &records.Users{},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE created_at > '2023-01-01T00:00:00Z'",
-			want1:   nil,
+			want:    "SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE `created_at` > ?",
+			want1:   []sql.Param{{Value: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -171,8 +189,8 @@ func TestParseGetByFilterQuery(t *testing.T) {
 				},
 				records: &records.Users{},
 			},
-			want:    "SELECT id, name, email, password_hash, score, is_active, created_at, updated_at FROM users WHERE 1 GROUP BY (city, country) ORDER BY age ASC LIMIT 10 OFFSET ?",
-			want1:   []int{1},
+			want:    "SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE 1 GROUP BY (`city`, `country`) ORDER BY `age` ASC LIMIT 10 OFFSET ?",
+			want1:   []sql.Param{{Index: 1}},
 			wantErr: false,
 		},
 		{
@@ -199,6 +217,71 @@ func TestParseGetByFilterQuery(t *testing.T) {
 			want1:   nil,
 			wantErr: true,
 		},
+		{
+			name: "filter with skip locked lock",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("status", sql.EQ, sql.NewIndexedValue(0)),
+					Limit:     sql.NewValue(int64(10)),
+					Lock:      sql.ForUpdateSkipLocked,
+				},
+				records: &records.Users{},
+			},
+			want:  "SELECT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE `status` = ? LIMIT 10 FOR UPDATE SKIP LOCKED",
+			want1: []sql.Param{{Index: 0}},
+		},
+		{
+			name: "filter with distinct",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("is_active", sql.EQ, sql.NewIndexedValue(0)),
+					Distinct:  true,
+				},
+				records: &records.Users{},
+			},
+			want:  "SELECT DISTINCT `id`, `name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at` FROM `users` WHERE `is_active` = ?",
+			want1: []sql.Param{{Index: 0}},
+		},
+		{
+			name: "filter with distinct on",
+			args: args{
+				filter: &sql.Filter{
+					Condition:  sql.NewCondition("is_active", sql.EQ, sql.NewIndexedValue(0)),
+					DistinctOn: sql.DistinctOn("email"),
+					Sort:       sql.NewSort().Add("created_at", sql.Desc),
+					Limit:      sql.NewIndexedValue(1),
+				},
+				records: &records.Users{},
+			},
+			want:  "SELECT distinct_col_1, distinct_col_2, distinct_col_3, distinct_col_4, distinct_col_5, distinct_col_6, distinct_col_7, distinct_col_8 FROM (SELECT `id` AS distinct_col_1, `name` AS distinct_col_2, `email` AS distinct_col_3, `password_hash` AS distinct_col_4, `score` AS distinct_col_5, `is_active` AS distinct_col_6, `created_at` AS distinct_col_7, `updated_at` AS distinct_col_8, `email` AS distinct_sort_1, `created_at` AS distinct_sort_2, ROW_NUMBER() OVER (PARTITION BY `email` ORDER BY `created_at` DESC) AS distinct_row_num FROM `users` WHERE `is_active` = ?) AS distinct_rows WHERE distinct_row_num = 1 ORDER BY distinct_sort_1 ASC, distinct_sort_2 DESC LIMIT ?",
+			want1: []sql.Param{{Index: 0}, {Index: 1}},
+		},
+		{
+			name: "filter with distinct and distinct on",
+			args: args{
+				filter: &sql.Filter{
+					Distinct:   true,
+					DistinctOn: sql.DistinctOn("email"),
+				},
+				records: &records.Users{},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "filter with distinct and lock",
+			args: args{
+				filter: &sql.Filter{
+					Distinct: true,
+					Lock:     sql.ForUpdate,
+				},
+				records: &records.Users{},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -8,17 +8,54 @@ import (
 )
 
 const (
-	insertQuery = "INSERT INTO %s (%s) VALUES %s"
+	insertQuery       = "INSERT INTO %s (%s) VALUES %s"
+	insertIgnoreQuery = "INSERT IGNORE INTO %s (%s) VALUES %s"
 )
 
 func (p *parser) ParseInsertQuery(record ...sql.Record) (string, []any, error) {
 	if len(record) == 0 {
 		return "", nil, errors.New("no record provided")
 	}
-	tableName, err := parseTableName(record[0].Table())
+	tableName, _, err := parseTableName(record[0].Table())
 	if err != nil {
 		return "", nil, err
 	}
+	if err := validateColumns(record[0].Columns()); err != nil {
+		return "", nil, err
+	}
 	placehodlers, values := getValuesPlaceHolders(record...)
 	return fmt.Sprintf(insertQuery, tableName, parseInsertColumns(record[0]), placehodlers), values, nil
 }
+
+// ParseInsertReturningIDsQuery is not supported, mysql has no clause returning the inserted rows,
+// the IDs of a multi-row insert are consecutive from LastInsertId.
+func (p *parser) ParseInsertReturningIDsQuery(records ...sql.Record) (string, []any, error) {
+	return "", nil, sql.NewInvalidQueryError("insert returning query:: mysql does not return the inserted IDs, use LastInsertId")
+}
+
+/*
+ParseInsertIgnoreQuery returns the insert query of the records that skips the records conflicting with existing rows.
+mysql skips the conflicts on every unique key, the conflict columns are not used and a constraint cannot be named.
+INSERT IGNORE also turns some other errors into warnings, eg. a value too long for its column is truncated.
+eg. INSERT IGNORE INTO `users` (`name`, `email`) VALUES (?, ?)
+*/
+func (p *parser) ParseInsertIgnoreQuery(conflict *sql.Conflict, records ...sql.Record) (string, []any, error) {
+	if len(records) == 0 || records[0] == nil {
+		return "", nil, errors.New("no record provided")
+	}
+	if err := conflict.Validate(); err != nil {
+		return "", nil, err
+	}
+	if conflict != nil && conflict.Constraint != "" {
+		return "", nil, sql.NewInvalidQueryError("invalid conflict: mysql does not support conflicts on a named constraint")
+	}
+	tableName, _, err := parseTableName(records[0].Table())
+	if err != nil {
+		return "", nil, err
+	}
+	if err := validateColumns(records[0].Columns()); err != nil {
+		return "", nil, err
+	}
+	placeholders, values := getValuesPlaceHolders(records...)
+	return fmt.Sprintf(insertIgnoreQuery, tableName, parseInsertColumns(records[0]), placeholders), values, nil
+}
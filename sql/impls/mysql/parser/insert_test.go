@@ -29,14 +29,14 @@ func TestParseInsertQuery(t *testing.T) {
 		{
 			name:    "single record, single column (id only)",
 			args:    args{record: []sql.Record{&mockIdOnlyRecord{Id: 1}}},
-			want:    "INSERT INTO mock () VALUES ()",
+			want:    "INSERT INTO `mock` () VALUES ()",
 			want1:   []any{},
 			wantErr: false,
 		},
 		{
 			name:    "multiple records, single column (id only batch)",
 			args:    args{record: []sql.Record{&mockIdOnlyRecord{Id: 1}, &mockIdOnlyRecord{Id: 2}}},
-			want:    "INSERT INTO mock () VALUES (), ()",
+			want:    "INSERT INTO `mock` () VALUES (), ()",
 			want1:   []any{},
 			wantErr: false,
 		},
@@ -46,7 +46,7 @@ func TestParseInsertQuery(t *testing.T) {
 				Id: 1, Name: "Alice", Email: "alice@example.com", PasswordHash: "hash123",
 				IsActive: 1, CreatedAt: 123456789, UpdatedAt: 987654321,
 			}}},
-			want:    "INSERT INTO users (name, email, password_hash, score, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			want:    "INSERT INTO `users` (`name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?)",
 			want1:   []any{"Alice", "alice@example.com", "hash123", 0, 1, int64(123456789), int64(987654321)},
 			wantErr: false,
 		},
@@ -56,7 +56,7 @@ func TestParseInsertQuery(t *testing.T) {
 				&records.User{Id: 1, Name: "Alice", Email: "alice@example.com", PasswordHash: "hash123", IsActive: 1, CreatedAt: 123456789, UpdatedAt: 987654321},
 				&records.User{Id: 2, Name: "Bob", Email: "bob@example.com", PasswordHash: "hash456", IsActive: 0, CreatedAt: 123456790, UpdatedAt: 987654322},
 			}},
-			want:    "INSERT INTO users (name, email, password_hash, score, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
+			want:    "INSERT INTO `users` (`name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
 			want1:   []any{"Alice", "alice@example.com", "hash123", 0, 1, int64(123456789), int64(987654321), "Bob", "bob@example.com", "hash456", 0, 0, int64(123456790), int64(987654322)},
 			wantErr: false,
 		},
@@ -84,3 +84,69 @@ func TestParseInsertQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestParseInsertIgnoreQuery(t *testing.T) {
+	alice := &records.User{Id: 1, Name: "Alice", Email: "alice@example.com", PasswordHash: "hash123", IsActive: 1, CreatedAt: 123456789, UpdatedAt: 987654321}
+	type args struct {
+		conflict *sql.Conflict
+		records  []sql.Record
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		want1   []any
+		wantErr bool
+	}{
+		{
+			name:    "no records (error)",
+			args:    args{records: nil},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "default conflict",
+			args:    args{records: []sql.Record{alice}},
+			want:    "INSERT IGNORE INTO `users` (`name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			want1:   []any{"Alice", "alice@example.com", "hash123", 0, 1, int64(123456789), int64(987654321)},
+			wantErr: false,
+		},
+		{
+			name:    "conflict columns",
+			args:    args{conflict: &sql.Conflict{Columns: []string{"email"}}, records: []sql.Record{alice}},
+			want:    "INSERT IGNORE INTO `users` (`name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			want1:   []any{"Alice", "alice@example.com", "hash123", 0, 1, int64(123456789), int64(987654321)},
+			wantErr: false,
+		},
+		{
+			name:    "conflict constraint",
+			args:    args{conflict: &sql.Conflict{Constraint: "users_email_key"}, records: []sql.Record{alice}},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "missing table",
+			args:    args{records: []sql.Record{&mockNoTableRecord{}}},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, err := prsr.ParseInsertIgnoreQuery(tt.args.conflict, tt.args.records...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseInsertIgnoreQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseInsertIgnoreQuery() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("ParseInsertIgnoreQuery() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package parser
 
-import "github.com/gofreego/database/sql/impls/common"
+import (
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/impls/common"
+)
 
 var (
 	prsr = &parser{}
@@ -12,3 +15,27 @@ type parser struct {
 func NewParser() common.Parser {
 	return &parser{}
 }
+
+func (p *parser) Dialect() sql.Dialect {
+	return sql.MySQL
+}
+
+// MaxParams returns the maximum number of parameters in a query, prepared statements support up to 65535 placeholders
+func (p *parser) MaxParams() int {
+	return 65535
+}
+
+// MaxRows returns the maximum number of rows in a VALUES list, 0 when unlimited, there is no limit on the rows of a statement
+func (p *parser) MaxRows() int {
+	return 0
+}
+
+// InsertReturnsIDs returns false as the inserted IDs are read from LastInsertId
+func (p *parser) InsertReturnsIDs() bool {
+	return false
+}
+
+// ReturnsModifiedRows returns false as mysql has no clause returning the modified rows
+func (p *parser) ReturnsModifiedRows() bool {
+	return false
+}
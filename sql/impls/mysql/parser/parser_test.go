@@ -3,6 +3,7 @@ package parser
 import (
 	"testing"
 
+	"github.com/gofreego/database/sql"
 	"github.com/gofreego/database/sql/impls/common"
 )
 
@@ -37,3 +38,9 @@ func TestNewParser(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_Dialect(t *testing.T) {
+	if got := NewParser().Dialect(); got != sql.MySQL {
+		t.Errorf("Dialect() = %v, want %v", got, sql.MySQL)
+	}
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/gofreego/database/sql"
@@ -11,17 +12,18 @@ func Test_parseTableName(t *testing.T) {
 		table *sql.Table
 	}
 	tests := []struct {
-		name    string
-		args    args
-		want    string
-		wantErr bool
+		name       string
+		args       args
+		want       string
+		wantParams []sql.Param
+		wantErr    bool
 	}{
 		{
 			name: "simple table",
 			args: args{
 				table: sql.NewTable("users"),
 			},
-			want:    "users",
+			want:    "`users`",
 			wantErr: false,
 		},
 		{
@@ -33,7 +35,7 @@ func Test_parseTableName(t *testing.T) {
 					return t
 				}(),
 			},
-			want:    "users u",
+			want:    "`users` `u`",
 			wantErr: false,
 		},
 		{
@@ -50,7 +52,7 @@ func Test_parseTableName(t *testing.T) {
 					return t1
 				}(),
 			},
-			want:    "users INNER JOIN orders ON users.id = orders.user_id",
+			want:    "`users` INNER JOIN `orders` ON `users`.`id` = `orders`.`user_id`",
 			wantErr: false,
 		},
 		{
@@ -67,7 +69,7 @@ func Test_parseTableName(t *testing.T) {
 					return t1
 				}(),
 			},
-			want:    "users LEFT JOIN profiles ON users.id = profiles.user_id",
+			want:    "`users` LEFT JOIN `profiles` ON `users`.`id` = `profiles`.`user_id`",
 			wantErr: false,
 		},
 		{
@@ -84,7 +86,7 @@ func Test_parseTableName(t *testing.T) {
 					return t1
 				}(),
 			},
-			want:    "users RIGHT JOIN logins ON users.id = logins.user_id",
+			want:    "`users` RIGHT JOIN `logins` ON `users`.`id` = `logins`.`user_id`",
 			wantErr: false,
 		},
 		{
@@ -107,16 +109,31 @@ func Test_parseTableName(t *testing.T) {
 					return t1
 				}(),
 			},
-			want:    "users INNER JOIN orders ON users.id = orders.user_id LEFT JOIN payments ON orders.id = payments.order_id",
+			want:    "`users` INNER JOIN `orders` ON `users`.`id` = `orders`.`user_id` LEFT JOIN `payments` ON `orders`.`id` = `payments`.`order_id`",
 			wantErr: false,
 		},
+		{
+			name: "table with fixed value in join condition",
+			args: args{
+				table: func() *sql.Table {
+					t1 := sql.NewTable("users")
+					t2 := sql.NewTable("orders")
+					t1.WithInnerJoin(t2, sql.NewCondition("users.id", sql.EQ, sql.NewColumnValue("orders.user_id")).
+						And(sql.NewCondition("orders.status", sql.EQ, sql.NewValue("paid"))))
+					return t1
+				}(),
+			},
+			want:       "`users` INNER JOIN `orders` ON (`users`.`id` = `orders`.`user_id` AND `orders`.`status` = ?)",
+			wantParams: []sql.Param{{Value: "paid", Fixed: true}},
+			wantErr:    false,
+		},
 		{
 			name: "empty table name",
 			args: args{
 				table: sql.NewTable(""),
 			},
 			want:    "",
-			wantErr: false,
+			wantErr: true,
 		},
 		{
 			name: "nil table",
@@ -129,7 +146,7 @@ func Test_parseTableName(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := parseTableName(tt.args.table)
+			got, got1, err := parseTableName(tt.args.table)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("parseTableName() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -137,6 +154,9 @@ func Test_parseTableName(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("parseTableName() = %v, want %v", got, tt.want)
 			}
+			if !reflect.DeepEqual(got1, tt.wantParams) {
+				t.Errorf("parseTableName() got1 = %v, want %v", got1, tt.wantParams)
+			}
 		})
 	}
 }
@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/gofreego/database/sql"
+)
+
+// ParseTransactionQuery returns no statement as the driver begins the transaction with the isolation level and the access mode,
+// mysql has no snapshot isolation level and no deferrable transactions.
+func (p *parser) ParseTransactionQuery(options *sql.TxOptions) (string, error) {
+	if options == nil {
+		return "", nil
+	}
+	if options.Isolation == sql.Snapshot {
+		return "", sql.NewInvalidQueryError("invalid transaction options: mysql does not support the snapshot isolation level")
+	}
+	if options.Deferrable {
+		return "", sql.NewInvalidQueryError("invalid transaction options: mysql does not support deferrable transactions")
+	}
+	return "", nil
+}
+
+const (
+	savepointQuery           = "SAVEPOINT %s"
+	rollbackToSavepointQuery = "ROLLBACK TO SAVEPOINT %s"
+	releaseSavepointQuery    = "RELEASE SAVEPOINT %s"
+)
+
+// ParseSavepointQuery returns the query setting the savepoint
+func (p *parser) ParseSavepointQuery(name string) (string, error) {
+	if err := sql.ValidateSavepoint(name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(savepointQuery, quote(name)), nil
+}
+
+// ParseRollbackToSavepointQuery returns the query rolling the transaction back to the savepoint
+func (p *parser) ParseRollbackToSavepointQuery(name string) (string, error) {
+	if err := sql.ValidateSavepoint(name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(rollbackToSavepointQuery, quote(name)), nil
+}
+
+// ParseReleaseSavepointQuery returns the query releasing the savepoint
+func (p *parser) ParseReleaseSavepointQuery(name string) (string, error) {
+	if err := sql.ValidateSavepoint(name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(releaseSavepointQuery, quote(name)), nil
+}
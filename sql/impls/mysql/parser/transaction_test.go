@@ -0,0 +1,70 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/gofreego/database/sql"
+)
+
+func TestParseTransactionQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *sql.TxOptions
+		want    string
+		wantErr bool
+	}{
+		{"no options", nil, "", false},
+		{"isolation level and read only", &sql.TxOptions{Isolation: sql.Serializable, ReadOnly: true}, "", false},
+		{"snapshot", &sql.TxOptions{Isolation: sql.Snapshot}, "", true},
+		{"deferrable", &sql.TxOptions{Isolation: sql.Serializable, ReadOnly: true, Deferrable: true}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := prsr.ParseTransactionQuery(tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseTransactionQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseTransactionQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSavepointQueries(t *testing.T) {
+	tests := []struct {
+		name           string
+		savepoint      string
+		wantSavepoint  string
+		wantRollbackTo string
+		wantRelease    string
+		wantErr        bool
+	}{
+		{"savepoint", "sp_1", "SAVEPOINT `sp_1`", "ROLLBACK TO SAVEPOINT `sp_1`", "RELEASE SAVEPOINT `sp_1`", false},
+		{"qualified name", "tx.sp_1", "", "", "", true},
+		{"invalid name", "sp; DROP TABLE users", "", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			savepoint, err := prsr.ParseSavepointQuery(tt.savepoint)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSavepointQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			rollbackTo, err := prsr.ParseRollbackToSavepointQuery(tt.savepoint)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRollbackToSavepointQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			release, err := prsr.ParseReleaseSavepointQuery(tt.savepoint)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseReleaseSavepointQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if savepoint != tt.wantSavepoint || rollbackTo != tt.wantRollbackTo || release != tt.wantRelease {
+				t.Errorf("savepoint queries = %q, %q, %q, want %q, %q, %q", savepoint, rollbackTo, release, tt.wantSavepoint, tt.wantRollbackTo, tt.wantRelease)
+			}
+		})
+	}
+}
@@ -11,18 +11,19 @@ const (
 	updateQuery = "UPDATE %s SET %s WHERE %s"
 )
 
-func (p *parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condition *sql.Condition) (string, []int, error) {
-	var valueIndexes []int
+func (p *parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condition *sql.Condition) (string, []sql.Param, error) {
+	var valueIndexes []sql.Param
 	var updateClause string
 	var err error
 
 	if updates == nil {
 		return "", nil, errors.New("updates is nil")
 	}
-	tableName, err := parseTableName(table)
+	tableName, tableParams, err := parseTableName(table)
 	if err != nil {
 		return "", nil, err
 	}
+	valueIndexes = append(valueIndexes, tableParams...)
 	updateClause, updateValueIndexes, err := parseUpdates(updates)
 	if err != nil {
 		return "", nil, err
@@ -37,9 +38,9 @@ func (p *parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condit
 	return fmt.Sprintf(updateQuery, tableName, updateClause, conditionQuery), valueIndexes, nil
 }
 
-func parseUpdates(updates *sql.Updates) (string, []int, error) {
+func parseUpdates(updates *sql.Updates) (string, []sql.Param, error) {
 	var updateClause string
-	var valueIndexes []int
+	var valueIndexes []sql.Param
 
 	for i, update := range updates.Fields {
 		if update.Field == "" {
@@ -48,6 +49,10 @@ func parseUpdates(updates *sql.Updates) (string, []int, error) {
 		if update.Value == nil {
 			return "", nil, errors.New("value is nil for update field: " + update.Field)
 		}
+		if err := sql.ValidateIdentifier(update.Field); err != nil {
+			return "", nil, err
+		}
+		field := quote(update.Field)
 
 		// Add comma separator if not the first field
 		if i > 0 {
@@ -58,14 +63,32 @@ func parseUpdates(updates *sql.Updates) (string, []int, error) {
 			if update.Value.Value == nil || update.Value.Value == "" {
 				return "", nil, errors.New("value is empty for update field: " + update.Field + " and value type is a column")
 			}
-			updateClause += fmt.Sprintf("%s = %s", update.Field, update.Value.Value)
+			column, ok := update.Value.Value.(string)
+			if !ok {
+				return "", nil, errors.New("value is not a column name for update field: " + update.Field)
+			}
+			if err := sql.ValidateIdentifier(column); err != nil {
+				return "", nil, err
+			}
+			updateClause += fmt.Sprintf("%s = %s", field, quote(column))
+		} else if update.Value.IsExpression() {
+			updateClause += fmt.Sprintf("%s = %v", field, update.Value.Value)
 		} else if update.Value.Value != nil {
-			updateClause += fmt.Sprintf("%s = %s", update.Field, getValue(update.Value.Value))
+			// fixed values are bound as parameters
+			placeHolder, params := parseFixedValues(update.Value, update.Value.Value)
+			updateClause += fmt.Sprintf("%s = %s", field, placeHolder)
+			valueIndexes = append(valueIndexes, params...)
 		} else {
-			updateClause += fmt.Sprintf("%s = ?", update.Field)
-			valueIndexes = append(valueIndexes, update.Value.Index)
+			updateClause += fmt.Sprintf("%s = ?", field)
+			valueIndexes = append(valueIndexes, sql.Param{Index: update.Value.Index})
 		}
 	}
 
 	return updateClause, valueIndexes, nil
 }
+
+// ParseUpdateReturningQuery is not supported, mysql has no clause returning the updated rows,
+// they are selected after the update in the same transaction.
+func (p *parser) ParseUpdateReturningQuery(updates *sql.Updates, condition *sql.Condition, records sql.Records) (string, []sql.Param, error) {
+	return "", nil, sql.NewInvalidQueryError("update returning query:: mysql does not return the updated rows")
+}
@@ -15,7 +15,14 @@ func (p *parser) ParseUpdateByIDQuery(record sql.Record) (string, error) {
 	if record == nil {
 		return "", sql.NewInvalidQueryError("update query:: record cannot be nil")
 	}
-	tableName, err := parseTableName(record.Table())
+	tableName, _, err := parseTableName(record.Table())
+	if err != nil {
+		return "", err
+	}
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", err
+	}
+	idColumn, err := parseIdColumn(record)
 	if err != nil {
 		return "", err
 	}
@@ -23,7 +30,7 @@ func (p *parser) ParseUpdateByIDQuery(record sql.Record) (string, error) {
 	if updateString == "" {
 		return "", sql.NewInvalidQueryError("update query:: no columns to update")
 	}
-	return fmt.Sprintf(mysqlUpdateByIDQuery, tableName, getUpdatesString(record), record.IdColumn()), nil
+	return fmt.Sprintf(mysqlUpdateByIDQuery, tableName, updateString, idColumn), nil
 }
 
 func getUpdatesString(record sql.Record) string {
@@ -33,7 +40,7 @@ func getUpdatesString(record sql.Record) string {
 		if column.Name == idColumn {
 			continue // Skip the ID column in the update
 		}
-		updates = append(updates, fmt.Sprintf("%s = ?", column.Name))
+		updates = append(updates, fmt.Sprintf("%s = ?", quote(column.Name)))
 	}
 	return strings.Join(updates, ", ")
 }
@@ -0,0 +1,70 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofreego/database/sql"
+)
+
+const (
+	mysqlUpdateManyByIDQuery = "UPDATE %s JOIN (%s) AS update_values ON %s.%s = update_values.%s SET %s"
+)
+
+/*
+ParseUpdateManyByIDQuery returns the query to update every record by its ID with its own values.
+The values of every record are a row of a derived table joined on the id column, the values are id first and then the record's values.
+eg. UPDATE `users` JOIN (SELECT ? AS `id`, ? AS `name` UNION ALL SELECT ?, ?) AS update_values
+ON `users`.`id` = update_values.`id` SET `users`.`name` = update_values.`name`
+*/
+func (p *parser) ParseUpdateManyByIDQuery(records ...sql.Record) (string, []any, error) {
+	if len(records) == 0 {
+		return "", nil, errors.New("no record provided")
+	}
+	record := records[0]
+	tableName, _, err := parseTableName(record.Table())
+	if err != nil {
+		return "", nil, err
+	}
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", nil, err
+	}
+	idColumn, err := parseIdColumn(record)
+	if err != nil {
+		return "", nil, err
+	}
+	target := quote(record.Table().Name)
+	if record.Table().Alias != "" {
+		target = quote(record.Table().Alias)
+	}
+	// the first row names the columns of the derived table
+	columns := []string{"? AS " + idColumn}
+	updates := []string{}
+	for _, column := range record.Columns() {
+		if column.Name == record.IdColumn() {
+			continue // the id column is the first column of the values
+		}
+		name := quote(column.Name)
+		columns = append(columns, "? AS "+name)
+		updates = append(updates, fmt.Sprintf("%s.%s = update_values.%s", target, name, name))
+	}
+	if len(updates) == 0 {
+		return "", nil, sql.NewInvalidQueryError("update many query:: no columns to update")
+	}
+	rows := make([]string, len(records))
+	values := make([]any, 0, len(records)*len(columns))
+	for i, r := range records {
+		if len(r.Values()) != len(columns)-1 {
+			return "", nil, sql.NewInvalidQueryError("update many query:: every record should have %d values", len(columns)-1)
+		}
+		if i == 0 {
+			rows[i] = "SELECT " + strings.Join(columns, ", ")
+		} else {
+			rows[i] = "SELECT " + getPlaceHolders(len(columns))
+		}
+		values = append(append(values, r.ID()), r.Values()...)
+	}
+	return fmt.Sprintf(mysqlUpdateManyByIDQuery, tableName, strings.Join(rows, " UNION ALL "), target, idColumn, idColumn,
+		strings.Join(updates, ", ")), values, nil
+}
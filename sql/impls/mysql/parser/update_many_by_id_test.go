@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/tests/records"
+)
+
+func TestParseUpdateManyByIDQuery(t *testing.T) {
+	type args struct {
+		records []sql.Record
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       string
+		wantValues []any
+		wantErr    bool
+	}{
+		{
+			name: "single record",
+			args: args{
+				records: []sql.Record{
+					&records.User{Id: 1, Name: "Alice", Email: "alice@example.com", PasswordHash: "hash1", Score: 10, IsActive: 1, CreatedAt: 100, UpdatedAt: 200},
+				},
+			},
+			want:       "UPDATE `users` JOIN (SELECT ? AS `id`, ? AS `name`, ? AS `email`, ? AS `password_hash`, ? AS `score`, ? AS `is_active`, ? AS `created_at`, ? AS `updated_at`) AS update_values ON `users`.`id` = update_values.`id` SET `users`.`name` = update_values.`name`, `users`.`email` = update_values.`email`, `users`.`password_hash` = update_values.`password_hash`, `users`.`score` = update_values.`score`, `users`.`is_active` = update_values.`is_active`, `users`.`created_at` = update_values.`created_at`, `users`.`updated_at` = update_values.`updated_at`",
+			wantValues: []any{int64(1), "Alice", "alice@example.com", "hash1", 10, 1, int64(100), int64(200)},
+			wantErr:    false,
+		},
+		{
+			name: "multiple records",
+			args: args{
+				records: []sql.Record{
+					&records.User{Id: 1, Name: "Alice", Email: "alice@example.com", PasswordHash: "hash1", Score: 10, IsActive: 1, CreatedAt: 100, UpdatedAt: 200},
+					&records.User{Id: 2, Name: "Bob", Email: "bob@example.com", PasswordHash: "hash2", Score: 20, IsActive: 0, CreatedAt: 300, UpdatedAt: 400},
+				},
+			},
+			want: "UPDATE `users` JOIN (SELECT ? AS `id`, ? AS `name`, ? AS `email`, ? AS `password_hash`, ? AS `score`, ? AS `is_active`, ? AS `created_at`, ? AS `updated_at` UNION ALL SELECT ?, ?, ?, ?, ?, ?, ?, ?) AS update_values ON `users`.`id` = update_values.`id` SET `users`.`name` = update_values.`name`, `users`.`email` = update_values.`email`, `users`.`password_hash` = update_values.`password_hash`, `users`.`score` = update_values.`score`, `users`.`is_active` = update_values.`is_active`, `users`.`created_at` = update_values.`created_at`, `users`.`updated_at` = update_values.`updated_at`",
+			wantValues: []any{
+				int64(1), "Alice", "alice@example.com", "hash1", 10, 1, int64(100), int64(200),
+				int64(2), "Bob", "bob@example.com", "hash2", 20, 0, int64(300), int64(400),
+			},
+			wantErr: false,
+		},
+		{
+			name: "no records",
+			args: args{
+				records: nil,
+			},
+			want:       "",
+			wantValues: nil,
+			wantErr:    true,
+		},
+		{
+			name: "missing table",
+			args: args{
+				records: []sql.Record{&mockNoTableRecord{}},
+			},
+			want:       "",
+			wantValues: nil,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, values, err := prsr.ParseUpdateManyByIDQuery(tt.args.records...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseUpdateManyByIDQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseUpdateManyByIDQuery() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(values, tt.wantValues) {
+				t.Errorf("ParseUpdateManyByIDQuery() values = %v, want %v", values, tt.wantValues)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/tests/records"
 )
 
 func TestParseUpdateQuery(t *testing.T) {
@@ -17,7 +18,7 @@ func TestParseUpdateQuery(t *testing.T) {
 		name    string
 		args    args
 		want    string
-		want1   []int
+		want1   []sql.Param
 		wantErr bool
 	}{
 		{
@@ -33,8 +34,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0),
 				},
 			},
-			want:    "UPDATE users SET name = 'John Doe', email = 'john@example.com' WHERE id = ?",
-			want1:   []int{0},
+			want:    "UPDATE `users` SET `name` = ?, `email` = ? WHERE `id` = ?",
+			want1:   []sql.Param{{Value: "John Doe", Fixed: true}, {Value: "john@example.com", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -50,8 +51,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0),
 				},
 			},
-			want:    "UPDATE products SET price = 99.99, quantity = 100 WHERE category = ?",
-			want1:   []int{0},
+			want:    "UPDATE `products` SET `price` = ?, `quantity` = ? WHERE `category` = ?",
+			want1:   []sql.Param{{Value: 99.99, Fixed: true}, {Value: 100, Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -67,8 +68,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0),
 				},
 			},
-			want:    "UPDATE settings SET is_active = true, notifications_enabled = false WHERE user_id = ?",
-			want1:   []int{0},
+			want:    "UPDATE `settings` SET `is_active` = ?, `notifications_enabled` = ? WHERE `user_id` = ?",
+			want1:   []sql.Param{{Value: true, Fixed: true}, {Value: false, Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -76,7 +77,7 @@ func TestParseUpdateQuery(t *testing.T) {
 			args: args{
 				table: sql.NewTable("orders"),
 				updates: sql.NewUpdates().
-					Add("total", sql.NewColumnValue("price * quantity")).
+					Add("total", sql.NewExpressionValue("price * quantity")).
 					Add("updated_at", sql.NewValue("NOW()")),
 				condition: &sql.Condition{
 					Field:    "status",
@@ -84,8 +85,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0),
 				},
 			},
-			want:    "UPDATE orders SET total = price * quantity, updated_at = 'NOW()' WHERE status = ?",
-			want1:   []int{0},
+			want:    "UPDATE `orders` SET `total` = price * quantity, `updated_at` = ? WHERE `status` = ?",
+			want1:   []sql.Param{{Value: "NOW()", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -102,8 +103,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(3),
 				},
 			},
-			want:    "UPDATE users SET name = ?, email = ?, age = ? WHERE id = ?",
-			want1:   []int{0, 1, 2, 3},
+			want:    "UPDATE `users` SET `name` = ?, `email` = ?, `age` = ? WHERE `id` = ?",
+			want1:   []sql.Param{{Index: 0}, {Index: 1}, {Index: 2}, {Index: 3}},
 			wantErr: false,
 		},
 		{
@@ -129,8 +130,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					},
 				},
 			},
-			want:    "UPDATE products SET price = 150, discount = 10 WHERE (category = ? AND price > ?)",
-			want1:   []int{0, 1},
+			want:    "UPDATE `products` SET `price` = ?, `discount` = ? WHERE (`category` = ? AND `price` > ?)",
+			want1:   []sql.Param{{Value: 150.00, Fixed: true}, {Value: 10, Fixed: true}, {Index: 0}, {Index: 1}},
 			wantErr: false,
 		},
 		{
@@ -145,8 +146,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0).WithCount(3),
 				},
 			},
-			want:    "UPDATE users SET status = 'inactive' WHERE id IN (?, ?, ?)",
-			want1:   []int{0},
+			want:    "UPDATE `users` SET `status` = ? WHERE `id` IN (?, ?, ?)",
+			want1:   []sql.Param{{Value: "inactive", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -161,8 +162,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0),
 				},
 			},
-			want:    "UPDATE products SET category = 'electronics' WHERE name LIKE ?",
-			want1:   []int{0},
+			want:    "UPDATE `products` SET `category` = ? WHERE `name` LIKE ?",
+			want1:   []sql.Param{{Value: "electronics", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -177,8 +178,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0),
 				},
 			},
-			want:    "UPDATE users u SET last_login = 'NOW()' WHERE u.id = ?",
-			want1:   []int{0},
+			want:    "UPDATE `users` `u` SET `last_login` = ? WHERE `u`.`id` = ?",
+			want1:   []sql.Param{{Value: "NOW()", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -198,8 +199,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0),
 				},
 			},
-			want:    "UPDATE orders INNER JOIN users ON orders.user_id = users.id SET status = 'shipped' WHERE users.email = ?",
-			want1:   []int{0},
+			want:    "UPDATE `orders` INNER JOIN `users` ON `orders`.`user_id` = `users`.`id` SET `status` = ? WHERE `users`.`email` = ?",
+			want1:   []sql.Param{{Value: "shipped", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -220,8 +221,8 @@ func TestParseUpdateQuery(t *testing.T) {
 				updates:   sql.NewUpdates(),
 				condition: &sql.Condition{Field: "id", Operator: sql.EQ, Value: sql.NewIndexedValue(0)},
 			},
-			want:    "UPDATE users SET  WHERE id = ?",
-			want1:   []int{0},
+			want:    "UPDATE `users` SET  WHERE `id` = ?",
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -301,8 +302,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0),
 				},
 			},
-			want:    "UPDATE comments SET content = 'It's a \"quoted\" text with 'apostrophes'', author = 'John O'Connor' WHERE id = ?",
-			want1:   []int{0},
+			want:    "UPDATE `comments` SET `content` = ?, `author` = ? WHERE `id` = ?",
+			want1:   []sql.Param{{Value: "It's a \"quoted\" text with 'apostrophes'", Fixed: true}, {Value: "John O'Connor", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -318,8 +319,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0),
 				},
 			},
-			want:    "UPDATE articles SET title = 'This is a very long title that might exceed normal limits', content = 'This is a very long content with multiple paragraphs and lots of text' WHERE author_id = ?",
-			want1:   []int{0},
+			want:    "UPDATE `articles` SET `title` = ?, `content` = ? WHERE `author_id` = ?",
+			want1:   []sql.Param{{Value: "This is a very long title that might exceed normal limits", Fixed: true}, {Value: "This is a very long content with multiple paragraphs and lots of text", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -338,8 +339,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0),
 				},
 			},
-			want:    "UPDATE products SET name = 'Product Name', price = 99.99, is_active = true, tags = 'tag1,tag2,tag3', rating = 4.5 WHERE category_id = ?",
-			want1:   []int{0},
+			want:    "UPDATE `products` SET `name` = ?, `price` = ?, `is_active` = ?, `tags` = ?, `rating` = ? WHERE `category_id` = ?",
+			want1:   []sql.Param{{Value: "Product Name", Fixed: true}, {Value: 99.99, Fixed: true}, {Value: true, Fixed: true}, {Value: "tag1,tag2,tag3", Fixed: true}, {Value: 4.5, Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -364,8 +365,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					},
 				},
 			},
-			want:    "UPDATE users SET status = 'inactive' WHERE (last_login < ? OR email_verified = ?)",
-			want1:   []int{0, 1},
+			want:    "UPDATE `users` SET `status` = ? WHERE (`last_login` < ? OR `email_verified` = ?)",
+			want1:   []sql.Param{{Value: "inactive", Fixed: true}, {Index: 0}, {Index: 1}},
 			wantErr: false,
 		},
 		{
@@ -380,8 +381,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Value:    sql.NewIndexedValue(0),
 				},
 			},
-			want:    "UPDATE orders SET discount = 15 WHERE (total BETWEEN ? AND ?)",
-			want1:   []int{0},
+			want:    "UPDATE `orders` SET `discount` = ? WHERE (`total` BETWEEN ? AND ?)",
+			want1:   []sql.Param{{Value: 15, Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -395,8 +396,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Operator: sql.ISNULL,
 				},
 			},
-			want:    "UPDATE users SET email_verified_at = 'NOW()' WHERE email_verified_at IS NULL",
-			want1:   nil,
+			want:    "UPDATE `users` SET `email_verified_at` = ? WHERE `email_verified_at` IS NULL",
+			want1:   []sql.Param{{Value: "NOW()", Fixed: true}},
 			wantErr: false,
 		},
 	}
@@ -416,3 +417,55 @@ func TestParseUpdateQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestParseUpdateReturningQuery(t *testing.T) {
+	type args struct {
+		updates   *sql.Updates
+		condition *sql.Condition
+		records   sql.Records
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		want1   []sql.Param
+		wantErr bool
+	}{
+		{
+			name: "test update returning with indexed condition",
+			args: args{
+				updates:   sql.NewUpdates().Add("is_active", sql.NewValue(0)),
+				condition: sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0)),
+				records:   &records.Users{},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "test update returning with nil records",
+			args: args{
+				updates:   sql.NewUpdates().Add("is_active", sql.NewValue(0)),
+				condition: sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0)),
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, err := prsr.ParseUpdateReturningQuery(tt.args.updates, tt.args.condition, tt.args.records)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseUpdateReturningQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseUpdateReturningQuery() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("ParseUpdateReturningQuery() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/gofreego/database/sql"
@@ -12,35 +13,162 @@ const (
 	upsertQuery = "INSERT INTO %s (%s) VALUES %s ON DUPLICATE KEY UPDATE %s"
 )
 
-func (p *parser) ParseUpsertQuery(record sql.Record) (string, []any, error) {
-	if record == nil {
+/*
+ParseUpsertQuery returns the query inserting the records or updating the rows they conflict with.
+mysql detects the conflicts on every unique key, the conflict columns are only left out of the updates
+and a constraint cannot be named.
+The id column is set to LAST_INSERT_ID(id), for LastInsertId to be the id of the existing row on conflict.
+The condition of the conflict options is checked by every update, eg.
+INSERT INTO `users` (`name`, `updated_at`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`),
+`name` = IF(`updated_at` < VALUES(`updated_at`), VALUES(`name`), `name`), `updated_at` = IF(`updated_at` < VALUES(`updated_at`), VALUES(`updated_at`), `updated_at`)
+*/
+func (p *parser) ParseUpsertQuery(conflict *sql.Conflict, records ...sql.Record) (string, []any, error) {
+	if len(records) == 0 || records[0] == nil {
 		return "", nil, errors.New("no record provided")
 	}
-	tableName, err := parseTableName(record.Table())
+	record := records[0]
+	if err := conflict.Validate(); err != nil {
+		return "", nil, err
+	}
+	if conflict != nil && conflict.Constraint != "" {
+		return "", nil, sql.NewInvalidQueryError("invalid conflict: mysql does not support conflicts on a named constraint")
+	}
+	tableName, _, err := parseTableName(record.Table())
 	if err != nil {
 		return "", nil, err
 	}
-	placeholders, values := getValuesPlaceHolders(record)
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", nil, err
+	}
+	placeholders, values := getValuesPlaceHolders(records...)
 	if len(values) == 0 {
 		return "", nil, errors.New("no values provided for upsert")
 	}
+	idColumn, err := parseIdColumn(record)
+	if err != nil {
+		return "", nil, err
+	}
+	// setting the id column to its own value leaves the row unchanged
+	idUpdate := fmt.Sprintf("%s = LAST_INSERT_ID(%s)", idColumn, idColumn)
+	if conflict != nil && conflict.DoNothing {
+		return fmt.Sprintf(upsertQuery, tableName, parseInsertColumns(record), placeholders, idUpdate), values, nil
+	}
 
-	updates := parseUpsertUpdates(record)
+	updates, updateValues, err := parseUpsertUpdates(record, conflict)
+	if err != nil {
+		return "", nil, err
+	}
 	if updates == "" {
 		return "", nil, errors.New("no columns to update")
 	}
 
-	return fmt.Sprintf(upsertQuery, tableName, parseInsertColumns(record), placeholders, updates), values, nil
+	updates = idUpdate + ", " + updates
+	return fmt.Sprintf(upsertQuery, tableName, parseInsertColumns(record), placeholders, updates), append(values, updateValues...), nil
 }
 
-func parseUpsertUpdates(record sql.Record) string {
+/*
+parseUpsertUpdates returns the updates on conflict and the values of their placeholders.
+With a condition every column is set to its value only if the condition is met. mysql evaluates the updates in order,
+each one against the row updated by the previous ones, so the condition can read at most one updated column,
+updated last for the condition to see the existing row in every update.
+*/
+func parseUpsertUpdates(record sql.Record, conflict *sql.Conflict) (string, []any, error) {
+	columns, err := conflict.UpdateColumns(record)
+	if err != nil {
+		return "", nil, err
+	}
+	if conflict == nil || conflict.Where == nil {
+		updates := []string{}
+		for _, column := range columns {
+			updates = append(updates, fmt.Sprintf("%s = VALUES(%s)", quote(column), quote(column)))
+		}
+		return strings.Join(updates, ", "), nil, nil
+	}
+	read := map[string]bool{}
+	where, err := resolveUpsertCondition(*conflict.Where, read)
+	if err != nil {
+		return "", nil, err
+	}
+	condition, params, err := parseCondition(&where)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, param := range params {
+		if !param.Fixed {
+			return "", nil, sql.NewInvalidQueryError("invalid conflict: only fixed values can be used in the condition")
+		}
+	}
+	var updatedReads []string
+	for _, column := range columns {
+		if read[column] {
+			updatedReads = append(updatedReads, column)
+		}
+	}
+	if len(updatedReads) > 1 {
+		return "", nil, sql.NewInvalidQueryError("invalid conflict: the condition can read at most one updated column on mysql, it reads %s", strings.Join(updatedReads, ", "))
+	}
+	conditionValues := sql.GetParamValues(params, nil)
+	columns = slices.Clone(columns)
+	slices.SortStableFunc(columns, func(a, b string) int {
+		switch {
+		case read[a] == read[b]:
+			return 0
+		case read[a]:
+			return 1
+		default:
+			return -1
+		}
+	})
 	updates := []string{}
-	idColumn := record.IdColumn()
-	for _, col := range record.Columns() {
-		if col.Name == idColumn {
-			continue // Skip the ID column in the update
+	values := []any{}
+	for _, column := range columns {
+		updates = append(updates, fmt.Sprintf("%s = IF(%s, VALUES(%s), %s)", quote(column), condition, quote(column), quote(column)))
+		values = append(values, conditionValues...)
+	}
+	return strings.Join(updates, ", "), values, nil
+}
+
+// resolveUpsertCondition returns a copy of the condition with the columns of sql.Excluded rendered as VALUES(column),
+// the other columns the condition reads are added to read.
+func resolveUpsertCondition(condition sql.Condition, read map[string]bool) (sql.Condition, error) {
+	if len(condition.Conditions) > 0 {
+		conditions := make([]sql.Condition, len(condition.Conditions))
+		for i, subCondition := range condition.Conditions {
+			resolved, err := resolveUpsertCondition(subCondition, read)
+			if err != nil {
+				return sql.Condition{}, err
+			}
+			conditions[i] = resolved
+		}
+		condition.Conditions = conditions
+		return condition, nil
+	}
+	if condition.Field != "" && !condition.RawField {
+		column, err := resolveUpsertColumn(condition.Field, read)
+		if err != nil {
+			return sql.Condition{}, err
+		}
+		condition.Field, condition.RawField = column, true
+	}
+	if condition.Value != nil && condition.Value.IsColumn() && condition.Value.IsStringValue() {
+		column, err := resolveUpsertColumn(condition.Value.Value.(string), read)
+		if err != nil {
+			return sql.Condition{}, err
 		}
-		updates = append(updates, fmt.Sprintf("%s = VALUES(%s)", col.Name, col.Name))
+		condition.Value = sql.NewExpressionValue(column)
+	}
+	return condition, nil
+}
+
+// resolveUpsertColumn quotes the column, a column of sql.Excluded is rendered as VALUES(column)
+func resolveUpsertColumn(column string, read map[string]bool) (string, error) {
+	if err := sql.ValidateIdentifier(column); err != nil {
+		return "", err
+	}
+	if name, ok := strings.CutPrefix(column, sql.Excluded+"."); ok {
+		return fmt.Sprintf("VALUES(%s)", quote(name)), nil
 	}
-	return strings.Join(updates, ", ")
+	// a qualified column is read by the name of its last part
+	read[column[strings.LastIndex(column, ".")+1:]] = true
+	return quote(column), nil
 }
@@ -10,7 +10,8 @@ import (
 
 func TestParseUpsertQuery(t *testing.T) {
 	type args struct {
-		record sql.Record
+		record   sql.Record
+		conflict *sql.Conflict
 	}
 	tests := []struct {
 		name    string
@@ -32,7 +33,7 @@ func TestParseUpsertQuery(t *testing.T) {
 					UpdatedAt:    456,
 				},
 			},
-			want:    "INSERT INTO users (name, email, password_hash, score, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE name = VALUES(name), email = VALUES(email), password_hash = VALUES(password_hash), score = VALUES(score), is_active = VALUES(is_active), created_at = VALUES(created_at), updated_at = VALUES(updated_at)",
+			want:    "INSERT INTO `users` (`name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `name` = VALUES(`name`), `email` = VALUES(`email`), `password_hash` = VALUES(`password_hash`), `score` = VALUES(`score`), `is_active` = VALUES(`is_active`), `created_at` = VALUES(`created_at`), `updated_at` = VALUES(`updated_at`)",
 			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456)},
 			wantErr: false,
 		},
@@ -63,10 +64,190 @@ func TestParseUpsertQuery(t *testing.T) {
 			want1:   nil,
 			wantErr: true,
 		},
+		{
+			name: "conflict columns",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}},
+			},
+			want:    "INSERT INTO `users` (`name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `name` = VALUES(`name`), `password_hash` = VALUES(`password_hash`), `score` = VALUES(`score`), `is_active` = VALUES(`is_active`), `created_at` = VALUES(`created_at`), `updated_at` = VALUES(`updated_at`)",
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456)},
+			wantErr: false,
+		},
+		{
+			name: "update columns with condition",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}, Update: []string{"updated_at", "name"}, Where: sql.NewCondition("updated_at", sql.LT, sql.NewColumnValue(sql.ExcludedColumn("updated_at"))).And(sql.NewCondition("is_active", sql.EQ, sql.NewValue(1)))},
+			},
+			want:    "INSERT INTO `users` (`name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `name` = IF((`updated_at` < VALUES(`updated_at`) AND `is_active` = ?), VALUES(`name`), `name`), `updated_at` = IF((`updated_at` < VALUES(`updated_at`) AND `is_active` = ?), VALUES(`updated_at`), `updated_at`)",
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456), 1, 1},
+			wantErr: false,
+		},
+		{
+			name: "condition reads two updated columns",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}, Update: []string{"updated_at", "is_active", "name"}, Where: sql.NewCondition("updated_at", sql.LT, sql.NewColumnValue(sql.ExcludedColumn("updated_at"))).And(sql.NewCondition("is_active", sql.EQ, sql.NewValue(1)))},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "condition reads two columns, one updated",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}, Update: []string{"is_active", "name"}, Where: sql.NewCondition("updated_at", sql.LT, sql.NewColumnValue(sql.ExcludedColumn("updated_at"))).And(sql.NewCondition("is_active", sql.EQ, sql.NewValue(1)))},
+			},
+			want:    "INSERT INTO `users` (`name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `name` = IF((`updated_at` < VALUES(`updated_at`) AND `is_active` = ?), VALUES(`name`), `name`), `is_active` = IF((`updated_at` < VALUES(`updated_at`) AND `is_active` = ?), VALUES(`is_active`), `is_active`)",
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456), 1, 1},
+			wantErr: false,
+		},
+		{
+			name: "do nothing",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}, DoNothing: true},
+			},
+			want:    "INSERT INTO `users` (`name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`)",
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456)},
+			wantErr: false,
+		},
+		{
+			name: "constraint",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Constraint: "users_email_key"},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "indexed value in condition",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Where: sql.NewCondition("score", sql.GT, sql.NewIndexedValue(0))},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "update column is not a value of the record",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Update: []string{"id"}},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "columns and constraint",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}, Constraint: "users_email_key"},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "do nothing with update columns",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{DoNothing: true, Update: []string{"name"}},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, got1, err := prsr.ParseUpsertQuery(tt.args.record)
+			got, got1, err := prsr.ParseUpsertQuery(tt.args.conflict, tt.args.record)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseUpsertQuery() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -80,3 +261,22 @@ func TestParseUpsertQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestParseUpsertQueryManyRecords(t *testing.T) {
+	users := []sql.Record{
+		&records.User{Name: "John", Email: "john@example.com", PasswordHash: "hash", UpdatedAt: 1},
+		&records.User{Name: "Jane", Email: "jane@example.com", PasswordHash: "hash", UpdatedAt: 2},
+	}
+	got, got1, err := prsr.ParseUpsertQuery(&sql.Conflict{Columns: []string{"email"}, Update: []string{"name"}}, users...)
+	if err != nil {
+		t.Fatalf("ParseUpsertQuery() error = %v", err)
+	}
+	want := "INSERT INTO `users` (`name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `name` = VALUES(`name`)"
+	if got != want {
+		t.Errorf("ParseUpsertQuery() got = %v, want %v", got, want)
+	}
+	want1 := []any{"John", "john@example.com", "hash", 0, 0, int64(0), int64(1), "Jane", "jane@example.com", "hash", 0, 0, int64(0), int64(2)}
+	if !reflect.DeepEqual(got1, want1) {
+		t.Errorf("ParseUpsertQuery() got1 = %v, want %v", got1, want1)
+	}
+}
@@ -28,7 +28,7 @@ func parseInsertColumns(record sql.Record) string {
 		if col.Name == idColumn {
 			continue
 		}
-		columns = append(columns, col.Name)
+		columns = append(columns, quote(col.Name))
 	}
 	return strings.Join(columns, ", ")
 }
@@ -54,6 +54,39 @@ func parseColumns(fields []*sql.Field) string {
 	return strings.Join(columnStrings, ", ")
 }
 
+// validateColumns validates the names and aliases of the columns, they are quoted when they are rendered
+func validateColumns(fields []*sql.Field) error {
+	for _, field := range fields {
+		if err := field.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseIdColumn validates and quotes the id column of the record
+func parseIdColumn(record sql.Record) (string, error) {
+	idColumn := record.IdColumn()
+	if err := sql.ValidateIdentifier(idColumn); err != nil {
+		return "", err
+	}
+	return quote(idColumn), nil
+}
+
+// quote quotes the identifier for mysql, eg. users.id is rendered as `users`.`id`
+func quote(identifier string) string {
+	return sql.MySQL.QuoteIdentifier(identifier)
+}
+
+// quoteAll quotes the identifiers and joins them into a comma separated list
+func quoteAll(identifiers []string) string {
+	quoted := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		quoted[i] = quote(identifier)
+	}
+	return strings.Join(quoted, ", ")
+}
+
 var (
 	aggregateFuncMap = map[sql.AggregateFunc]string{
 		sql.Count: "COUNT",
@@ -67,6 +100,9 @@ var (
 func parseField(field *sql.Field) string {
 	if field.Name != "" {
 		res := field.Name
+		if !field.Raw && field.Name != "*" {
+			res = quote(field.Name)
+		}
 		if field.Distinct {
 			res = "DISTINCT " + res
 		}
@@ -74,7 +110,7 @@ func parseField(field *sql.Field) string {
 			res = fmt.Sprintf("%s(%s)", aggregateFuncMap[field.Func], res)
 		}
 		if field.Alias != "" {
-			res += " AS " + field.Alias
+			res += " AS " + quote(field.Alias)
 		}
 		return res
 	}
@@ -87,7 +123,7 @@ func parseField(field *sql.Field) string {
 			res = "DISTINCT " + res
 		}
 		if field.Alias != "" {
-			res += " AS " + field.Alias
+			res += " AS " + quote(field.Alias)
 		}
 		return res
 	}
@@ -60,7 +60,7 @@ func TestParseInsertColumns(t *testing.T) {
 		{
 			name:   "user record with multiple columns",
 			record: &records.User{Id: 1, Name: "Alice", Email: "alice@example.com"},
-			want:   "name, email, password_hash, score, is_active, created_at, updated_at",
+			want:   "`name`, `email`, `password_hash`, `score`, `is_active`, `created_at`, `updated_at`",
 		},
 		{
 			name:   "id only record",
@@ -131,27 +131,27 @@ func TestParseColumns(t *testing.T) {
 		{
 			name:   "simple fields",
 			fields: []*sql.Field{sql.NewField("name"), sql.NewField("email")},
-			want:   "name, email",
+			want:   "`name`, `email`",
 		},
 		{
 			name:   "fields with aliases",
 			fields: []*sql.Field{sql.NewField("name").As("user_name"), sql.NewField("email").As("user_email")},
-			want:   "name AS user_name, email AS user_email",
+			want:   "`name` AS `user_name`, `email` AS `user_email`",
 		},
 		{
 			name:   "fields with aggregate functions",
 			fields: []*sql.Field{sql.CountOf(sql.NewField("id")), sql.SumOf(sql.NewField("amount"))},
-			want:   "COUNT(id), SUM(amount)",
+			want:   "COUNT(`id`), SUM(`amount`)",
 		},
 		{
 			name:   "fields with distinct",
 			fields: []*sql.Field{sql.DistinctOf(sql.NewField("category"))},
-			want:   "DISTINCT category",
+			want:   "DISTINCT `category`",
 		},
 		{
 			name:   "complex field with function and alias",
 			fields: []*sql.Field{sql.CountOf(sql.NewField("id")).As("total_count")},
-			want:   "COUNT(id) AS total_count",
+			want:   "COUNT(`id`) AS `total_count`",
 		},
 		{
 			name:   "empty fields",
@@ -184,57 +184,57 @@ func TestParseField(t *testing.T) {
 		{
 			name:  "simple field",
 			field: sql.NewField("name"),
-			want:  "name",
+			want:  "`name`",
 		},
 		{
 			name:  "field with alias",
 			field: sql.NewField("name").As("user_name"),
-			want:  "name AS user_name",
+			want:  "`name` AS `user_name`",
 		},
 		{
 			name:  "field with distinct",
 			field: sql.DistinctOf(sql.NewField("category")),
-			want:  "DISTINCT category",
+			want:  "DISTINCT `category`",
 		},
 		{
 			name:  "field with count function",
 			field: sql.CountOf(sql.NewField("id")),
-			want:  "COUNT(id)",
+			want:  "COUNT(`id`)",
 		},
 		{
 			name:  "field with sum function",
 			field: sql.SumOf(sql.NewField("amount")),
-			want:  "SUM(amount)",
+			want:  "SUM(`amount`)",
 		},
 		{
 			name:  "field with avg function",
 			field: sql.AvgOf(sql.NewField("score")),
-			want:  "AVG(score)",
+			want:  "AVG(`score`)",
 		},
 		{
 			name:  "field with min function",
 			field: sql.MinOf(sql.NewField("price")),
-			want:  "MIN(price)",
+			want:  "MIN(`price`)",
 		},
 		{
 			name:  "field with max function",
 			field: sql.MaxOf(sql.NewField("price")),
-			want:  "MAX(price)",
+			want:  "MAX(`price`)",
 		},
 		{
 			name:  "field with function and alias",
 			field: sql.CountOf(sql.NewField("id")).As("total"),
-			want:  "COUNT(id) AS total",
+			want:  "COUNT(`id`) AS `total`",
 		},
 		{
 			name:  "field with distinct and function",
 			field: sql.CountOf(sql.DistinctOf(sql.NewField("category"))),
-			want:  "COUNT(DISTINCT category)",
+			want:  "COUNT(DISTINCT `category`)",
 		},
 		{
 			name:  "nested field with function",
 			field: sql.CountOf(sql.NewField("id")),
-			want:  "COUNT(id)",
+			want:  "COUNT(`id`)",
 		},
 		{
 			name:  "empty field",
@@ -0,0 +1,30 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/gofreego/database/sql"
+)
+
+const (
+	// ER_LOCK_DEADLOCK, mysql reports serialization failures as deadlocks
+	lockDeadlock = 1213
+)
+
+// BeginTransaction begins a transaction, the operations of the returned handle run in it.
+func (c *MysqlDatabase) BeginTransaction(ctx context.Context, options ...sql.Options) (sql.Tx, error) {
+	return c.Executor.BeginTransaction(ctx, c, options...)
+}
+
+// RunInTransaction runs fn in a transaction, retried with Options.Retry on deadlocks.
+func (c *MysqlDatabase) RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx sql.Tx) error, options ...sql.Options) error {
+	return c.Executor.RunInTransaction(ctx, c, fn, isRetryable, options...)
+}
+
+// isRetryable reports whether the transaction failed on a deadlock
+func isRetryable(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == lockDeadlock
+}
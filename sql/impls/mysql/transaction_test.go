@@ -0,0 +1,30 @@
+package mysql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/gofreego/database/sql"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"deadlock", sql.NewDatabaseError(&mysql.MySQLError{Number: 1213}), true},
+		{"wrapped deadlock", fmt.Errorf("update: %w", &mysql.MySQLError{Number: 1213}), true},
+		{"duplicate entry", sql.NewDatabaseError(&mysql.MySQLError{Number: 1062}), false},
+		{"other error", errors.New("connection refused"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package postgresql
+
+import (
+	"context"
+	driver "database/sql"
+	"iter"
+	"strings"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/impls/common"
+	"github.com/gofreego/database/sql/internal"
+	"github.com/lib/pq"
+)
+
+// BulkLoad loads the records of the source into the columns of the table with COPY FROM STDIN.
+func (c *PostgresqlDatabase) BulkLoad(ctx context.Context, table *sql.Table, columns []string, source iter.Seq[sql.Record], options ...sql.Options) (int64, error) {
+	if err := common.ValidateBulkLoad(table, columns); err != nil {
+		return 0, err
+	}
+	opt, err := internal.GetOptions(ctx, options...)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := common.WithTimeout(ctx, opt, c.Timeouts().BulkLoad)
+	defer cancel()
+	return common.InBulkTransaction(ctx, c.db, opt, func(txn *driver.Tx) (int64, error) {
+		stmt, err := txn.PrepareContext(ctx, copyInQuery(table.Name, columns))
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		defer stmt.Close()
+		_, err = common.BulkRows(ctx, source, columns, opt, func(values []any) error {
+			_, err := stmt.ExecContext(ctx, values...)
+			return err
+		})
+		if err != nil {
+			return 0, err
+		}
+		// exec without values ends the copy, its result has the number of rows copied
+		res, err := stmt.ExecContext(ctx)
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return 0, internal.HandleContextError(ctx, err)
+		}
+		return rows, nil
+	})
+}
+
+// copyInQuery returns the COPY query for the table, the table can be qualified with its schema.
+func copyInQuery(table string, columns []string) string {
+	if schema, name, ok := strings.Cut(table, "."); ok {
+		return pq.CopyInSchema(schema, name, columns...)
+	}
+	return pq.CopyIn(table, columns...)
+}
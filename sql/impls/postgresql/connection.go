@@ -2,9 +2,10 @@ package postgresql
 
 import (
 	"context"
-	"database/sql"
+	driver "database/sql"
 	"fmt"
 
+	"github.com/gofreego/database/sql"
 	"github.com/gofreego/database/sql/impls/common"
 	"github.com/gofreego/database/sql/impls/postgresql/parser"
 	"github.com/gofreego/database/sql/internal"
@@ -17,17 +18,52 @@ type Config struct {
 	User     string `yaml:"User" json:"User"`
 	Password string `yaml:"Password" json:"Password"`
 	Database string `yaml:"Database" json:"Database"`
+	// Timeouts are the default timeouts of the operations in milliseconds, overridden by Options.Timeout
+	Timeouts sql.Timeouts `yaml:"Timeouts" json:"Timeouts"`
+	// Replicas are the read replicas of the database, Get and GetByID read from them with the routing
+	Replicas []Replica   `yaml:"Replicas" json:"Replicas"`
+	Routing  sql.Routing `yaml:"Routing" json:"Routing"`
+}
+
+// Replica is the connection of a read replica, the fields not set are the ones of the primary
+type Replica struct {
+	Host     string `yaml:"Host" json:"Host"`
+	Port     int    `yaml:"Port" json:"Port"`
+	User     string `yaml:"User" json:"User"`
+	Password string `yaml:"Password" json:"Password"`
+	Database string `yaml:"Database" json:"Database"`
+}
+
+// replica returns the config of the connection of the replica
+func (c *Config) replica(r Replica) *Config {
+	config := *c
+	if r.Host != "" {
+		config.Host = r.Host
+	}
+	if r.Port != 0 {
+		config.Port = r.Port
+	}
+	if r.User != "" {
+		config.User = r.User
+	}
+	if r.Password != "" {
+		config.Password = r.Password
+	}
+	if r.Database != "" {
+		config.Database = r.Database
+	}
+	return &config
 }
 
 type PostgresqlDatabase struct {
-	db                 *sql.DB
+	db                 *driver.DB
 	parser             common.Parser
 	preparedStatements internal.PreparedStatements
 	*common.Executor
 }
 
-func NewConnection(ctx context.Context, config *Config) (*sql.DB, error) {
-	db, err := sql.Open("postgres", fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable", config.Host, config.Port, config.User, config.Password, config.Database))
+func NewConnection(ctx context.Context, config *Config) (*driver.DB, error) {
+	db, err := driver.Open("postgres", fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable", config.Host, config.Port, config.User, config.Password, config.Database))
 	if err != nil {
 		return nil, err
 	}
@@ -35,13 +71,23 @@ func NewConnection(ctx context.Context, config *Config) (*sql.DB, error) {
 }
 
 func NewPostgresqlDatabase(ctx context.Context, config *Config) (*PostgresqlDatabase, error) {
+	if err := config.Routing.Validate(); err != nil {
+		return nil, err
+	}
 	db, err := NewConnection(ctx, config)
 	if err != nil {
 		return nil, err
 	}
+	replicas, err := common.OpenReplicas(len(config.Replicas), func(i int) (internal.DB, error) {
+		return NewConnection(ctx, config.replica(config.Replicas[i]))
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
 	parser := parser.NewParser()
 	return &PostgresqlDatabase{
-		Executor:           common.NewExecutor(db, parser),
+		Executor:           common.NewExecutor(db, parser).WithTimeouts(config.Timeouts).WithReplicas(replicas, config.Routing),
 		db:                 db,
 		parser:             parser,
 		preparedStatements: internal.NewPreparedStatements(),
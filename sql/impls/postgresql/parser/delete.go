@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/gofreego/database/sql"
@@ -9,22 +10,26 @@ import (
 const (
 	deleteByIDQuery     = "DELETE FROM %s WHERE %s = $1"
 	deleteQuery         = "DELETE FROM %s WHERE %s"
-	softDeleteByIDQuery = "UPDATE %s SET deleted = 1 WHERE %s = $1"
-	softDeleteQuery     = "UPDATE %s SET deleted = 1 WHERE %s"
+	softDeleteByIDQuery = "UPDATE %s SET \"deleted\" = 1 WHERE %s = $1"
+	softDeleteQuery     = "UPDATE %s SET \"deleted\" = 1 WHERE %s"
 )
 
 func (p *parser) ParseDeleteByIDQuery(record sql.Record) (string, error) {
 	var lastIndex int
-	tableName, err := parseTableName(record.Table(), &lastIndex)
+	tableName, _, err := parseTableName(record.Table(), &lastIndex)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(deleteByIDQuery, tableName, record.IdColumn()), nil
+	idColumn, err := parseIdColumn(record)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(deleteByIDQuery, tableName, idColumn), nil
 }
 
-func (p *parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []int, error) {
+func (p *parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []sql.Param, error) {
 	var lastIndex int
-	tableName, err := parseTableName(table, &lastIndex)
+	tableName, tableParams, err := parseTableName(table, &lastIndex)
 	if err != nil {
 		return "", nil, err
 	}
@@ -32,21 +37,25 @@ func (p *parser) ParseDeleteQuery(table *sql.Table, condition *sql.Condition) (s
 	if err != nil {
 		return "", nil, err
 	}
-	return fmt.Sprintf(deleteQuery, tableName, conditionStr), values, nil
+	return fmt.Sprintf(deleteQuery, tableName, conditionStr), append(tableParams, values...), nil
 }
 
 func (p *parser) ParseSoftDeleteByIDQuery(table *sql.Table, record sql.Record) (string, error) {
 	var lastIndex int
-	tableName, err := parseTableName(table, &lastIndex)
+	tableName, _, err := parseTableName(table, &lastIndex)
+	if err != nil {
+		return "", err
+	}
+	idColumn, err := parseIdColumn(record)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(softDeleteByIDQuery, tableName, record.IdColumn()), nil
+	return fmt.Sprintf(softDeleteByIDQuery, tableName, idColumn), nil
 }
 
-func (p *parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []int, error) {
+func (p *parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition) (string, []sql.Param, error) {
 	var lastIndex int
-	tableName, err := parseTableName(table, &lastIndex)
+	tableName, tableParams, err := parseTableName(table, &lastIndex)
 	if err != nil {
 		return "", nil, err
 	}
@@ -55,5 +64,24 @@ func (p *parser) ParseSoftDeleteQuery(table *sql.Table, condition *sql.Condition
 	if err != nil {
 		return "", nil, err
 	}
-	return fmt.Sprintf(softDeleteQuery, tableName, conditionStr), values, nil
+	return fmt.Sprintf(softDeleteQuery, tableName, conditionStr), append(tableParams, values...), nil
+}
+
+// ParseDeleteReturningQuery returns the delete query of the table of the records that returns the columns of the records of the deleted rows,
+// eg. DELETE FROM "users" WHERE "email" = $1 RETURNING "id", "name"
+func (p *parser) ParseDeleteReturningQuery(condition *sql.Condition, records sql.Records) (string, []sql.Param, error) {
+	if records == nil {
+		return "", nil, errors.New("records is nil")
+	}
+	if len(records.Columns()) == 0 {
+		return "", nil, errors.New("no columns to return")
+	}
+	if err := validateColumns(records.Columns()); err != nil {
+		return "", nil, err
+	}
+	query, params, err := p.ParseDeleteQuery(records.Table(), condition)
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf(returningQuery, query, parseColumns(records.Columns())), params, nil
 }
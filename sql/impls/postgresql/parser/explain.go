@@ -0,0 +1,60 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/gofreego/database/sql"
+)
+
+const (
+	explainQuery = "EXPLAIN (FORMAT JSON) %s"
+)
+
+func (p *parser) ParseExplainQuery(filter *sql.Filter, records sql.Records) (string, []sql.Param, error) {
+	query, values, err := p.ParseGetByFilterQuery(filter, records)
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf(explainQuery, query), values, nil
+}
+
+// planNode is a node of the postgresql json plan
+type planNode struct {
+	NodeType  string     `json:"Node Type"`
+	PlanRows  float64    `json:"Plan Rows"`
+	IndexName string     `json:"Index Name"`
+	Plans     []planNode `json:"Plans"`
+}
+
+// ParseQueryPlan summarises the output of EXPLAIN (FORMAT JSON).
+func (p *parser) ParseQueryPlan(plan string) (*sql.QueryPlan, error) {
+	var plans []struct {
+		Plan planNode `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &plans); err != nil {
+		return nil, sql.NewDatabaseError(err)
+	}
+	if len(plans) == 0 {
+		return nil, sql.NewInvalidQueryError("invalid query plan: plan is empty")
+	}
+	queryPlan := &sql.QueryPlan{
+		Raw:           plan,
+		EstimatedRows: plans[0].Plan.PlanRows,
+	}
+	summarisePlanNode(&plans[0].Plan, queryPlan)
+	return queryPlan, nil
+}
+
+func summarisePlanNode(node *planNode, queryPlan *sql.QueryPlan) {
+	if node.NodeType == "Seq Scan" {
+		queryPlan.SequentialScan = true
+	}
+	if node.IndexName != "" && !slices.Contains(queryPlan.Indexes, node.IndexName) {
+		queryPlan.Indexes = append(queryPlan.Indexes, node.IndexName)
+	}
+	for i := range node.Plans {
+		summarisePlanNode(&node.Plans[i], queryPlan)
+	}
+}
@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gofreego/database/sql"
+)
+
+func TestParseExplainQuery(t *testing.T) {
+	filter := &sql.Filter{
+		Condition: sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0)),
+	}
+	records := &mockRecords{table: sql.NewTable("users"), columns: []*sql.Field{sql.NewField("id")}}
+	got, got1, err := prsr.ParseExplainQuery(filter, records)
+	if err != nil {
+		t.Fatalf("ParseExplainQuery() error = %v", err)
+	}
+	if want := `EXPLAIN (FORMAT JSON) SELECT "id" FROM "users" WHERE "email" = $1`; got != want {
+		t.Errorf("ParseExplainQuery() got = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(got1, []sql.Param{{Index: 0}}) {
+		t.Errorf("ParseExplainQuery() got1 = %v, want %v", got1, []sql.Param{{Index: 0}})
+	}
+}
+
+func TestParseQueryPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    string
+		want    *sql.QueryPlan
+		wantErr bool
+	}{
+		{
+			name: "sequential scan",
+			plan: `[{"Plan": {"Node Type": "Seq Scan", "Relation Name": "users", "Plan Rows": 20, "Filter": "(name = 'x')"}}]`,
+			want: &sql.QueryPlan{
+				EstimatedRows:  20,
+				SequentialScan: true,
+			},
+		},
+		{
+			name: "index scan under sort",
+			plan: `[{"Plan": {"Node Type": "Sort", "Plan Rows": 3, "Plans": [{"Node Type": "Index Scan", "Index Name": "idx_users_email", "Plan Rows": 3}]}}]`,
+			want: &sql.QueryPlan{
+				EstimatedRows: 3,
+				Indexes:       []string{"idx_users_email"},
+			},
+		},
+		{
+			name: "join with bitmap and sequential scan",
+			plan: `[{"Plan": {"Node Type": "Hash Join", "Plan Rows": 7, "Plans": [{"Node Type": "Seq Scan", "Plan Rows": 100}, {"Node Type": "Hash", "Plans": [{"Node Type": "Bitmap Heap Scan", "Plans": [{"Node Type": "Bitmap Index Scan", "Index Name": "idx_users_active"}]}]}]}}]`,
+			want: &sql.QueryPlan{
+				EstimatedRows:  7,
+				SequentialScan: true,
+				Indexes:        []string{"idx_users_active"},
+			},
+		},
+		{
+			name:    "empty plan",
+			plan:    `[]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			plan:    `not json`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := prsr.ParseQueryPlan(tt.plan)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseQueryPlan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			tt.want.Raw = tt.plan
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseQueryPlan() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -18,7 +18,7 @@ func Test_parseFilter(t *testing.T) {
 		name    string
 		args    args
 		want    string
-		want1   []int
+		want1   []sql.Param
 		wantErr bool
 	}{
 		{
@@ -43,8 +43,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE name = $1",
-			want1:   []int{0},
+			want:    `WHERE "name" = $1`,
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -78,8 +78,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE (email = $1 AND age > 30 AND name LIKE $2) GROUP BY (city, country) ORDER BY age ASC LIMIT 10 OFFSET $3",
-			want1:   []int{0, 2, 1},
+			want:    `WHERE ("email" = $1 AND "age" > $2 AND "name" LIKE $3) GROUP BY ("city", "country") ORDER BY "age" ASC LIMIT 10 OFFSET $4`,
+			want1:   []sql.Param{{Index: 0}, {Value: 30, Fixed: true}, {Index: 2}, {Index: 1}},
 			wantErr: false,
 		},
 		{
@@ -94,8 +94,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE name LIKE 'john%'",
-			want1:   nil,
+			want:    `WHERE "name" LIKE $1`,
+			want1:   []sql.Param{{Value: "john%", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -110,8 +110,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE status IN ('active', 'pending')",
-			want1:   nil,
+			want:    `WHERE "status" IN ($1, $2)`,
+			want1:   []sql.Param{{Value: "active", Fixed: true}, {Value: "pending", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -126,8 +126,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE status IN ($1, $2, $3)",
-			want1:   []int{0},
+			want:    `WHERE "status" IN ($1, $2, $3)`,
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -141,7 +141,7 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE deleted_at IS NULL",
+			want:    `WHERE "deleted_at" IS NULL`,
 			want1:   nil,
 			wantErr: false,
 		},
@@ -156,7 +156,7 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE email IS NOT NULL",
+			want:    `WHERE "email" IS NOT NULL`,
 			want1:   nil,
 			wantErr: false,
 		},
@@ -172,8 +172,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE age BETWEEN 18 AND 65",
-			want1:   nil,
+			want:    `WHERE "age" BETWEEN $1 AND $2`,
+			want1:   []sql.Param{{Value: 18, Fixed: true}, {Value: 65, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -188,8 +188,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE (age BETWEEN $1 AND $2)",
-			want1:   []int{0},
+			want:    `WHERE ("age" BETWEEN $1 AND $2)`,
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -204,8 +204,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE created_at > '2023-01-01T00:00:00Z'",
-			want1:   nil,
+			want:    `WHERE "created_at" > $1`,
+			want1:   []sql.Param{{Value: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -230,8 +230,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE (status = 'active' OR status = 'pending')",
-			want1:   nil,
+			want:    `WHERE ("status" = $1 OR "status" = $2)`,
+			want1:   []sql.Param{{Value: "active", Fixed: true}, {Value: "pending", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -251,8 +251,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE NOT (status = 'deleted')",
-			want1:   nil,
+			want:    `WHERE NOT ("status" = $1)`,
+			want1:   []sql.Param{{Value: "deleted", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -267,7 +267,7 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE updated_at >= created_at",
+			want:    `WHERE "updated_at" >= "created_at"`,
 			want1:   nil,
 			wantErr: false,
 		},
@@ -281,7 +281,7 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE 1=1 ORDER BY created_at DESC, name ASC",
+			want:    `WHERE 1=1 ORDER BY "created_at" DESC, "name" ASC`,
 			want1:   nil,
 			wantErr: false,
 		},
@@ -318,7 +318,7 @@ func Test_parseFilter(t *testing.T) {
 				lastIndex: new(int),
 			},
 			want:    "WHERE 1=1 LIMIT $1",
-			want1:   []int{0},
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -330,7 +330,7 @@ func Test_parseFilter(t *testing.T) {
 				lastIndex: new(int),
 			},
 			want:    "WHERE 1=1 OFFSET $1",
-			want1:   []int{1},
+			want1:   []sql.Param{{Index: 1}},
 			wantErr: false,
 		},
 		{
@@ -345,8 +345,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE status NOT IN ('deleted', 'archived')",
-			want1:   nil,
+			want:    `WHERE "status" NOT IN ($1, $2)`,
+			want1:   []sql.Param{{Value: "deleted", Fixed: true}, {Value: "archived", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -361,8 +361,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE name NOT LIKE 'admin%'",
-			want1:   nil,
+			want:    `WHERE "name" NOT LIKE $1`,
+			want1:   []sql.Param{{Value: "admin%", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -377,8 +377,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE age NOT BETWEEN 18 AND 65",
-			want1:   nil,
+			want:    `WHERE "age" NOT BETWEEN $1 AND $2`,
+			want1:   []sql.Param{{Value: 18, Fixed: true}, {Value: 65, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -393,8 +393,8 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE email REGEXP '^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\\.[a-zA-Z]{2,}$'",
-			want1:   nil,
+			want:    `WHERE "email" REGEXP $1`,
+			want1:   []sql.Param{{Value: "^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\\.[a-zA-Z]{2,}$", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -491,10 +491,87 @@ func Test_parseFilter(t *testing.T) {
 				},
 				lastIndex: new(int),
 			},
-			want:    "WHERE (is_active = 1 AND (role = 'admin' OR role = 'user') AND NOT (email = 'test@example.com')) GROUP BY (department) ORDER BY created_at DESC, name ASC LIMIT 20 OFFSET 5",
+			want:    `WHERE ("is_active" = $1 AND ("role" = $2 OR "role" = $3) AND NOT ("email" = $4)) GROUP BY ("department") ORDER BY "created_at" DESC, "name" ASC LIMIT 20 OFFSET 5`,
+			want1:   []sql.Param{{Value: 1, Fixed: true}, {Value: "admin", Fixed: true}, {Value: "user", Fixed: true}, {Value: "test@example.com", Fixed: true}},
+			wantErr: false,
+		},
+		{
+			name: "test with fixed value containing a quote",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("name", sql.LIKE, sql.NewValue("O'Brien%")).
+						And(sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0))),
+				},
+				lastIndex: new(int),
+			},
+			want:    `WHERE ("name" LIKE $1 AND "email" = $2)`,
+			want1:   []sql.Param{{Value: "O'Brien%", Fixed: true}, {Index: 0}},
+			wantErr: false,
+		},
+		{
+			name: "test with raw literals",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("name", sql.EQ, sql.RawLiteral("O'Brien")).
+						And(sql.NewCondition("id", sql.IN, sql.RawLiteral([]any{1, 2}))),
+				},
+				lastIndex: new(int),
+			},
+			want:    `WHERE ("name" = 'O''Brien' AND "id" IN (1, 2))`,
 			want1:   nil,
 			wantErr: false,
 		},
+		{
+			name: "test with reserved word and mixed case identifiers",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("order", sql.EQ, sql.NewIndexedValue(0)),
+					GroupBy:   sql.NewGroupBy("user"),
+					Sort:      sql.NewSort().Add("CreatedAt", sql.Desc),
+				},
+				lastIndex: new(int),
+			},
+			want:    `WHERE "order" = $1 GROUP BY ("user") ORDER BY "CreatedAt" DESC`,
+			want1:   []sql.Param{{Index: 0}},
+			wantErr: false,
+		},
+		{
+			name: "test with raw condition and expression value",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewRawCondition("LOWER(email)", sql.EQ, sql.NewIndexedValue(0)).
+						And(sql.NewCondition("updated_at", sql.GT, sql.NewExpressionValue("created_at + 1"))),
+				},
+				lastIndex: new(int),
+			},
+			want:    `WHERE (LOWER(email) = $1 AND "updated_at" > created_at + 1)`,
+			want1:   []sql.Param{{Index: 0}},
+			wantErr: false,
+		},
+		{
+			name: "test with injected condition field",
+			args: args{
+				filter: &sql.Filter{
+					Condition: sql.NewCondition("name = name OR 1", sql.EQ, sql.NewIndexedValue(0)),
+				},
+				lastIndex: new(int),
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "test with injected group by field",
+			args: args{
+				filter: &sql.Filter{
+					GroupBy: sql.NewGroupBy("name; DROP TABLE users"),
+				},
+				lastIndex: new(int),
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -512,3 +589,65 @@ func Test_parseFilter(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseOrderBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		sort    *sql.Sort
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "nulls placement",
+			sort: sql.NewSort().Add("score", sql.Desc).NullsLast().Add("name", sql.Asc).NullsFirst(),
+			want: `ORDER BY "score" DESC NULLS LAST, "name" ASC NULLS FIRST`,
+		},
+		{
+			name: "aggregate field, alias is not rendered",
+			sort: sql.NewSort().AddAggregate(sql.CountOf(sql.NewField("id")).As("total"), sql.Desc),
+			want: `ORDER BY COUNT("id") DESC`,
+		},
+		{
+			name: "table qualified field",
+			sort: sql.NewSort().AddOrderBy(sql.NewASCOrder("u.name")),
+			want: `ORDER BY "u"."name" ASC`,
+		},
+		{
+			name: "expression",
+			sort: sql.NewSort().AddExpression("LENGTH(name)", sql.Asc),
+			want: "ORDER BY LENGTH(name) ASC",
+		},
+		{
+			name:    "invalid field name",
+			sort:    sql.NewSort().Add("name; DROP TABLE users", sql.Asc),
+			wantErr: true,
+		},
+		{
+			name:    "invalid aggregate field name",
+			sort:    sql.NewSort().AddAggregate(sql.MaxOf(sql.NewField("score)")), sql.Asc),
+			wantErr: true,
+		},
+		{
+			name:    "field and expression",
+			sort:    sql.NewSort().AddOrderBy(&sql.OrderBy{Field: "name", Expression: "LENGTH(name)"}),
+			wantErr: true,
+		},
+		{
+			name:    "invalid order",
+			sort:    sql.NewSort().Add("name", sql.Order(100)),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOrderBy(tt.sort)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseOrderBy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parseOrderBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,33 +7,61 @@ import (
 )
 
 const (
-	postgresqlGetByIDQuery = "SELECT %s FROM %s WHERE id = $1"
+	postgresqlGetByIDQuery = "SELECT %s FROM %s WHERE \"id\" = $1"
 	postgresqlGetQuery     = "SELECT %s FROM %s"
 )
 
-func (p *parser) ParseGetByIDQuery(record sql.Record) (string, error) {
+func (p *parser) ParseGetByIDQuery(record sql.Record, lock sql.LockMode) (string, error) {
 	var lastIndex int
-	tableName, err := parseTableName(record.Table(), &lastIndex)
+	tableName, _, err := parseTableName(record.Table(), &lastIndex)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(postgresqlGetByIDQuery, parseColumns(record.Columns()), tableName), nil
+	lockClause, err := parseLock(lock)
+	if err != nil {
+		return "", err
+	}
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", err
+	}
+	query := fmt.Sprintf(postgresqlGetByIDQuery, parseColumns(record.Columns()), tableName)
+	if lockClause != "" {
+		query += " " + lockClause
+	}
+	return query, nil
 }
 
-func (p *parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records) (string, []int, error) {
+func (p *parser) ParseGetByFilterQuery(filter *sql.Filter, records sql.Records) (string, []sql.Param, error) {
+	if err := filter.Validate(); err != nil {
+		return "", nil, err
+	}
 	var lastIndex int
-	tableName, err := parseTableName(records.Table(), &lastIndex)
+	tableName, tableParams, err := parseTableName(records.Table(), &lastIndex)
 	if err != nil {
 		return "", nil, err
 	}
+	if err := validateColumns(records.Columns()); err != nil {
+		return "", nil, err
+	}
+	columns := parseColumns(records.Columns())
+	if filter != nil && filter.Distinct {
+		columns = "DISTINCT " + columns
+	}
+	if filter != nil && filter.DistinctOn != nil {
+		columns = fmt.Sprintf("DISTINCT ON (%s) %s", quoteAll(filter.DistinctOn.Fields()), columns)
+		// postgresql requires the distinct on fields to lead the order by
+		distinctOnFilter := *filter
+		distinctOnFilter.Sort = filter.DistinctOn.Sort(filter.Sort)
+		filter = &distinctOnFilter
+	}
 	filterString, values, err := parseFilter(filter, &lastIndex)
 	if err != nil {
 		return "", nil, err
 	}
 
-	query := fmt.Sprintf(postgresqlGetQuery, parseColumns(records.Columns()), tableName)
+	query := fmt.Sprintf(postgresqlGetQuery, columns, tableName)
 	if filterString != "" {
 		query += " " + filterString
 	}
-	return query, values, nil
+	return query, append(tableParams, values...), nil
 }
@@ -8,7 +8,9 @@ import (
 )
 
 const (
-	insertQuery = "INSERT INTO %s (%s) VALUES %s"
+	insertQuery                    = "INSERT INTO %s (%s) VALUES %s"
+	postgresqlInsertReturningQuery = "%s RETURNING %s"
+	postgresqlInsertIgnoreQuery    = "%s ON CONFLICT%s DO NOTHING RETURNING %s"
 )
 
 func (p *parser) ParseInsertQuery(record ...sql.Record) (string, []any, error) {
@@ -16,11 +18,62 @@ func (p *parser) ParseInsertQuery(record ...sql.Record) (string, []any, error) {
 		return "", nil, errors.New("no record provided")
 	}
 	var lastIndex int
-	tableName, err := parseTableName(record[0].Table(), &lastIndex)
+	tableName, _, err := parseTableName(record[0].Table(), &lastIndex)
 	if err != nil {
 		return "", nil, err
 	}
+	if err := validateColumns(record[0].Columns()); err != nil {
+		return "", nil, err
+	}
 
 	placehodlers, values := getValuesPlaceHolders(&lastIndex, record...)
 	return fmt.Sprintf(insertQuery, tableName, parseInsertColumns(record[0]), placehodlers), values, nil
 }
+
+// ParseInsertReturningIDsQuery returns the insert query of the records that returns their IDs,
+// the rows of RETURNING are in the order of the VALUES list.
+// eg. INSERT INTO "users" ("name") VALUES ($1), ($2) RETURNING "id"
+func (p *parser) ParseInsertReturningIDsQuery(records ...sql.Record) (string, []any, error) {
+	query, values, err := p.ParseInsertQuery(records...)
+	if err != nil {
+		return "", nil, err
+	}
+	idColumn, err := parseIdColumn(records[0])
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf(postgresqlInsertReturningQuery, query, idColumn), values, nil
+}
+
+/*
+ParseInsertIgnoreQuery returns the insert query of the records that skips the records conflicting with existing rows,
+and returns the IDs of the inserted rows in the order of the records.
+The conflict is detected on the columns or the constraint of the conflict options if any, otherwise on every unique constraint,
+the other conflict options are not used.
+eg. INSERT INTO "users" ("name", "email") VALUES ($1, $2) ON CONFLICT ("email") DO NOTHING RETURNING "id"
+*/
+func (p *parser) ParseInsertIgnoreQuery(conflict *sql.Conflict, records ...sql.Record) (string, []any, error) {
+	if len(records) == 0 || records[0] == nil {
+		return "", nil, errors.New("no record provided")
+	}
+	if err := conflict.Validate(); err != nil {
+		return "", nil, err
+	}
+	query, values, err := p.ParseInsertQuery(records...)
+	if err != nil {
+		return "", nil, err
+	}
+	idColumn, err := parseIdColumn(records[0])
+	if err != nil {
+		return "", nil, err
+	}
+	target := ""
+	switch {
+	case conflict == nil:
+	case conflict.Constraint != "":
+		target = " ON CONSTRAINT " + quote(conflict.Constraint)
+	case len(conflict.Columns) > 0:
+		target = fmt.Sprintf(" (%s)", quoteAll(conflict.Columns))
+	}
+	return fmt.Sprintf(postgresqlInsertIgnoreQuery, query, target, idColumn), values, nil
+}
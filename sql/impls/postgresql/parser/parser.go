@@ -1,6 +1,9 @@
 package parser
 
-import "github.com/gofreego/database/sql/impls/common"
+import (
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/impls/common"
+)
 
 var (
 	prsr = &parser{}
@@ -12,3 +15,27 @@ type parser struct {
 func NewParser() common.Parser {
 	return &parser{}
 }
+
+func (p *parser) Dialect() sql.Dialect {
+	return sql.PostgreSQL
+}
+
+// MaxParams returns the maximum number of parameters in a query, the protocol sends the number of parameters as a 16 bit integer
+func (p *parser) MaxParams() int {
+	return 65535
+}
+
+// MaxRows returns the maximum number of rows in a VALUES list, 0 when unlimited, there is no limit on the rows of a VALUES list
+func (p *parser) MaxRows() int {
+	return 0
+}
+
+// InsertReturnsIDs returns true as the inserted and upserted IDs are returned with RETURNING
+func (p *parser) InsertReturnsIDs() bool {
+	return true
+}
+
+// ReturnsModifiedRows returns true as UPDATE and DELETE return the modified rows with RETURNING
+func (p *parser) ReturnsModifiedRows() bool {
+	return true
+}
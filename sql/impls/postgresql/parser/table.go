@@ -15,39 +15,46 @@ var (
 	}
 )
 
-func parseTableName(table *sql.Table, lastIndex *int) (string, error) {
+// parseTableName parses the table name and its joins, the params of the join conditions are returned in placeholder order
+func parseTableName(table *sql.Table, lastIndex *int) (string, []sql.Param, error) {
 	if table == nil {
-		return "", sql.NewInvalidQueryError("invalid table: table cannot be nil")
+		return "", nil, sql.NewInvalidQueryError("invalid table: table cannot be nil")
 	}
-	joinString, err := parseJoin(table.Join, lastIndex)
+	if err := table.Validate(); err != nil {
+		return "", nil, err
+	}
+	joinString, params, err := parseJoin(table.Join, lastIndex)
 	if err != nil {
-		return "", nil
+		return "", nil, err
 	}
-	return table.Name + getAlias(table.Alias) + joinString, nil
+	return quote(table.Name) + getAlias(table.Alias) + joinString, params, nil
 }
 
 func getAlias(alias string) string {
 	if alias == "" {
 		return ""
 	}
-	return " " + alias
+	return " " + quote(alias)
 }
 
-func parseJoin(join []sql.Join, lastIndex *int) (string, error) {
+// parseJoin parses the joins and returns the params of the join conditions in placeholder order
+func parseJoin(join []sql.Join, lastIndex *int) (string, []sql.Param, error) {
 	if len(join) == 0 {
-		return "", nil
+		return "", nil, nil
 	}
 	joins := ""
+	var params []sql.Param
 	for _, j := range join {
-		conditionString, _, err := parseCondition(j.On, lastIndex)
+		tableName, tableParams, err := parseTableName(j.Table, lastIndex)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
-		tableName, err := parseTableName(j.Table, lastIndex)
+		conditionString, conditionParams, err := parseCondition(j.On, lastIndex)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
+		params = append(append(params, tableParams...), conditionParams...)
 		joins += fmt.Sprintf(" %s %s ON %s", joinTypes[j.Type], tableName, conditionString)
 	}
-	return joins, nil
+	return joins, params, nil
 }
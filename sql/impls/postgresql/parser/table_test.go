@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/gofreego/database/sql"
@@ -8,21 +9,22 @@ import (
 
 func Test_parseTableName(t *testing.T) {
 	tests := []struct {
-		name    string
-		table   *sql.Table
-		want    string
-		wantErr bool
+		name       string
+		table      *sql.Table
+		want       string
+		wantParams []sql.Param
+		wantErr    bool
 	}{
 		{
 			name:    "simple table",
 			table:   sql.NewTable("users"),
-			want:    "users",
+			want:    `"users"`,
 			wantErr: false,
 		},
 		{
 			name:    "table with alias",
 			table:   func() *sql.Table { t := sql.NewTable("users"); t.Alias = "u"; return t }(),
-			want:    "users u",
+			want:    `"users" "u"`,
 			wantErr: false,
 		},
 		{
@@ -37,7 +39,7 @@ func Test_parseTableName(t *testing.T) {
 				})
 				return t1
 			}(),
-			want:    "users INNER JOIN orders ON users.id = orders.user_id",
+			want:    `"users" INNER JOIN "orders" ON "users"."id" = "orders"."user_id"`,
 			wantErr: false,
 		},
 		{
@@ -52,7 +54,7 @@ func Test_parseTableName(t *testing.T) {
 				})
 				return t1
 			}(),
-			want:    "users LEFT JOIN profiles ON users.id = profiles.user_id",
+			want:    `"users" LEFT JOIN "profiles" ON "users"."id" = "profiles"."user_id"`,
 			wantErr: false,
 		},
 		{
@@ -67,7 +69,7 @@ func Test_parseTableName(t *testing.T) {
 				})
 				return t1
 			}(),
-			want:    "users RIGHT JOIN logins ON users.id = logins.user_id",
+			want:    `"users" RIGHT JOIN "logins" ON "users"."id" = "logins"."user_id"`,
 			wantErr: false,
 		},
 		{
@@ -88,14 +90,14 @@ func Test_parseTableName(t *testing.T) {
 				})
 				return t1
 			}(),
-			want:    "users INNER JOIN orders ON users.id = orders.user_id LEFT JOIN products ON orders.product_id = products.id",
+			want:    `"users" INNER JOIN "orders" ON "users"."id" = "orders"."user_id" LEFT JOIN "products" ON "orders"."product_id" = "products"."id"`,
 			wantErr: false,
 		},
 		{
 			name:    "empty table name",
 			table:   sql.NewTable(""),
 			want:    "",
-			wantErr: false,
+			wantErr: true,
 		},
 		{
 			name:    "nil table",
@@ -103,6 +105,19 @@ func Test_parseTableName(t *testing.T) {
 			want:    "",
 			wantErr: true,
 		},
+		{
+			name: "table with fixed value in join condition",
+			table: func() *sql.Table {
+				t1 := sql.NewTable("users")
+				t2 := sql.NewTable("orders")
+				t1.WithInnerJoin(t2, sql.NewCondition("users.id", sql.EQ, sql.NewColumnValue("orders.user_id")).
+					And(sql.NewCondition("orders.status", sql.EQ, sql.NewValue("paid"))))
+				return t1
+			}(),
+			want:       `"users" INNER JOIN "orders" ON ("users"."id" = "orders"."user_id" AND "orders"."status" = $1)`,
+			wantParams: []sql.Param{{Value: "paid", Fixed: true}},
+			wantErr:    false,
+		},
 		{
 			name: "table with join error",
 			table: func() *sql.Table {
@@ -116,14 +131,14 @@ func Test_parseTableName(t *testing.T) {
 				return t1
 			}(),
 			want:    "",
-			wantErr: false,
+			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			lastIndex := 0
-			got, err := parseTableName(tt.table, &lastIndex)
+			got, got1, err := parseTableName(tt.table, &lastIndex)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("parseTableName() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -131,6 +146,9 @@ func Test_parseTableName(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("parseTableName() = %v, want %v", got, tt.want)
 			}
+			if !reflect.DeepEqual(got1, tt.wantParams) {
+				t.Errorf("parseTableName() got1 = %v, want %v", got1, tt.wantParams)
+			}
 		})
 	}
 }
@@ -149,12 +167,12 @@ func Test_getAlias(t *testing.T) {
 		{
 			name:  "simple alias",
 			alias: "u",
-			want:  " u",
+			want:  ` "u"`,
 		},
 		{
 			name:  "long alias",
 			alias: "user_table",
-			want:  " user_table",
+			want:  ` "user_table"`,
 		},
 	}
 
@@ -194,7 +212,7 @@ func Test_parseJoin(t *testing.T) {
 					},
 				},
 			},
-			want:    " INNER JOIN orders ON users.id = orders.user_id",
+			want:    ` INNER JOIN "orders" ON "users"."id" = "orders"."user_id"`,
 			wantErr: false,
 		},
 		{
@@ -210,7 +228,7 @@ func Test_parseJoin(t *testing.T) {
 					},
 				},
 			},
-			want:    " LEFT JOIN profiles ON users.id = profiles.user_id",
+			want:    ` LEFT JOIN "profiles" ON "users"."id" = "profiles"."user_id"`,
 			wantErr: false,
 		},
 		{
@@ -226,7 +244,7 @@ func Test_parseJoin(t *testing.T) {
 					},
 				},
 			},
-			want:    " RIGHT JOIN logins ON users.id = logins.user_id",
+			want:    ` RIGHT JOIN "logins" ON "users"."id" = "logins"."user_id"`,
 			wantErr: false,
 		},
 		{
@@ -251,7 +269,7 @@ func Test_parseJoin(t *testing.T) {
 					},
 				},
 			},
-			want:    " INNER JOIN orders ON users.id = orders.user_id LEFT JOIN products ON orders.product_id = products.id",
+			want:    ` INNER JOIN "orders" ON "users"."id" = "orders"."user_id" LEFT JOIN "products" ON "orders"."product_id" = "products"."id"`,
 			wantErr: false,
 		},
 		{
@@ -291,7 +309,7 @@ func Test_parseJoin(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			lastIndex := 0
-			got, err := parseJoin(tt.join, &lastIndex)
+			got, _, err := parseJoin(tt.join, &lastIndex)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("parseJoin() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -0,0 +1,63 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/gofreego/database/sql"
+)
+
+const (
+	// the driver begins the transaction with the isolation level and the access mode, deferrable is set by the first statement
+	deferrableQuery = "SET TRANSACTION DEFERRABLE"
+)
+
+/*
+ParseTransactionQuery returns the statement run at the start of a transaction to set the options the driver cannot begin it with.
+postgresql runs the snapshot isolation as REPEATABLE READ, Snapshot is rejected to not hide the difference with MSSQL.
+Deferrable is only set on serializable read-only transactions, it has no effect on the others.
+*/
+func (p *parser) ParseTransactionQuery(options *sql.TxOptions) (string, error) {
+	if options == nil {
+		return "", nil
+	}
+	if options.Isolation == sql.Snapshot {
+		return "", sql.NewInvalidQueryError("invalid transaction options: postgresql does not support the snapshot isolation level, use RepeatableRead")
+	}
+	if !options.Deferrable {
+		return "", nil
+	}
+	if options.Isolation != sql.Serializable || !options.ReadOnly {
+		return "", sql.NewInvalidQueryError("invalid transaction options: deferrable requires a serializable read-only transaction")
+	}
+	return deferrableQuery, nil
+}
+
+const (
+	savepointQuery           = "SAVEPOINT %s"
+	rollbackToSavepointQuery = "ROLLBACK TO SAVEPOINT %s"
+	releaseSavepointQuery    = "RELEASE SAVEPOINT %s"
+)
+
+// ParseSavepointQuery returns the query setting the savepoint
+func (p *parser) ParseSavepointQuery(name string) (string, error) {
+	if err := sql.ValidateSavepoint(name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(savepointQuery, quote(name)), nil
+}
+
+// ParseRollbackToSavepointQuery returns the query rolling the transaction back to the savepoint
+func (p *parser) ParseRollbackToSavepointQuery(name string) (string, error) {
+	if err := sql.ValidateSavepoint(name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(rollbackToSavepointQuery, quote(name)), nil
+}
+
+// ParseReleaseSavepointQuery returns the query releasing the savepoint
+func (p *parser) ParseReleaseSavepointQuery(name string) (string, error) {
+	if err := sql.ValidateSavepoint(name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(releaseSavepointQuery, quote(name)), nil
+}
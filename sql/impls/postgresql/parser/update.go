@@ -8,11 +8,12 @@ import (
 )
 
 const (
-	updateQuery = "UPDATE %s SET %s WHERE %s"
+	updateQuery    = "UPDATE %s SET %s WHERE %s"
+	returningQuery = "%s RETURNING %s"
 )
 
-func (p *parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condition *sql.Condition) (string, []int, error) {
-	var valueIndexes []int
+func (p *parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condition *sql.Condition) (string, []sql.Param, error) {
+	var valueIndexes []sql.Param
 	var updateClause string
 	var err error
 
@@ -20,10 +21,11 @@ func (p *parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condit
 		return "", nil, errors.New("updates is nil")
 	}
 	var lastIndex int
-	tableName, err := parseTableName(table, &lastIndex)
+	tableName, tableParams, err := parseTableName(table, &lastIndex)
 	if err != nil {
 		return "", nil, err
 	}
+	valueIndexes = append(valueIndexes, tableParams...)
 	updateClause, updateValueIndexes, err := parseUpdates(updates, &lastIndex)
 	if err != nil {
 		return "", nil, err
@@ -38,9 +40,9 @@ func (p *parser) ParseUpdateQuery(table *sql.Table, updates *sql.Updates, condit
 	return fmt.Sprintf(updateQuery, tableName, updateClause, conditionQuery), valueIndexes, nil
 }
 
-func parseUpdates(updates *sql.Updates, lastIndex *int) (string, []int, error) {
+func parseUpdates(updates *sql.Updates, lastIndex *int) (string, []sql.Param, error) {
 	var updateClause string
-	var valueIndexes []int
+	var valueIndexes []sql.Param
 
 	for i, update := range updates.Fields {
 		if update.Field == "" {
@@ -49,6 +51,10 @@ func parseUpdates(updates *sql.Updates, lastIndex *int) (string, []int, error) {
 		if update.Value == nil {
 			return "", nil, errors.New("value is nil for update field: " + update.Field)
 		}
+		if err := sql.ValidateIdentifier(update.Field); err != nil {
+			return "", nil, err
+		}
+		field := quote(update.Field)
 
 		// Add comma separator if not the first field
 		if i > 0 {
@@ -59,15 +65,46 @@ func parseUpdates(updates *sql.Updates, lastIndex *int) (string, []int, error) {
 			if update.Value.Value == nil || update.Value.Value == "" {
 				return "", nil, errors.New("value is empty for update field: " + update.Field + " and value type is a column")
 			}
-			updateClause += fmt.Sprintf("%s = %s", update.Field, update.Value.Value)
+			column, ok := update.Value.Value.(string)
+			if !ok {
+				return "", nil, errors.New("value is not a column name for update field: " + update.Field)
+			}
+			if err := sql.ValidateIdentifier(column); err != nil {
+				return "", nil, err
+			}
+			updateClause += fmt.Sprintf("%s = %s", field, quote(column))
+		} else if update.Value.IsExpression() {
+			updateClause += fmt.Sprintf("%s = %v", field, update.Value.Value)
 		} else if update.Value.Value != nil {
-			updateClause += fmt.Sprintf("%s = %s", update.Field, getValue(update.Value.Value))
+			// fixed values are bound as parameters
+			placeHolder, params := parseFixedValues(update.Value, lastIndex, update.Value.Value)
+			updateClause += fmt.Sprintf("%s = %s", field, placeHolder)
+			valueIndexes = append(valueIndexes, params...)
 		} else {
 			*lastIndex++
-			updateClause += fmt.Sprintf("%s = $%d", update.Field, *lastIndex)
-			valueIndexes = append(valueIndexes, update.Value.Index)
+			updateClause += fmt.Sprintf("%s = $%d", field, *lastIndex)
+			valueIndexes = append(valueIndexes, sql.Param{Index: update.Value.Index})
 		}
 	}
 
 	return updateClause, valueIndexes, nil
 }
+
+// ParseUpdateReturningQuery returns the update query of the table of the records that returns the columns of the records of the updated rows,
+// eg. UPDATE "users" SET "is_active" = $1 WHERE "email" = $2 RETURNING "id", "name"
+func (p *parser) ParseUpdateReturningQuery(updates *sql.Updates, condition *sql.Condition, records sql.Records) (string, []sql.Param, error) {
+	if records == nil {
+		return "", nil, errors.New("records is nil")
+	}
+	if len(records.Columns()) == 0 {
+		return "", nil, errors.New("no columns to return")
+	}
+	if err := validateColumns(records.Columns()); err != nil {
+		return "", nil, err
+	}
+	query, params, err := p.ParseUpdateQuery(records.Table(), updates, condition)
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf(returningQuery, query, parseColumns(records.Columns())), params, nil
+}
@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofreego/database/sql"
+)
+
+const (
+	postgresqlUpdateManyByIDQuery = "UPDATE %s SET %s FROM (VALUES %s) AS update_values (%s) WHERE %s.%s = update_values.%s"
+)
+
+/*
+ParseUpdateManyByIDQuery returns the query to update every record by its ID with its own values.
+The values of every record are a row of a VALUES list joined on the id column, the values are id first and then the record's values.
+Parameters in a VALUES list are typed as text, so the first row selects the columns of a NULL row of the table
+to type them as the table's columns, the row updates nothing as its id is NULL.
+eg. UPDATE "users" SET "name" = update_values."name" FROM (VALUES ((NULL::"users")."id", (NULL::"users")."name"), ($1, $2), ($3, $4))
+AS update_values ("id", "name") WHERE "users"."id" = update_values."id"
+*/
+func (p *parser) ParseUpdateManyByIDQuery(records ...sql.Record) (string, []any, error) {
+	if len(records) == 0 {
+		return "", nil, errors.New("no record provided")
+	}
+	record := records[0]
+	var lastIndex int
+	tableName, _, err := parseTableName(record.Table(), &lastIndex)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", nil, err
+	}
+	idColumn, err := parseIdColumn(record)
+	if err != nil {
+		return "", nil, err
+	}
+	target := quote(record.Table().Name)
+	if record.Table().Alias != "" {
+		target = quote(record.Table().Alias)
+	}
+	columns := []string{idColumn}
+	typedColumns := []string{fmt.Sprintf("(NULL::%s).%s", quote(record.Table().Name), idColumn)}
+	updates := []string{}
+	for _, column := range record.Columns() {
+		if column.Name == record.IdColumn() {
+			continue // the id column is the first column of the values
+		}
+		name := quote(column.Name)
+		columns = append(columns, name)
+		typedColumns = append(typedColumns, fmt.Sprintf("(NULL::%s).%s", quote(record.Table().Name), name))
+		updates = append(updates, fmt.Sprintf("%s = update_values.%s", name, name))
+	}
+	if len(updates) == 0 {
+		return "", nil, sql.NewInvalidQueryError("update many query:: no columns to update")
+	}
+	rows := make([]string, 0, len(records)+1)
+	rows = append(rows, fmt.Sprintf("(%s)", strings.Join(typedColumns, ", ")))
+	values := make([]any, 0, len(records)*len(columns))
+	for _, r := range records {
+		if len(r.Values()) != len(columns)-1 {
+			return "", nil, sql.NewInvalidQueryError("update many query:: every record should have %d values", len(columns)-1)
+		}
+		rows = append(rows, fmt.Sprintf("(%s)", getPlaceHolders(len(columns), &lastIndex)))
+		values = append(append(values, r.ID()), r.Values()...)
+	}
+	return fmt.Sprintf(postgresqlUpdateManyByIDQuery, tableName, strings.Join(updates, ", "), strings.Join(rows, ", "),
+		strings.Join(columns, ", "), target, idColumn, idColumn), values, nil
+}
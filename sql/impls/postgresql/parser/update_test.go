@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/tests/records"
 )
 
 func TestParseUpdateQuery(t *testing.T) {
@@ -17,7 +18,7 @@ func TestParseUpdateQuery(t *testing.T) {
 		name    string
 		args    args
 		want    string
-		want1   []int
+		want1   []sql.Param
 		wantErr bool
 	}{
 		{
@@ -29,8 +30,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("email", sql.NewValue("john@example.com")),
 				condition: sql.NewCondition("id", sql.EQ, sql.NewIndexedValue(0)),
 			},
-			want:    "UPDATE users SET name = 'John', email = 'john@example.com' WHERE id = $1",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "name" = $1, "email" = $2 WHERE "id" = $3`,
+			want1:   []sql.Param{{Value: "John", Fixed: true}, {Value: "john@example.com", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -42,8 +43,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("score", sql.NewValue(100.5)),
 				condition: sql.NewCondition("id", sql.EQ, sql.NewIndexedValue(0)),
 			},
-			want:    "UPDATE users SET age = 25, score = 100.5 WHERE id = $1",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "age" = $1, "score" = $2 WHERE "id" = $3`,
+			want1:   []sql.Param{{Value: 25, Fixed: true}, {Value: 100.5, Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -55,8 +56,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("is_verified", sql.NewValue(false)),
 				condition: sql.NewCondition("id", sql.EQ, sql.NewIndexedValue(0)),
 			},
-			want:    "UPDATE users SET is_active = true, is_verified = false WHERE id = $1",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "is_active" = $1, "is_verified" = $2 WHERE "id" = $3`,
+			want1:   []sql.Param{{Value: true, Fixed: true}, {Value: false, Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -67,8 +68,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("last_updated", sql.NewColumnValue("updated_at")),
 				condition: sql.NewCondition("id", sql.EQ, sql.NewIndexedValue(0)),
 			},
-			want:    "UPDATE users SET last_updated = updated_at WHERE id = $1",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "last_updated" = "updated_at" WHERE "id" = $1`,
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -80,8 +81,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("email", sql.NewIndexedValue(1)),
 				condition: sql.NewCondition("id", sql.EQ, sql.NewIndexedValue(2)),
 			},
-			want:    "UPDATE users SET name = $1, email = $2 WHERE id = $3",
-			want1:   []int{0, 1, 2},
+			want:    `UPDATE "users" SET "name" = $1, "email" = $2 WHERE "id" = $3`,
+			want1:   []sql.Param{{Index: 0}, {Index: 1}, {Index: 2}},
 			wantErr: false,
 		},
 		{
@@ -93,8 +94,8 @@ func TestParseUpdateQuery(t *testing.T) {
 				condition: sql.NewCondition("age", sql.GT, sql.NewIndexedValue(0)).
 					And(sql.NewCondition("is_active", sql.EQ, sql.NewValue(true))),
 			},
-			want:    "UPDATE users SET status = 'active' WHERE (age > $1 AND is_active = true)",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "status" = $1 WHERE ("age" > $2 AND "is_active" = $3)`,
+			want1:   []sql.Param{{Value: "active", Fixed: true}, {Index: 0}, {Value: true, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -105,8 +106,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("status", sql.NewValue("inactive")),
 				condition: sql.NewCondition("id", sql.IN, sql.NewIndexedValue(0).WithCount(3)),
 			},
-			want:    "UPDATE users SET status = 'inactive' WHERE id IN ($1, $2, $3)",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "status" = $1 WHERE "id" IN ($2, $3, $4)`,
+			want1:   []sql.Param{{Value: "inactive", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -117,8 +118,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("verified", sql.NewValue(true)),
 				condition: sql.NewCondition("email", sql.LIKE, sql.NewIndexedValue(0)),
 			},
-			want:    "UPDATE users SET verified = true WHERE email LIKE $1",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "verified" = $1 WHERE "email" LIKE $2`,
+			want1:   []sql.Param{{Value: true, Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -129,8 +130,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("name", sql.NewValue("John")),
 				condition: sql.NewCondition("u.id", sql.EQ, sql.NewIndexedValue(0)),
 			},
-			want:    "UPDATE users u SET name = 'John' WHERE u.id = $1",
-			want1:   []int{0},
+			want:    `UPDATE "users" "u" SET "name" = $1 WHERE "u"."id" = $2`,
+			want1:   []sql.Param{{Value: "John", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -150,8 +151,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("users.name", sql.NewValue("John")),
 				condition: sql.NewCondition("profiles.id", sql.EQ, sql.NewIndexedValue(0)),
 			},
-			want:    "UPDATE users INNER JOIN profiles ON users.id = profiles.user_id SET users.name = 'John' WHERE profiles.id = $1",
-			want1:   []int{0},
+			want:    `UPDATE "users" INNER JOIN "profiles" ON "users"."id" = "profiles"."user_id" SET "users"."name" = $1 WHERE "profiles"."id" = $2`,
+			want1:   []sql.Param{{Value: "John", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -172,8 +173,8 @@ func TestParseUpdateQuery(t *testing.T) {
 				updates:   sql.NewUpdates(),
 				condition: sql.NewCondition("id", sql.EQ, sql.NewIndexedValue(0)),
 			},
-			want:    "UPDATE users SET  WHERE id = $1",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET  WHERE "id" = $1`,
+			want1:   []sql.Param{{Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -249,8 +250,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("email", sql.NewValue("test@example.com")),
 				condition: sql.NewCondition("id", sql.EQ, sql.NewIndexedValue(0)),
 			},
-			want:    "UPDATE users SET name = 'O'Connor', email = 'test@example.com' WHERE id = $1",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "name" = $1, "email" = $2 WHERE "id" = $3`,
+			want1:   []sql.Param{{Value: "O'Connor", Fixed: true}, {Value: "test@example.com", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -261,8 +262,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("description", sql.NewValue("This is a very long description that contains many characters")),
 				condition: sql.NewCondition("id", sql.EQ, sql.NewIndexedValue(0)),
 			},
-			want:    "UPDATE users SET description = 'This is a very long description that contains many characters' WHERE id = $1",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "description" = $1 WHERE "id" = $2`,
+			want1:   []sql.Param{{Value: "This is a very long description that contains many characters", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -276,8 +277,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("score", sql.NewValue(95.5)),
 				condition: sql.NewCondition("id", sql.EQ, sql.NewIndexedValue(0)),
 			},
-			want:    "UPDATE users SET name = 'John', age = 25, is_active = true, score = 95.5 WHERE id = $1",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "name" = $1, "age" = $2, "is_active" = $3, "score" = $4 WHERE "id" = $5`,
+			want1:   []sql.Param{{Value: "John", Fixed: true}, {Value: 25, Fixed: true}, {Value: true, Fixed: true}, {Value: 95.5, Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -289,8 +290,8 @@ func TestParseUpdateQuery(t *testing.T) {
 				condition: sql.NewCondition("age", sql.LT, sql.NewIndexedValue(0)).
 					Or(sql.NewCondition("is_active", sql.EQ, sql.NewValue(false))),
 			},
-			want:    "UPDATE users SET status = 'inactive' WHERE (age < $1 OR is_active = false)",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "status" = $1 WHERE ("age" < $2 OR "is_active" = $3)`,
+			want1:   []sql.Param{{Value: "inactive", Fixed: true}, {Index: 0}, {Value: false, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -301,8 +302,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("category", sql.NewValue("premium")),
 				condition: sql.NewCondition("score", sql.BETWEEN, sql.NewIndexedValue(0).WithCount(2)),
 			},
-			want:    "UPDATE users SET category = 'premium' WHERE (score BETWEEN $1 AND $2)",
-			want1:   []int{0},
+			want:    `UPDATE "users" SET "category" = $1 WHERE ("score" BETWEEN $2 AND $3)`,
+			want1:   []sql.Param{{Value: "premium", Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 		{
@@ -313,8 +314,8 @@ func TestParseUpdateQuery(t *testing.T) {
 					Add("verified_at", sql.NewValue("2023-01-01")),
 				condition: sql.NewCondition("verified_at", sql.ISNULL, nil),
 			},
-			want:    "UPDATE users SET verified_at = '2023-01-01' WHERE verified_at IS NULL",
-			want1:   nil,
+			want:    `UPDATE "users" SET "verified_at" = $1 WHERE "verified_at" IS NULL`,
+			want1:   []sql.Param{{Value: "2023-01-01", Fixed: true}},
 			wantErr: false,
 		},
 	}
@@ -341,7 +342,7 @@ func Test_parseUpdates(t *testing.T) {
 		name    string
 		updates *sql.Updates
 		want    string
-		want1   []int
+		want1   []sql.Param
 		wantErr bool
 	}{
 		{
@@ -349,8 +350,8 @@ func Test_parseUpdates(t *testing.T) {
 			updates: sql.NewUpdates().
 				Add("name", sql.NewValue("John")).
 				Add("email", sql.NewValue("john@example.com")),
-			want:    "name = 'John', email = 'john@example.com'",
-			want1:   nil,
+			want:    `"name" = $1, "email" = $2`,
+			want1:   []sql.Param{{Value: "John", Fixed: true}, {Value: "john@example.com", Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -358,8 +359,8 @@ func Test_parseUpdates(t *testing.T) {
 			updates: sql.NewUpdates().
 				Add("age", sql.NewValue(25)).
 				Add("score", sql.NewValue(100.5)),
-			want:    "age = 25, score = 100.5",
-			want1:   nil,
+			want:    `"age" = $1, "score" = $2`,
+			want1:   []sql.Param{{Value: 25, Fixed: true}, {Value: 100.5, Fixed: true}},
 			wantErr: false,
 		},
 		{
@@ -367,15 +368,15 @@ func Test_parseUpdates(t *testing.T) {
 			updates: sql.NewUpdates().
 				Add("is_active", sql.NewValue(true)).
 				Add("is_verified", sql.NewValue(false)),
-			want:    "is_active = true, is_verified = false",
-			want1:   nil,
+			want:    `"is_active" = $1, "is_verified" = $2`,
+			want1:   []sql.Param{{Value: true, Fixed: true}, {Value: false, Fixed: true}},
 			wantErr: false,
 		},
 		{
 			name: "updates with column references",
 			updates: sql.NewUpdates().
 				Add("last_updated", sql.NewColumnValue("updated_at")),
-			want:    "last_updated = updated_at",
+			want:    `"last_updated" = "updated_at"`,
 			want1:   nil,
 			wantErr: false,
 		},
@@ -384,8 +385,8 @@ func Test_parseUpdates(t *testing.T) {
 			updates: sql.NewUpdates().
 				Add("name", sql.NewIndexedValue(0)).
 				Add("email", sql.NewIndexedValue(1)),
-			want:    "name = $1, email = $2",
-			want1:   []int{0, 1},
+			want:    `"name" = $1, "email" = $2`,
+			want1:   []sql.Param{{Index: 0}, {Index: 1}},
 			wantErr: false,
 		},
 		{
@@ -425,8 +426,8 @@ func Test_parseUpdates(t *testing.T) {
 				Add("age", sql.NewValue(25)).
 				Add("is_active", sql.NewValue(true)).
 				Add("score", sql.NewIndexedValue(0)),
-			want:    "name = 'John', age = 25, is_active = true, score = $1",
-			want1:   []int{0},
+			want:    `"name" = $1, "age" = $2, "is_active" = $3, "score" = $4`,
+			want1:   []sql.Param{{Value: "John", Fixed: true}, {Value: 25, Fixed: true}, {Value: true, Fixed: true}, {Index: 0}},
 			wantErr: false,
 		},
 	}
@@ -448,3 +449,55 @@ func Test_parseUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestParseUpdateReturningQuery(t *testing.T) {
+	type args struct {
+		updates   *sql.Updates
+		condition *sql.Condition
+		records   sql.Records
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		want1   []sql.Param
+		wantErr bool
+	}{
+		{
+			name: "test update returning with indexed condition",
+			args: args{
+				updates:   sql.NewUpdates().Add("is_active", sql.NewValue(0)),
+				condition: sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0)),
+				records:   &records.Users{},
+			},
+			want:    `UPDATE "users" SET "is_active" = $1 WHERE "email" = $2 RETURNING "id", "name", "email", "password_hash", "score", "is_active", "created_at", "updated_at"`,
+			want1:   []sql.Param{{Value: 0, Fixed: true}, {Index: 0}},
+			wantErr: false,
+		},
+		{
+			name: "test update returning with nil records",
+			args: args{
+				updates:   sql.NewUpdates().Add("is_active", sql.NewValue(0)),
+				condition: sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0)),
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, err := prsr.ParseUpdateReturningQuery(tt.args.updates, tt.args.condition, tt.args.records)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseUpdateReturningQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseUpdateReturningQuery() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("ParseUpdateReturningQuery() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
@@ -3,45 +3,301 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/gofreego/database/sql"
 )
 
 const (
-	upsertQuery = "INSERT INTO %s (%s) VALUES %s ON CONFLICT (id) DO UPDATE SET %s"
+	upsertQuery          = "INSERT INTO %s (%s) VALUES %s ON CONFLICT %s DO UPDATE SET %s"
+	upsertDoNothingQuery = "INSERT INTO %s (%s) VALUES %s ON CONFLICT %s DO NOTHING"
+	// xmax of a row is 0 until it is updated or deleted, so it is 0 only for the inserted row
+	upsertReturning = " RETURNING %s, (xmax = 0)"
+
+	// the records conflicting on the id are updated and inserted by the data-modifying statements of a WITH query
+	upsertByIDQuery = "WITH %s SELECT %s, inserted FROM (%s) AS upserted (%s, inserted, ordinal) ORDER BY ordinal"
+	// updatedStatement updates the row the record matches, insertedStatement inserts the record if no row matches it
+	updatedStatement  = "updated_%d AS (UPDATE %s SET %s WHERE %s RETURNING %s)"
+	insertedStatement = "inserted_%d AS (INSERT INTO %s (%s) SELECT %s WHERE NOT EXISTS (SELECT 1 FROM %s WHERE %s) RETURNING %s)"
+	updatedRow        = "SELECT %s, false, %d FROM updated_%d"
+	insertedRow       = "SELECT %s, true, %d FROM inserted_%d"
 )
 
-func (p *parser) ParseUpsertQuery(record sql.Record) (string, []any, error) {
-	if record == nil {
+/*
+ParseUpsertQuery returns the query inserting the records or updating the rows they conflict with.
+The conflict is detected on the columns or the constraint of the conflict options, the id column by default.
+On the id column, the query is built with parseUpsertByIDQuery, as the generated id of an inserted record never conflicts.
+The query returns the id of every row and whether it was inserted, in the order of the records,
+no row is returned for a record whose existing row is left unchanged.
+The records of a query cannot conflict with the same row, postgresql cannot update a row twice in a command.
+eg. INSERT INTO "users" ("name", "email") VALUES ($1, $2) ON CONFLICT ("email") DO UPDATE SET "name" = EXCLUDED."name"
+WHERE "users"."updated_at" < "excluded"."updated_at" RETURNING "id", (xmax = 0)
+*/
+func (p *parser) ParseUpsertQuery(conflict *sql.Conflict, records ...sql.Record) (string, []any, error) {
+	if len(records) == 0 || records[0] == nil {
 		return "", nil, errors.New("no record provided")
 	}
+	record := records[0]
+	if err := conflict.Validate(); err != nil {
+		return "", nil, err
+	}
 	var lastIndex int
-	tableName, err := parseTableName(record.Table(), &lastIndex)
+	tableName, _, err := parseTableName(record.Table(), &lastIndex)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := validateColumns(record.Columns()); err != nil {
+		return "", nil, err
+	}
+	idColumn, err := parseIdColumn(record)
 	if err != nil {
 		return "", nil, err
 	}
-	placeholders, values := getValuesPlaceHolders(&lastIndex, record)
-	if len(values) == 0 {
+	if len(record.Values()) == 0 {
 		return "", nil, errors.New("no values provided for upsert")
 	}
+	if matchesID(conflict, record) {
+		return parseUpsertByIDQuery(conflict, records)
+	}
+	insertColumns := parseInsertColumns(record)
+	placeholders, values := getValuesPlaceHolders(&lastIndex, records...)
+	target := fmt.Sprintf("(%s)", quoteAll(conflict.ConflictColumns(record)))
+	if conflict != nil && conflict.Constraint != "" {
+		target = "ON CONSTRAINT " + quote(conflict.Constraint)
+	}
+	if conflict != nil && conflict.DoNothing {
+		query := fmt.Sprintf(upsertDoNothingQuery, tableName, insertColumns, placeholders, target)
+		return query + fmt.Sprintf(upsertReturning, idColumn), values, nil
+	}
 
-	updates := parseUpsertUpdates(record)
+	updates, err := parseUpsertUpdates(record, conflict)
+	if err != nil {
+		return "", nil, err
+	}
 	if updates == "" {
 		return "", nil, errors.New("no columns to update")
 	}
+	query := fmt.Sprintf(upsertQuery, tableName, insertColumns, placeholders, target, updates)
+	if conflict != nil && conflict.Where != nil {
+		where, whereValues, err := parseUpsertCondition(conflict.Where, upsertTable(record.Table()), &lastIndex)
+		if err != nil {
+			return "", nil, err
+		}
+		query += " WHERE " + where
+		values = append(values, whereValues...)
+	}
+	return query + fmt.Sprintf(upsertReturning, idColumn), values, nil
+}
 
-	return fmt.Sprintf(upsertQuery, tableName, parseInsertColumns(record), placeholders, updates), values, nil
+// matchesID returns true if the conflict is detected on the id column of the record
+func matchesID(conflict *sql.Conflict, record sql.Record) bool {
+	if conflict != nil && conflict.Constraint != "" {
+		return false
+	}
+	return slices.Contains(conflict.ConflictColumns(record), record.IdColumn())
 }
 
-func parseUpsertUpdates(record sql.Record) string {
+/*
+parseUpsertByIDQuery returns the query upserting the records conflicting on the id column, as the MERGE of mssql.
+The record updates the row matching its conflict columns, and is inserted if no row matches them,
+a record without an id matches no row so it is inserted. The ids are never inserted, the rows take the ids generated by the table.
+The values are bound in the statements of every record, so their types are the types of the columns.
+eg. WITH updated_0 AS (UPDATE "users" SET "name" = $1 WHERE "users"."id" = $3 AND $3 <> 0 RETURNING "id"),
+inserted_0 AS (INSERT INTO "users" ("name", "email") SELECT $1, $2 WHERE NOT EXISTS (SELECT 1 FROM "users" WHERE "users"."id" = $3 AND $3 <> 0) RETURNING "id")
+SELECT "id", inserted FROM (SELECT "id", false, 0 FROM updated_0 UNION ALL SELECT "id", true, 0 FROM inserted_0) AS upserted ("id", inserted, ordinal) ORDER BY ordinal
+*/
+func parseUpsertByIDQuery(conflict *sql.Conflict, records []sql.Record) (string, []any, error) {
+	record := records[0]
+	table := record.Table()
+	if len(table.Join) > 0 {
+		return "", nil, sql.NewInvalidQueryError("invalid table: upsert table cannot have joins")
+	}
+	idColumn, err := parseIdColumn(record)
+	if err != nil {
+		return "", nil, err
+	}
+	insertColumns := []string{}
+	for _, column := range record.Columns() {
+		if column.Name != record.IdColumn() {
+			insertColumns = append(insertColumns, column.Name)
+		}
+	}
+	for _, column := range conflict.ConflictColumns(record) {
+		if column != record.IdColumn() && !slices.Contains(insertColumns, column) {
+			return "", nil, sql.NewInvalidQueryError("invalid conflict: conflict column %s is not a column of the record", column)
+		}
+	}
+	var updateColumns []string
+	if conflict == nil || !conflict.DoNothing {
+		if updateColumns, err = conflict.UpdateColumns(record); err != nil {
+			return "", nil, err
+		}
+		if len(updateColumns) == 0 {
+			return "", nil, errors.New("no columns to update")
+		}
+	}
+
+	// the placeholders of the columns of every record, the id is bound for every record so the query of a record does not depend on its id
+	var lastIndex int
+	placeholders := make([]map[string]string, len(records))
+	values := make([]any, 0, len(records)*(len(insertColumns)+1))
+	for i, r := range records {
+		if len(r.Values()) != len(insertColumns) {
+			return "", nil, sql.NewInvalidQueryError("upsert query:: every record should have %d values", len(insertColumns))
+		}
+		placeholders[i] = make(map[string]string, len(insertColumns)+1)
+		for _, column := range insertColumns {
+			lastIndex++
+			placeholders[i][column] = fmt.Sprintf("$%d", lastIndex)
+		}
+		lastIndex++
+		placeholders[i][r.IdColumn()] = fmt.Sprintf("$%d", lastIndex)
+		values = append(values, r.Values()...)
+		values = append(values, r.ID())
+	}
+	target := upsertTable(table)
+	where := ""
+	if len(updateColumns) > 0 && conflict != nil && conflict.Where != nil {
+		var whereValues []any
+		where, whereValues, err = parseUpsertCondition(conflict.Where, target, &lastIndex)
+		if err != nil {
+			return "", nil, err
+		}
+		values = append(values, whereValues...)
+	}
+
+	tableName := quote(table.Name) + getAlias(table.Alias)
+	statements := []string{}
+	rows := []string{}
+	for i := range records {
+		columns := placeholders[i]
+		on := []string{}
+		for _, column := range conflict.ConflictColumns(record) {
+			on = append(on, fmt.Sprintf("%s.%s = %s", target, quote(column), columns[column]))
+			// a record without an id conflicts with no row
+			if column == record.IdColumn() {
+				on = append(on, columns[column]+" <> 0")
+			}
+		}
+		match := strings.Join(on, " AND ")
+		if len(updateColumns) > 0 {
+			updates := make([]string, len(updateColumns))
+			for j, column := range updateColumns {
+				updates[j] = fmt.Sprintf("%s = %s", quote(column), columns[column])
+			}
+			condition := match
+			if where != "" {
+				bound, err := bindExcludedColumns(where, columns)
+				if err != nil {
+					return "", nil, err
+				}
+				condition += " AND " + bound
+			}
+			statements = append(statements, fmt.Sprintf(updatedStatement, i, tableName, strings.Join(updates, ", "), condition, idColumn))
+			rows = append(rows, fmt.Sprintf(updatedRow, idColumn, i, i))
+		}
+		selected := make([]string, len(insertColumns))
+		for j, column := range insertColumns {
+			selected[j] = columns[column]
+		}
+		statements = append(statements, fmt.Sprintf(insertedStatement, i, quote(table.Name), quoteAll(insertColumns), strings.Join(selected, ", "), tableName, match, idColumn))
+		rows = append(rows, fmt.Sprintf(insertedRow, idColumn, i, i))
+	}
+	return fmt.Sprintf(upsertByIDQuery, strings.Join(statements, ", "), idColumn, strings.Join(rows, " UNION ALL "), idColumn), values, nil
+}
+
+// bindExcludedColumns replaces the columns of the excluded row in the condition with the placeholders of the record
+func bindExcludedColumns(condition string, placeholders map[string]string) (string, error) {
+	for column, placeholder := range placeholders {
+		condition = strings.ReplaceAll(condition, quote(sql.ExcludedColumn(column)), placeholder)
+	}
+	if strings.Contains(condition, quote(sql.Excluded)+".") {
+		return "", sql.NewInvalidQueryError("invalid conflict: the condition reads a column of the excluded row that is not a value of the record")
+	}
+	return condition, nil
+}
+
+func parseUpsertUpdates(record sql.Record, conflict *sql.Conflict) (string, error) {
+	columns, err := conflict.UpdateColumns(record)
+	if err != nil {
+		return "", err
+	}
 	updates := []string{}
-	idColumn := record.IdColumn()
-	for _, col := range record.Columns() {
-		if col.Name == idColumn {
-			continue // Skip the ID column in the update
+	for _, column := range columns {
+		updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", quote(column), quote(column)))
+	}
+	return strings.Join(updates, ", "), nil
+}
+
+// upsertTable returns the quoted name the columns of the existing row are qualified with, the alias of the table if it has one
+func upsertTable(table *sql.Table) string {
+	if table.Alias != "" {
+		return quote(table.Alias)
+	}
+	return quote(table.Name)
+}
+
+/*
+parseUpsertCondition parses the condition of the update on conflict, the columns are qualified with the table
+as the unqualified columns are ambiguous with the columns of the excluded row.
+Only fixed values can be used in the condition, their values are returned in placeholder order.
+*/
+func parseUpsertCondition(condition *sql.Condition, table string, lastIndex *int) (string, []any, error) {
+	qualified, err := qualifyUpsertCondition(*condition, table)
+	if err != nil {
+		return "", nil, err
+	}
+	where, params, err := parseCondition(&qualified, lastIndex)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, param := range params {
+		if !param.Fixed {
+			return "", nil, sql.NewInvalidQueryError("invalid conflict: only fixed values can be used in the condition")
+		}
+	}
+	return where, sql.GetParamValues(params, nil), nil
+}
+
+// qualifyUpsertCondition returns a copy of the condition with its fields and column values rendered as qualified columns
+func qualifyUpsertCondition(condition sql.Condition, table string) (sql.Condition, error) {
+	if len(condition.Conditions) > 0 {
+		conditions := make([]sql.Condition, len(condition.Conditions))
+		for i, subCondition := range condition.Conditions {
+			qualified, err := qualifyUpsertCondition(subCondition, table)
+			if err != nil {
+				return sql.Condition{}, err
+			}
+			conditions[i] = qualified
+		}
+		condition.Conditions = conditions
+		return condition, nil
+	}
+	if condition.Field != "" && !condition.RawField {
+		column, err := qualifyUpsertColumn(condition.Field, table)
+		if err != nil {
+			return sql.Condition{}, err
+		}
+		condition.Field, condition.RawField = column, true
+	}
+	if condition.Value != nil && condition.Value.IsColumn() && condition.Value.IsStringValue() {
+		column, err := qualifyUpsertColumn(condition.Value.Value.(string), table)
+		if err != nil {
+			return sql.Condition{}, err
 		}
-		updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", col.Name, col.Name))
+		condition.Value = sql.NewExpressionValue(column)
+	}
+	return condition, nil
+}
+
+// qualifyUpsertColumn quotes the column, qualified with the table unless it is already qualified, eg. with sql.Excluded
+func qualifyUpsertColumn(column, table string) (string, error) {
+	if err := sql.ValidateIdentifier(column); err != nil {
+		return "", err
+	}
+	if strings.Contains(column, ".") {
+		return quote(column), nil
 	}
-	return strings.Join(updates, ", ")
+	return table + "." + quote(column), nil
 }
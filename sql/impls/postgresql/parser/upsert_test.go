@@ -10,7 +10,8 @@ import (
 
 func TestParseUpsertQuery(t *testing.T) {
 	type args struct {
-		record sql.Record
+		record   sql.Record
+		conflict *sql.Conflict
 	}
 	tests := []struct {
 		name    string
@@ -32,10 +33,59 @@ func TestParseUpsertQuery(t *testing.T) {
 					UpdatedAt:    456,
 				},
 			},
-			want:    "INSERT INTO users (name, email, password_hash, score, is_active, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, email = EXCLUDED.email, password_hash = EXCLUDED.password_hash, score = EXCLUDED.score, is_active = EXCLUDED.is_active, created_at = EXCLUDED.created_at, updated_at = EXCLUDED.updated_at",
-			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456)},
+			want:    `WITH updated_0 AS (UPDATE "users" SET "name" = $1, "email" = $2, "password_hash" = $3, "score" = $4, "is_active" = $5, "created_at" = $6, "updated_at" = $7 WHERE "users"."id" = $8 AND $8 <> 0 RETURNING "id"), inserted_0 AS (INSERT INTO "users" ("name", "email", "password_hash", "score", "is_active", "created_at", "updated_at") SELECT $1, $2, $3, $4, $5, $6, $7 WHERE NOT EXISTS (SELECT 1 FROM "users" WHERE "users"."id" = $8 AND $8 <> 0) RETURNING "id") SELECT "id", inserted FROM (SELECT "id", false, 0 FROM updated_0 UNION ALL SELECT "id", true, 0 FROM inserted_0) AS upserted ("id", inserted, ordinal) ORDER BY ordinal`,
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456), int64(1)},
+			wantErr: false,
+		},
+		{
+			name: "default id conflict without id",
+			args: args{
+				record: &records.User{
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+				},
+			},
+			// the id 0 matches no row, the record is inserted
+			want:    `WITH updated_0 AS (UPDATE "users" SET "name" = $1, "email" = $2, "password_hash" = $3, "score" = $4, "is_active" = $5, "created_at" = $6, "updated_at" = $7 WHERE "users"."id" = $8 AND $8 <> 0 RETURNING "id"), inserted_0 AS (INSERT INTO "users" ("name", "email", "password_hash", "score", "is_active", "created_at", "updated_at") SELECT $1, $2, $3, $4, $5, $6, $7 WHERE NOT EXISTS (SELECT 1 FROM "users" WHERE "users"."id" = $8 AND $8 <> 0) RETURNING "id") SELECT "id", inserted FROM (SELECT "id", false, 0 FROM updated_0 UNION ALL SELECT "id", true, 0 FROM inserted_0) AS upserted ("id", inserted, ordinal) ORDER BY ordinal`,
+			want1:   []any{"Test", "test@example.com", "hash", 0, 0, int64(0), int64(0), int64(0)},
+			wantErr: false,
+		},
+		{
+			name: "id conflict with condition",
+			args: args{
+				record: &records.User{
+					Id:        1,
+					Name:      "Test",
+					Email:     "test@example.com",
+					UpdatedAt: 456,
+				},
+				conflict: &sql.Conflict{Update: []string{"name", "updated_at"}, Where: sql.NewCondition("updated_at", sql.LT, sql.NewColumnValue(sql.ExcludedColumn("updated_at"))).And(sql.NewCondition("is_active", sql.EQ, sql.NewValue(1)))},
+			},
+			want:    `WITH updated_0 AS (UPDATE "users" SET "name" = $1, "updated_at" = $7 WHERE "users"."id" = $8 AND $8 <> 0 AND ("users"."updated_at" < $7 AND "users"."is_active" = $9) RETURNING "id"), inserted_0 AS (INSERT INTO "users" ("name", "email", "password_hash", "score", "is_active", "created_at", "updated_at") SELECT $1, $2, $3, $4, $5, $6, $7 WHERE NOT EXISTS (SELECT 1 FROM "users" WHERE "users"."id" = $8 AND $8 <> 0) RETURNING "id") SELECT "id", inserted FROM (SELECT "id", false, 0 FROM updated_0 UNION ALL SELECT "id", true, 0 FROM inserted_0) AS upserted ("id", inserted, ordinal) ORDER BY ordinal`,
+			want1:   []any{"Test", "test@example.com", "", 0, 0, int64(0), int64(456), int64(1), 1},
+			wantErr: false,
+		},
+		{
+			name: "id conflict do nothing",
+			args: args{
+				record:   &records.User{Id: 1, Name: "Test", Email: "test@example.com"},
+				conflict: &sql.Conflict{DoNothing: true},
+			},
+			want:    `WITH inserted_0 AS (INSERT INTO "users" ("name", "email", "password_hash", "score", "is_active", "created_at", "updated_at") SELECT $1, $2, $3, $4, $5, $6, $7 WHERE NOT EXISTS (SELECT 1 FROM "users" WHERE "users"."id" = $8 AND $8 <> 0) RETURNING "id") SELECT "id", inserted FROM (SELECT "id", true, 0 FROM inserted_0) AS upserted ("id", inserted, ordinal) ORDER BY ordinal`,
+			want1:   []any{"Test", "test@example.com", "", 0, 0, int64(0), int64(0), int64(1)},
 			wantErr: false,
 		},
+		{
+			name: "id conflict reading an excluded column that is not a value",
+			args: args{
+				record:   &records.User{Id: 1, Name: "Test"},
+				conflict: &sql.Conflict{Update: []string{"name"}, Where: sql.NewCondition("name", sql.NEQ, sql.NewColumnValue(sql.ExcludedColumn("nickname")))},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
 		{
 			name: "nil record",
 			args: args{
@@ -63,10 +113,154 @@ func TestParseUpsertQuery(t *testing.T) {
 			want1:   nil,
 			wantErr: true,
 		},
+		{
+			name: "conflict columns",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}},
+			},
+			want:    `INSERT INTO "users" ("name", "email", "password_hash", "score", "is_active", "created_at", "updated_at") VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT ("email") DO UPDATE SET "name" = EXCLUDED."name", "password_hash" = EXCLUDED."password_hash", "score" = EXCLUDED."score", "is_active" = EXCLUDED."is_active", "created_at" = EXCLUDED."created_at", "updated_at" = EXCLUDED."updated_at" RETURNING "id", (xmax = 0)`,
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456)},
+			wantErr: false,
+		},
+		{
+			name: "update columns with condition",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}, Update: []string{"updated_at", "name"}, Where: sql.NewCondition("updated_at", sql.LT, sql.NewColumnValue(sql.ExcludedColumn("updated_at"))).And(sql.NewCondition("is_active", sql.EQ, sql.NewValue(1)))},
+			},
+			want:    `INSERT INTO "users" ("name", "email", "password_hash", "score", "is_active", "created_at", "updated_at") VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT ("email") DO UPDATE SET "updated_at" = EXCLUDED."updated_at", "name" = EXCLUDED."name" WHERE ("users"."updated_at" < "excluded"."updated_at" AND "users"."is_active" = $8) RETURNING "id", (xmax = 0)`,
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456), 1},
+			wantErr: false,
+		},
+		{
+			name: "do nothing",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}, DoNothing: true},
+			},
+			want:    `INSERT INTO "users" ("name", "email", "password_hash", "score", "is_active", "created_at", "updated_at") VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT ("email") DO NOTHING RETURNING "id", (xmax = 0)`,
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456)},
+			wantErr: false,
+		},
+		{
+			name: "constraint",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Constraint: "users_email_key"},
+			},
+			want:    `INSERT INTO "users" ("name", "email", "password_hash", "score", "is_active", "created_at", "updated_at") VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT ON CONSTRAINT "users_email_key" DO UPDATE SET "name" = EXCLUDED."name", "email" = EXCLUDED."email", "password_hash" = EXCLUDED."password_hash", "score" = EXCLUDED."score", "is_active" = EXCLUDED."is_active", "created_at" = EXCLUDED."created_at", "updated_at" = EXCLUDED."updated_at" RETURNING "id", (xmax = 0)`,
+			want1:   []any{"Test", "test@example.com", "hash", 0, 1, int64(123), int64(456)},
+			wantErr: false,
+		},
+		{
+			name: "indexed value in condition",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Where: sql.NewCondition("score", sql.GT, sql.NewIndexedValue(0))},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "update column is not a value of the record",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Update: []string{"id"}},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "columns and constraint",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{Columns: []string{"email"}, Constraint: "users_email_key"},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "do nothing with update columns",
+			args: args{
+				record: &records.User{
+					Id:           1,
+					Name:         "Test",
+					Email:        "test@example.com",
+					PasswordHash: "hash",
+					IsActive:     1,
+					CreatedAt:    123,
+					UpdatedAt:    456,
+				},
+				conflict: &sql.Conflict{DoNothing: true, Update: []string{"name"}},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, got1, err := prsr.ParseUpsertQuery(tt.args.record)
+			got, got1, err := prsr.ParseUpsertQuery(tt.args.conflict, tt.args.record)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseUpsertQuery() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -80,3 +274,44 @@ func TestParseUpsertQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestParseUpsertQueryManyRecords(t *testing.T) {
+	users := []sql.Record{
+		&records.User{Name: "John", Email: "john@example.com", PasswordHash: "hash", UpdatedAt: 1},
+		&records.User{Name: "Jane", Email: "jane@example.com", PasswordHash: "hash", UpdatedAt: 2},
+	}
+	got, got1, err := prsr.ParseUpsertQuery(&sql.Conflict{Columns: []string{"email"}, Update: []string{"name"}}, users...)
+	if err != nil {
+		t.Fatalf("ParseUpsertQuery() error = %v", err)
+	}
+	want := `INSERT INTO "users" ("name", "email", "password_hash", "score", "is_active", "created_at", "updated_at") VALUES ($1, $2, $3, $4, $5, $6, $7), ($8, $9, $10, $11, $12, $13, $14) ON CONFLICT ("email") DO UPDATE SET "name" = EXCLUDED."name" RETURNING "id", (xmax = 0)`
+	if got != want {
+		t.Errorf("ParseUpsertQuery() got = %v, want %v", got, want)
+	}
+	want1 := []any{"John", "john@example.com", "hash", 0, 0, int64(0), int64(1), "Jane", "jane@example.com", "hash", 0, 0, int64(0), int64(2)}
+	if !reflect.DeepEqual(got1, want1) {
+		t.Errorf("ParseUpsertQuery() got1 = %v, want %v", got1, want1)
+	}
+}
+
+func TestParseUpsertQueryManyRecordsOnID(t *testing.T) {
+	users := []sql.Record{
+		&records.User{Id: 1, Name: "John", Email: "john@example.com", PasswordHash: "hash"},
+		&records.User{Name: "Jane", Email: "jane@example.com", PasswordHash: "hash"},
+	}
+	got, got1, err := prsr.ParseUpsertQuery(&sql.Conflict{Update: []string{"name"}}, users...)
+	if err != nil {
+		t.Fatalf("ParseUpsertQuery() error = %v", err)
+	}
+	// the ids are not inserted, the record without id matches no row and is inserted
+	want := `WITH updated_0 AS (UPDATE "users" SET "name" = $1 WHERE "users"."id" = $8 AND $8 <> 0 RETURNING "id"), inserted_0 AS (INSERT INTO "users" ("name", "email", "password_hash", "score", "is_active", "created_at", "updated_at") SELECT $1, $2, $3, $4, $5, $6, $7 WHERE NOT EXISTS (SELECT 1 FROM "users" WHERE "users"."id" = $8 AND $8 <> 0) RETURNING "id"), ` +
+		`updated_1 AS (UPDATE "users" SET "name" = $9 WHERE "users"."id" = $16 AND $16 <> 0 RETURNING "id"), inserted_1 AS (INSERT INTO "users" ("name", "email", "password_hash", "score", "is_active", "created_at", "updated_at") SELECT $9, $10, $11, $12, $13, $14, $15 WHERE NOT EXISTS (SELECT 1 FROM "users" WHERE "users"."id" = $16 AND $16 <> 0) RETURNING "id") ` +
+		`SELECT "id", inserted FROM (SELECT "id", false, 0 FROM updated_0 UNION ALL SELECT "id", true, 0 FROM inserted_0 UNION ALL SELECT "id", false, 1 FROM updated_1 UNION ALL SELECT "id", true, 1 FROM inserted_1) AS upserted ("id", inserted, ordinal) ORDER BY ordinal`
+	if got != want {
+		t.Errorf("ParseUpsertQuery() got = %v, want %v", got, want)
+	}
+	want1 := []any{"John", "john@example.com", "hash", 0, 0, int64(0), int64(0), int64(1), "Jane", "jane@example.com", "hash", 0, 0, int64(0), int64(0), int64(0)}
+	if !reflect.DeepEqual(got1, want1) {
+		t.Errorf("ParseUpsertQuery() got1 = %v, want %v", got1, want1)
+	}
+}
@@ -75,7 +75,7 @@ func TestParseInsertColumns(t *testing.T) {
 		{
 			name:   "user record with multiple columns",
 			record: &mockUserRecord{},
-			want:   "name, email, age",
+			want:   `"name", "email", "age"`,
 		},
 		{
 			name:   "id only record",
@@ -148,7 +148,7 @@ func TestParseColumns(t *testing.T) {
 				sql.NewField("email"),
 				sql.NewField("age"),
 			},
-			want: "name, email, age",
+			want: `"name", "email", "age"`,
 		},
 		{
 			name: "fields with aliases",
@@ -156,7 +156,7 @@ func TestParseColumns(t *testing.T) {
 				sql.NewField("name").As("user_name"),
 				sql.NewField("email").As("user_email"),
 			},
-			want: "name AS user_name, email AS user_email",
+			want: `"name" AS "user_name", "email" AS "user_email"`,
 		},
 		{
 			name: "fields with aggregate functions",
@@ -165,7 +165,7 @@ func TestParseColumns(t *testing.T) {
 				sql.AvgOf(sql.NewField("age")),
 				sql.SumOf(sql.NewField("salary")),
 			},
-			want: "COUNT(id), AVG(age), SUM(salary)",
+			want: `COUNT("id"), AVG("age"), SUM("salary")`,
 		},
 		{
 			name: "fields with distinct",
@@ -173,14 +173,14 @@ func TestParseColumns(t *testing.T) {
 				sql.DistinctOf(sql.NewField("name")),
 				sql.DistinctOf(sql.NewField("email")),
 			},
-			want: "DISTINCT name, DISTINCT email",
+			want: `DISTINCT "name", DISTINCT "email"`,
 		},
 		{
 			name: "complex field with function and alias",
 			fields: []*sql.Field{
 				sql.AvgOf(sql.NewField("age")).As("average_age"),
 			},
-			want: "AVG(age) AS average_age",
+			want: `AVG("age") AS "average_age"`,
 		},
 		{
 			name:   "empty fields",
@@ -213,57 +213,57 @@ func TestParseField(t *testing.T) {
 		{
 			name:  "simple field",
 			field: sql.NewField("name"),
-			want:  "name",
+			want:  `"name"`,
 		},
 		{
 			name:  "field with alias",
 			field: sql.NewField("name").As("user_name"),
-			want:  "name AS user_name",
+			want:  `"name" AS "user_name"`,
 		},
 		{
 			name:  "field with distinct",
 			field: sql.DistinctOf(sql.NewField("name")),
-			want:  "DISTINCT name",
+			want:  `DISTINCT "name"`,
 		},
 		{
 			name:  "field with count function",
 			field: sql.CountOf(sql.NewField("id")),
-			want:  "COUNT(id)",
+			want:  `COUNT("id")`,
 		},
 		{
 			name:  "field with sum function",
 			field: sql.SumOf(sql.NewField("salary")),
-			want:  "SUM(salary)",
+			want:  `SUM("salary")`,
 		},
 		{
 			name:  "field with avg function",
 			field: sql.AvgOf(sql.NewField("age")),
-			want:  "AVG(age)",
+			want:  `AVG("age")`,
 		},
 		{
 			name:  "field with min function",
 			field: sql.MinOf(sql.NewField("price")),
-			want:  "MIN(price)",
+			want:  `MIN("price")`,
 		},
 		{
 			name:  "field with max function",
 			field: sql.MaxOf(sql.NewField("price")),
-			want:  "MAX(price)",
+			want:  `MAX("price")`,
 		},
 		{
 			name:  "field with function and alias",
 			field: sql.AvgOf(sql.NewField("age")).As("average_age"),
-			want:  "AVG(age) AS average_age",
+			want:  `AVG("age") AS "average_age"`,
 		},
 		{
 			name:  "field with distinct and function",
 			field: sql.CountOf(sql.DistinctOf(sql.NewField("name"))),
-			want:  "COUNT(DISTINCT name)",
+			want:  `COUNT(DISTINCT "name")`,
 		},
 		{
 			name:  "nested field with function",
 			field: sql.CountOf(sql.NewField("subfield")),
-			want:  "COUNT(subfield)",
+			want:  `COUNT("subfield")`,
 		},
 		{
 			name:  "empty field",
@@ -278,32 +278,32 @@ func TestParseField(t *testing.T) {
 		{
 			name:  "nested field with distinct",
 			field: &sql.Field{Field: sql.NewField("subfield"), Distinct: true},
-			want:  "DISTINCT subfield",
+			want:  `DISTINCT "subfield"`,
 		},
 		{
 			name:  "nested field with function",
 			field: &sql.Field{Field: sql.NewField("subfield"), Func: sql.Count},
-			want:  "COUNT(subfield)",
+			want:  `COUNT("subfield")`,
 		},
 		{
 			name:  "nested field with alias",
 			field: &sql.Field{Field: sql.NewField("subfield"), Alias: "sub"},
-			want:  "subfield AS sub",
+			want:  `"subfield" AS "sub"`,
 		},
 		{
 			name:  "nested field with distinct and function",
 			field: &sql.Field{Field: sql.NewField("subfield"), Distinct: true, Func: sql.Count},
-			want:  "COUNT(DISTINCT subfield)",
+			want:  `COUNT(DISTINCT "subfield")`,
 		},
 		{
 			name:  "nested field with function and alias",
 			field: &sql.Field{Field: sql.NewField("subfield"), Func: sql.Avg, Alias: "average"},
-			want:  "AVG(subfield) AS average",
+			want:  `AVG("subfield") AS "average"`,
 		},
 		{
 			name:  "nested field with distinct, function and alias",
 			field: &sql.Field{Field: sql.NewField("subfield"), Distinct: true, Func: sql.Sum, Alias: "total"},
-			want:  "SUM(DISTINCT subfield) AS total",
+			want:  `SUM(DISTINCT "subfield") AS "total"`,
 		},
 		{
 			name:  "field with no name and no nested field",
@@ -0,0 +1,55 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofreego/database/mocks"
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/impls/common"
+	"github.com/gofreego/database/sql/impls/postgresql/parser"
+	"github.com/gofreego/database/sql/tests/records"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestRenderer_Insert checks that the rendered insert queries are the ones the executor sends
+func TestRenderer_Insert(t *testing.T) {
+	errSent := errors.New("sent")
+	tests := []struct {
+		name    string
+		options sql.Options
+		render  func(renderer sql.Renderer, record sql.Record) (*sql.Query, error)
+	}{
+		{
+			name: "insert",
+			render: func(renderer sql.Renderer, record sql.Record) (*sql.Query, error) {
+				return renderer.Insert(record)
+			},
+		},
+		{
+			name:    "insert ignore",
+			options: sql.Options{OnConflictDoNothing: true, Conflict: &sql.Conflict{Columns: []string{"email"}}},
+			render: func(renderer sql.Renderer, record sql.Record) (*sql.Query, error) {
+				return renderer.InsertIgnore([]sql.Record{record}, sql.Options{Conflict: &sql.Conflict{Columns: []string{"email"}}})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := mocks.NewDB(t)
+			executor := common.NewExecutor(db, parser.NewParser())
+			record := &records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123"}
+
+			query, err := tt.render(executor.ToSQL(), record)
+			if err != nil {
+				t.Fatalf("render error = %v", err)
+			}
+			// the executor fails once the query is sent, the mock fails the test if it sends another query
+			db.On("QueryContext", append([]any{mock.Anything, query.SQL}, query.Args...)...).Return(nil, errSent).Once()
+			if err := executor.Insert(context.Background(), record, tt.options); !errors.Is(err, errSent) {
+				t.Errorf("Insert() error = %v, want the rendered query sent", err)
+			}
+		})
+	}
+}
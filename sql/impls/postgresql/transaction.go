@@ -0,0 +1,33 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofreego/database/sql"
+	"github.com/lib/pq"
+)
+
+const (
+	serializationFailure = "40001"
+	deadlockDetected     = "40P01"
+)
+
+// BeginTransaction begins a transaction, the operations of the returned handle run in it.
+func (c *PostgresqlDatabase) BeginTransaction(ctx context.Context, options ...sql.Options) (sql.Tx, error) {
+	return c.Executor.BeginTransaction(ctx, c, options...)
+}
+
+// RunInTransaction runs fn in a transaction, retried with Options.Retry on serialization failures and deadlocks.
+func (c *PostgresqlDatabase) RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx sql.Tx) error, options ...sql.Options) error {
+	return c.Executor.RunInTransaction(ctx, c, fn, isRetryable, options...)
+}
+
+// isRetryable reports whether the transaction failed on a serialization failure or a deadlock
+func isRetryable(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return pqErr.Code == serializationFailure || pqErr.Code == deadlockDetected
+}
@@ -0,0 +1,29 @@
+package postgresql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofreego/database/sql"
+	"github.com/lib/pq"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"serialization failure", sql.NewDatabaseError(&pq.Error{Code: "40001"}), true},
+		{"deadlock", sql.NewDatabaseError(&pq.Error{Code: "40P01"}), true},
+		{"unique violation", sql.NewDatabaseError(&pq.Error{Code: "23505"}), false},
+		{"other error", errors.New("connection refused"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
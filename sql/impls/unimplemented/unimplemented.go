@@ -3,6 +3,7 @@ package unimplemented
 import (
 	"context"
 	"errors"
+	"iter"
 
 	"github.com/gofreego/database/sql"
 )
@@ -30,26 +31,54 @@ func (u *Unimplemented) GetByID(ctx context.Context, record sql.Record, options
 	return errors.New("GetByID method is not implemented")
 }
 
+func (u *Unimplemented) GetByIDs(ctx context.Context, ids []int64, records sql.Records, options ...sql.Options) ([]int64, error) {
+	return nil, errors.New("GetByIDs method is not implemented")
+}
+
 func (u *Unimplemented) Get(ctx context.Context, filter *sql.Filter, values []any, records sql.Records, options ...sql.Options) error {
 	return errors.New("GetByFilter method is not implemented")
 }
 
+func (u *Unimplemented) Explain(ctx context.Context, filter *sql.Filter, values []any, records sql.Records, options ...sql.Options) (*sql.QueryPlan, error) {
+	return nil, errors.New("Explain method is not implemented")
+}
+
 func (u *Unimplemented) UpdateByID(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
 	return false, errors.New("UpdateByID method is not implemented")
 }
 
+func (u *Unimplemented) UpdateManyByID(ctx context.Context, records []sql.Record, options ...sql.Options) ([]int64, error) {
+	return nil, errors.New("UpdateManyByID method is not implemented")
+}
+
 func (u *Unimplemented) Update(ctx context.Context, table *sql.Table, updates *sql.Updates, condition *sql.Condition, values []any, options ...sql.Options) (int64, error) {
 	return 0, errors.New("UpdateByCondition method is not implemented")
 }
 
+func (u *Unimplemented) UpdateReturning(ctx context.Context, updates *sql.Updates, condition *sql.Condition, values []any, records sql.Records, options ...sql.Options) (int64, error) {
+	return 0, errors.New("UpdateReturning method is not implemented")
+}
+
 func (u *Unimplemented) Upsert(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
 	return false, errors.New("Upsert method is not implemented")
 }
 
+func (u *Unimplemented) UpsertMany(ctx context.Context, records []sql.Record, options ...sql.Options) (int64, int64, error) {
+	return 0, 0, errors.New("UpsertMany method is not implemented")
+}
+
+func (u *Unimplemented) DeleteReturning(ctx context.Context, condition *sql.Condition, values []any, records sql.Records, options ...sql.Options) (int64, error) {
+	return 0, errors.New("DeleteReturning method is not implemented")
+}
+
 func (u *Unimplemented) DeleteByID(ctx context.Context, record sql.Record, options ...sql.Options) (bool, error) {
 	return false, errors.New("DeleteByID method is not implemented")
 }
 
+func (u *Unimplemented) DeleteByIDs(ctx context.Context, table *sql.Table, ids []int64, options ...sql.Options) (int64, error) {
+	return 0, errors.New("DeleteByIDs method is not implemented")
+}
+
 func (u *Unimplemented) Delete(ctx context.Context, table *sql.Table, condition *sql.Condition, values []any, options ...sql.Options) (int64, error) {
 	return 0, errors.New("DeleteByCondition method is not implemented")
 }
@@ -58,6 +87,22 @@ func (u *Unimplemented) SoftDeleteByID(ctx context.Context, record sql.Record, o
 	return false, errors.New("SoftDeleteByID method is not implemented")
 }
 
+func (u *Unimplemented) SoftDeleteByIDs(ctx context.Context, table *sql.Table, ids []int64, options ...sql.Options) (int64, error) {
+	return 0, errors.New("SoftDeleteByIDs method is not implemented")
+}
+
 func (u *Unimplemented) SoftDelete(ctx context.Context, table *sql.Table, condition *sql.Condition, values []any, options ...sql.Options) (int64, error) {
 	return 0, errors.New("SoftDelete method is not implemented")
 }
+
+func (u *Unimplemented) BulkLoad(ctx context.Context, table *sql.Table, columns []string, source iter.Seq[sql.Record], options ...sql.Options) (int64, error) {
+	return 0, errors.New("BulkLoad method is not implemented")
+}
+
+func (u *Unimplemented) RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx sql.Tx) error, options ...sql.Options) error {
+	return errors.New("RunInTransaction method is not implemented")
+}
+
+func (u *Unimplemented) ToSQL() sql.Renderer {
+	return nil
+}
@@ -17,13 +17,21 @@ type DB interface {
 	BeginTx(ctx context.Context, opts *driver.TxOptions) (*driver.Tx, error)
 }
 
+// driverTx is implemented by the transactions wrapping a driver transaction
+type driverTx interface {
+	DriverTx() *driver.Tx
+}
+
 func GetTransaction(tx any) (*driver.Tx, error) {
 	if tx == nil {
 		return nil, errors.New("transaction is nil")
 	}
-	txx, ok := tx.(*driver.Tx)
-	if !ok {
+	switch txx := tx.(type) {
+	case *driver.Tx:
+		return txx, nil
+	case driverTx:
+		return txx.DriverTx(), nil
+	default:
 		return nil, errors.New("invalid transaction object")
 	}
-	return txx, nil
 }
@@ -1,7 +1,9 @@
 package internal
 
 import (
+	"context"
 	db "database/sql"
+	"errors"
 
 	"github.com/gofreego/database/sql"
 )
@@ -13,5 +15,18 @@ func HandleError(err error) error {
 	if err == db.ErrNoRows {
 		return sql.ErrNoRecordFound
 	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return sql.NewTimeoutError(err)
+	}
 	return sql.NewDatabaseError(err)
 }
+
+// HandleContextError returns the error of an operation run with the context.
+// The error is a timeout if the deadline of the context is exceeded, the drivers do not all report it as context.DeadlineExceeded,
+// eg. postgresql reports the statement it canceled, as it does when the context is canceled by the caller.
+func HandleContextError(ctx context.Context, err error) error {
+	if err != nil && ctx != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) && err != db.ErrNoRows {
+		return sql.NewTimeoutError(err)
+	}
+	return HandleError(err)
+}
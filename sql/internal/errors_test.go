@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"context"
+	db "database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofreego/database/sql"
+)
+
+// queryCanceled is the SQLSTATE of a statement canceled by postgresql, when the context is done whatever the reason
+const queryCanceled = "57014"
+
+// stateError is a driver error with a SQLSTATE, as the errors of postgresql
+type stateError string
+
+func (e stateError) Error() string {
+	return "pq: " + string(e)
+}
+
+func (e stateError) SQLState() string {
+	return string(e)
+}
+
+func TestHandleError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode sql.ErrorCode
+	}{
+		{"no rows", db.ErrNoRows, sql.ErrCodeNoRecordFound},
+		{"deadline exceeded", context.DeadlineExceeded, sql.ErrCodeTimeout},
+		{"wrapped deadline exceeded", fmt.Errorf("read: %w", context.DeadlineExceeded), sql.ErrCodeTimeout},
+		{"statement canceled", stateError(queryCanceled), sql.ErrUnknownDatabaseError},
+		{"other state", stateError("40001"), sql.ErrUnknownDatabaseError},
+		{"canceled", context.Canceled, sql.ErrUnknownDatabaseError},
+		{"database error", errors.New("connection refused"), sql.ErrUnknownDatabaseError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sqlErr *sql.Error
+			if err := HandleError(tt.err); !errors.As(err, &sqlErr) || sqlErr.Code() != tt.wantCode {
+				t.Errorf("HandleError() = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+	if err := HandleError(nil); err != nil {
+		t.Errorf("HandleError(nil) = %v, want nil", err)
+	}
+}
+
+func TestHandleContextError(t *testing.T) {
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		err      error
+		wantCode sql.ErrorCode
+	}{
+		{"statement canceled on deadline", expired, stateError(queryCanceled), sql.ErrCodeTimeout},
+		{"statement canceled by the caller", canceled, stateError(queryCanceled), sql.ErrUnknownDatabaseError},
+		{"context canceled by the caller", canceled, context.Canceled, sql.ErrUnknownDatabaseError},
+		{"deadline exceeded", context.Background(), context.DeadlineExceeded, sql.ErrCodeTimeout},
+		{"no rows", expired, db.ErrNoRows, sql.ErrCodeNoRecordFound},
+		{"database error", context.Background(), errors.New("connection refused"), sql.ErrUnknownDatabaseError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sqlErr *sql.Error
+			if err := HandleContextError(tt.ctx, tt.err); !errors.As(err, &sqlErr) || sqlErr.Code() != tt.wantCode {
+				t.Errorf("HandleContextError() = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+	if err := HandleContextError(expired, nil); err != nil {
+		t.Errorf("HandleContextError(nil) = %v, want nil", err)
+	}
+}
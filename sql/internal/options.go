@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/gofreego/database/sql"
+)
+
+// GetOptions returns the first option if available with the transaction of the context, see sql.WithTransaction,
+// unless Options.Transaction is set. Returns an error if Options.Transaction is another transaction than the one of the context.
+func GetOptions(ctx context.Context, options ...sql.Options) (sql.Options, error) {
+	opt := sql.GetOptions(options...)
+	tx := sql.TransactionFromContext(ctx)
+	if tx == nil {
+		return opt, nil
+	}
+	if opt.Transaction == nil {
+		opt.Transaction = tx
+		return opt, nil
+	}
+	if !sameTransaction(opt.Transaction, tx) {
+		return sql.Options{}, sql.NewInvalidQueryError("conflicting transactions: Options.Transaction is not the transaction of the context")
+	}
+	return opt, nil
+}
+
+// sameTransaction returns true if both transactions run on the same driver transaction,
+// a transaction handle and its driver transaction are the same transaction.
+func sameTransaction(a, b any) bool {
+	if a == b {
+		return true
+	}
+	txA, err := GetTransaction(a)
+	if err != nil {
+		return false
+	}
+	txB, err := GetTransaction(b)
+	if err != nil {
+		return false
+	}
+	return txA == txB
+}
@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"context"
+	driver "database/sql"
+	"testing"
+
+	"github.com/gofreego/database/mocks"
+	"github.com/gofreego/database/sql"
+)
+
+// handle wraps a driver transaction as the transactions of the executor do
+type handle struct {
+	tx *driver.Tx
+}
+
+func (h *handle) DriverTx() *driver.Tx {
+	return h.tx
+}
+
+func TestGetOptions(t *testing.T) {
+	tx := mocks.NewTransaction(t)
+	other := mocks.NewTransaction(t)
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		options []sql.Options
+		want    sql.Transaction
+		wantErr bool
+	}{
+		{"no transaction", context.Background(), nil, nil, false},
+		{"transaction of the options", context.Background(), []sql.Options{{Transaction: tx}}, tx, false},
+		{"transaction of the context", sql.WithTransaction(context.Background(), tx), []sql.Options{{BatchSize: 10}}, tx, false},
+		{"same transaction in both", sql.WithTransaction(context.Background(), tx), []sql.Options{{Transaction: tx}}, tx, false},
+		{"conflicting transactions", sql.WithTransaction(context.Background(), tx), []sql.Options{{Transaction: other}}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetOptions(tt.ctx, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetOptions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got.Transaction != tt.want {
+				t.Errorf("GetOptions() transaction = %v, want %v", got.Transaction, tt.want)
+			}
+		})
+	}
+}
+
+func Test_sameTransaction(t *testing.T) {
+	tx := &driver.Tx{}
+	tests := []struct {
+		name string
+		a, b any
+		want bool
+	}{
+		{"same driver transaction", tx, tx, true},
+		{"handle of the driver transaction", &handle{tx: tx}, tx, true},
+		{"handles of the same driver transaction", &handle{tx: tx}, &handle{tx: tx}, true},
+		{"other driver transaction", &handle{tx: tx}, &driver.Tx{}, false},
+		{"unknown transaction", mocks.NewTransaction(t), tx, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameTransaction(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameTransaction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,21 +1,22 @@
 package internal
 
 import (
-	"database/sql"
-	"slices"
+	driver "database/sql"
+
+	"github.com/gofreego/database/sql"
 )
 
 // This will be used internally
 type PreparedStatement struct {
-	Statement *sql.Stmt
+	Statement *driver.Stmt
 	// This is getting used in case of batch insert, update, delete
 	noOfRecords        int
-	valueIndexes       []int
+	params             []sql.Param
 	noOfValuesRequired int
 	query              string
 }
 
-func NewPreparedStatement(s *sql.Stmt) *PreparedStatement {
+func NewPreparedStatement(s *driver.Stmt) *PreparedStatement {
 	return &PreparedStatement{
 		Statement: s,
 	}
@@ -35,18 +36,23 @@ func (s *PreparedStatement) GetNoOfRecords() int {
 	return s.noOfRecords
 }
 
-func (s *PreparedStatement) GetStatement() *sql.Stmt {
+func (s *PreparedStatement) GetStatement() *driver.Stmt {
 	return s.Statement
 }
 
-func (s *PreparedStatement) WithValueIndexes(valueIndexes []int) *PreparedStatement {
-	s.valueIndexes = valueIndexes
-	s.noOfValuesRequired = slices.Max(valueIndexes) + 1
+func (s *PreparedStatement) WithParams(params []sql.Param) *PreparedStatement {
+	s.params = params
+	s.noOfValuesRequired = 0
+	for _, param := range params {
+		if !param.Fixed && param.Index+1 > s.noOfValuesRequired {
+			s.noOfValuesRequired = param.Index + 1
+		}
+	}
 	return s
 }
 
-func (s *PreparedStatement) GetValueIndexes() []int {
-	return s.valueIndexes
+func (s *PreparedStatement) GetParams() []sql.Param {
+	return s.params
 }
 
 func (s *PreparedStatement) GetNoOfValuesRequired() int {
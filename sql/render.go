@@ -0,0 +1,71 @@
+package sql
+
+// Query is a rendered query along with the ordered arguments it will be executed with.
+type Query struct {
+	SQL  string // The query string, with dialect specific placeholders
+	Args []any  // The arguments in placeholder order
+}
+
+// Renderer renders the queries generated for database operations without executing them.
+// The rendered query and arguments are exactly what the executor would send to the database.
+// It is useful for inspecting generated SQL while debugging, in code review and in snapshot tests.
+type Renderer interface {
+	// Dialect returns the dialect the queries are rendered for.
+	Dialect() Dialect
+
+	// Insert renders the insert query for one or more records,
+	// with the RETURNING or OUTPUT clause of the inserted IDs on PostgreSQL and MSSQL.
+	Insert(records ...Record) (*Query, error)
+
+	// InsertIgnore renders the insert query for the records that skips the records conflicting with existing rows,
+	// as Options.OnConflictDoNothing. Options.Conflict is rendered, the other options do not change the query.
+	InsertIgnore(records []Record, options ...Options) (*Query, error)
+
+	// Upsert renders the upsert query for the record.
+	// Options.Conflict is rendered, the other options do not change the query.
+	Upsert(record Record, options ...Options) (*Query, error)
+
+	// UpsertMany renders the upsert query for the records as a single batch.
+	// Options.Conflict is rendered, the other options do not change the query.
+	UpsertMany(records []Record, options ...Options) (*Query, error)
+
+	// GetByID renders the query to get a record by its ID.
+	// Options.Lock is rendered, the other options do not change the query.
+	GetByID(record Record, options ...Options) (*Query, error)
+
+	// Get renders the query to get records by the filter.
+	// The values slice is resolved against the filter the same way as in Database.Get.
+	Get(filter *Filter, values []any, records Records) (*Query, error)
+
+	// UpdateByID renders the query to update a record by its ID.
+	UpdateByID(record Record) (*Query, error)
+
+	// UpdateManyByID renders the query to update the records by their IDs as a single batch.
+	UpdateManyByID(records ...Record) (*Query, error)
+
+	// Update renders the query to update records by the condition.
+	Update(table *Table, updates *Updates, condition *Condition, values []any) (*Query, error)
+
+	// UpdateReturning renders the query to update records by the condition that returns the updated rows.
+	// MySQL cannot return the updated rows in the query, an error is returned there.
+	UpdateReturning(updates *Updates, condition *Condition, values []any, records Records) (*Query, error)
+
+	// SoftDeleteByID renders the query to soft delete a record by its ID.
+	SoftDeleteByID(record Record) (*Query, error)
+
+	// SoftDelete renders the query to soft delete records by the condition.
+	SoftDelete(table *Table, condition *Condition, values []any) (*Query, error)
+
+	// DeleteByID renders the query to delete a record by its ID.
+	DeleteByID(record Record) (*Query, error)
+
+	// Delete renders the query to delete records by the condition.
+	Delete(table *Table, condition *Condition, values []any) (*Query, error)
+
+	// DeleteReturning renders the query to delete records by the condition that returns the deleted rows.
+	// MySQL cannot return the deleted rows in the query, an error is returned there.
+	DeleteReturning(condition *Condition, values []any, records Records) (*Query, error)
+
+	// RunSP renders the query to run the stored procedure.
+	RunSP(spName string, values []any) (*Query, error)
+}
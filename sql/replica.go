@@ -0,0 +1,34 @@
+package sql
+
+// Balancing is how the reads are balanced across the healthy replicas, see Routing.
+type Balancing string
+
+const (
+	RoundRobin       Balancing = "RoundRobin"       // The replicas take the reads in turn, the default
+	LeastConnections Balancing = "LeastConnections" // The replica running the fewest reads takes the read
+)
+
+// Routing configures how Get and GetByID are routed to the read replicas set in the config of the dialect.
+// Writes, transactions and the reads with Options.UsePrimaryDB run on the primary,
+// and so do the reads when no replica is healthy.
+type Routing struct {
+	// Balancing is how the reads are balanced across the healthy replicas, RoundRobin if not set.
+	Balancing Balancing `yaml:"Balancing" json:"Balancing"`
+	// HealthCheckInterval is the interval between the pings of the replicas in milliseconds, 5000 if not set.
+	// A replica is taken out of rotation when its ping fails and put back once it succeeds.
+	HealthCheckInterval int64 `yaml:"HealthCheckInterval" json:"HealthCheckInterval"`
+}
+
+// Validate checks if the balancing is known.
+// Returns ErrInvalidConfig if the routing is invalid.
+func (r Routing) Validate() error {
+	switch r.Balancing {
+	case "", RoundRobin, LeastConnections:
+	default:
+		return ErrInvalidConfig
+	}
+	if r.HealthCheckInterval < 0 {
+		return ErrInvalidConfig
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package sql
+
+import "testing"
+
+func TestRouting_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		routing Routing
+		wantErr bool
+	}{
+		{"default routing", Routing{}, false},
+		{"round robin", Routing{Balancing: RoundRobin, HealthCheckInterval: 1000}, false},
+		{"least connections", Routing{Balancing: LeastConnections}, false},
+		{"unknown balancing", Routing{Balancing: "Random"}, true},
+		{"negative health check interval", Routing{HealthCheckInterval: -1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.routing.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Routing.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
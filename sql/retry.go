@@ -0,0 +1,42 @@
+package sql
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// Retry configures how RunInTransaction retries a transaction that failed on a serialization failure or a deadlock.
+// The whole function is run again in a new transaction after a backoff.
+type Retry struct {
+	// MaxAttempts is the number of times the function is run at most, including the first attempt.
+	// The transaction is not retried if it is less than 2.
+	MaxAttempts int
+	// Backoff is the wait before the first retry, it is doubled for every next retry.
+	Backoff time.Duration
+	// MaxBackoff caps the wait before a retry, the wait is not capped if it is 0.
+	MaxBackoff time.Duration
+}
+
+// Delay returns the wait before the retry following the failed attempt, attempts are counted from 1.
+// The wait is randomized between half and all of the backoff, so transactions that failed on the same deadlock do not retry at the same time.
+func (r *Retry) Delay(attempt int) time.Duration {
+	if r == nil || r.Backoff <= 0 {
+		return 0
+	}
+	delay := r.Backoff
+	for i := 1; i < attempt && (r.MaxBackoff <= 0 || delay < r.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if r.MaxBackoff > 0 {
+		delay = min(delay, r.MaxBackoff)
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// Attempts returns the number of times the function is run at most, at least 1.
+func (r *Retry) Attempts() int {
+	if r == nil {
+		return 1
+	}
+	return max(r.MaxAttempts, 1)
+}
@@ -0,0 +1,49 @@
+package sql
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetry_Delay(t *testing.T) {
+	tests := []struct {
+		name    string
+		retry   *Retry
+		attempt int
+		want    time.Duration
+	}{
+		{"nil retry", nil, 1, 0},
+		{"no backoff", &Retry{MaxAttempts: 3}, 1, 0},
+		{"first retry", &Retry{MaxAttempts: 3, Backoff: 100 * time.Millisecond}, 1, 100 * time.Millisecond},
+		{"doubled for every retry", &Retry{MaxAttempts: 4, Backoff: 100 * time.Millisecond}, 3, 400 * time.Millisecond},
+		{"capped", &Retry{MaxAttempts: 10, Backoff: 100 * time.Millisecond, MaxBackoff: 250 * time.Millisecond}, 9, 250 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 20 {
+				if got := tt.retry.Delay(tt.attempt); got < tt.want/2 || got > tt.want {
+					t.Errorf("Delay() = %v, want between %v and %v", got, tt.want/2, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRetry_Attempts(t *testing.T) {
+	tests := []struct {
+		name  string
+		retry *Retry
+		want  int
+	}{
+		{"nil retry", nil, 1},
+		{"no attempts", &Retry{}, 1},
+		{"attempts", &Retry{MaxAttempts: 3}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.retry.Attempts(); got != tt.want {
+				t.Errorf("Attempts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,17 +4,21 @@ import (
 	"context"
 
 	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/impls/common"
 	"github.com/gofreego/database/sql/impls/mssql"
+	mssqlparser "github.com/gofreego/database/sql/impls/mssql/parser"
 	"github.com/gofreego/database/sql/impls/mysql"
+	mysqlparser "github.com/gofreego/database/sql/impls/mysql/parser"
 	"github.com/gofreego/database/sql/impls/postgresql"
+	postgresqlparser "github.com/gofreego/database/sql/impls/postgresql/parser"
 )
 
-type DBName string
+type DBName = sql.Dialect
 
 const (
-	PostgreSQL DBName = "postgresql"
-	MySQL      DBName = "mysql"
-	MSSQL      DBName = "mssql"
+	PostgreSQL DBName = sql.PostgreSQL
+	MySQL      DBName = sql.MySQL
+	MSSQL      DBName = sql.MSSQL
 )
 
 type Config struct {
@@ -36,3 +40,18 @@ func NewDatabase(ctx context.Context, config *Config) (sql.Database, error) {
 		return nil, sql.ErrInvalidConfig
 	}
 }
+
+// ToSQL returns a renderer for the given dialect.
+// It renders queries without a database connection, which is useful in tests and code review.
+func ToSQL(dialect sql.Dialect) (sql.Renderer, error) {
+	switch dialect {
+	case sql.PostgreSQL:
+		return common.NewRenderer(postgresqlparser.NewParser()), nil
+	case sql.MySQL:
+		return common.NewRenderer(mysqlparser.NewParser()), nil
+	case sql.MSSQL:
+		return common.NewRenderer(mssqlparser.NewParser()), nil
+	default:
+		return nil, sql.ErrInvalidConfig
+	}
+}
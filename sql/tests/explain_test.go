@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/tests/records"
+)
+
+// TestExplain tests the query plan returned for the get query
+func TestExplain(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, cleanup := setupTestDatabase(t, tt.args.config)
+			defer cleanup()
+
+			ctx := tt.args.ctx
+
+			// name has no index, the table has to be scanned
+			plan, err := db.Explain(ctx, &sql.Filter{
+				Condition: sql.NewCondition("name", sql.EQ, sql.NewIndexedValue(0)),
+			}, []any{"John Doe"}, &records.Users{})
+			if err != nil {
+				t.Fatalf("failed to explain query: %v", err)
+			}
+			if plan.Raw == "" {
+				t.Errorf("expected raw plan, got empty plan")
+			}
+			if !plan.SequentialScan {
+				t.Errorf("expected sequential scan for unindexed column, plan: %s", plan.Raw)
+			}
+
+			// the query is only planned, the values slice must still be complete
+			if _, err := db.Explain(ctx, &sql.Filter{
+				Condition: sql.NewCondition("name", sql.EQ, sql.NewIndexedValue(1)),
+			}, []any{"John Doe"}, &records.Users{}); err == nil {
+				t.Errorf("expected error for missing value")
+			}
+		})
+	}
+}
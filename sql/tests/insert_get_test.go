@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -556,3 +557,60 @@ func TestMysqlDatabase_GetByFilter_Advanced(t *testing.T) {
 		})
 	}
 }
+
+func TestConcurrentInsert(t *testing.T) {
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			MigrationUP(tt.args.ctx, tt.args.config, t)
+			defer MigrationDown(tt.args.ctx, tt.args.config, t)
+
+			conn, err := sqlfactory.NewDatabase(tt.args.ctx, tt.args.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewConnection() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			defer conn.Close(tt.args.ctx)
+
+			// every goroutine inserts its own user, the id set on it must be the id of its own row
+			users := make([]*records.User, 50)
+			var wg sync.WaitGroup
+			for i := range users {
+				users[i] = &records.User{
+					Name:         fmt.Sprintf("User %d", i),
+					Email:        fmt.Sprintf("user%d@example.com", i),
+					PasswordHash: "password123",
+					IsActive:     1,
+					CreatedAt:    time.Now().UnixMilli(),
+					UpdatedAt:    time.Now().UnixMilli(),
+				}
+				wg.Add(1)
+				go func(user *records.User) {
+					defer wg.Done()
+					if err := conn.Insert(tt.args.ctx, user); err != nil {
+						t.Errorf("Insert() failed: %v", err)
+					}
+				}(users[i])
+			}
+			wg.Wait()
+
+			ids := map[int64]bool{}
+			for i, user := range users {
+				if ids[user.Id] {
+					t.Errorf("Insert() failed: id %d is set on more than one user", user.Id)
+					return
+				}
+				ids[user.Id] = true
+				got := &records.User{Id: user.Id}
+				if err := conn.GetByID(tt.args.ctx, got); err != nil {
+					t.Errorf("GetByID() failed: %v", err)
+					return
+				}
+				if got.Email != fmt.Sprintf("user%d@example.com", i) {
+					t.Errorf("Insert() failed: id %d is the id of %s, not of user%d@example.com", user.Id, got.Email, i)
+					return
+				}
+			}
+		})
+	}
+}
@@ -407,3 +407,49 @@ func TestInsertManyWithOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestInsertManyOnConflictDoNothing(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			MigrationUP(tt.args.ctx, tt.args.config, t)
+			defer MigrationDown(tt.args.ctx, tt.args.config, t)
+
+			conn, err := sqlfactory.NewDatabase(tt.args.ctx, tt.args.config)
+			if err != nil {
+				t.Errorf("NewDatabase() error = %v", err)
+				return
+			}
+			defer conn.Close(tt.args.ctx)
+			options := sql.Options{OnConflictDoNothing: true, Conflict: &sql.Conflict{Columns: []string{"email"}}}
+
+			existing := &records.User{Name: "Alice Johnson", Email: "alice@example.com", PasswordHash: "hash123"}
+			if err := conn.Insert(tt.args.ctx, existing, options); err != nil {
+				t.Errorf("Insert() error = %v", err)
+				return
+			}
+
+			// inserting the same user again is skipped without an error
+			duplicate := &records.User{Name: "Alice Johnson", Email: "alice@example.com", PasswordHash: "hash123"}
+			if err := conn.Insert(tt.args.ctx, duplicate, options); err != nil {
+				t.Errorf("Insert() error = %v, want the duplicate skipped", err)
+				return
+			}
+			if duplicate.Id != 0 {
+				t.Errorf("Insert() id = %d, want the id of the skipped record unset", duplicate.Id)
+			}
+
+			users := []sql.Record{
+				&records.User{Name: "Alice Johnson", Email: "alice@example.com", PasswordHash: "hash123"},
+				&records.User{Name: "Bob Smith", Email: "bob@example.com", PasswordHash: "hash456"},
+			}
+			inserted, err := conn.InsertMany(tt.args.ctx, users, options)
+			if err != nil {
+				t.Errorf("InsertMany() error = %v", err)
+				return
+			}
+			if inserted != 1 {
+				t.Errorf("InsertMany() = %d, want 1 inserted", inserted)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/impls/mssql"
+	"github.com/gofreego/database/sql/impls/mysql"
+	"github.com/gofreego/database/sql/impls/postgresql"
+	"github.com/gofreego/database/sql/sqlfactory"
+	"github.com/gofreego/database/sql/tests/records"
+)
+
+// withReplicas returns a copy of the config with two read replicas: the database itself, and one that cannot be reached
+func withReplicas(config *sqlfactory.Config, routing sql.Routing) *sqlfactory.Config {
+	replicated := *config
+	switch config.Name {
+	case sqlfactory.MySQL:
+		c := *config.MySQL
+		c.Replicas, c.Routing = []mysql.Replica{{}, {Port: 1}}, routing
+		replicated.MySQL = &c
+	case sqlfactory.PostgreSQL:
+		c := *config.PostgreSQL
+		c.Replicas, c.Routing = []postgresql.Replica{{}, {Port: 1}}, routing
+		replicated.PostgreSQL = &c
+	case sqlfactory.MSSQL:
+		c := *config.MSSQL
+		c.Replicas, c.Routing = []mssql.Replica{{}, {Port: 1}}, routing
+		replicated.MSSQL = &c
+	}
+	return &replicated
+}
+
+func TestReplicas(t *testing.T) {
+	for _, tt := range tests {
+		for _, balancing := range []sql.Balancing{sql.RoundRobin, sql.LeastConnections} {
+			t.Run(tt.name+"/"+string(balancing), func(t *testing.T) {
+				ctx := context.Background()
+				MigrationUP(ctx, tt.args.config, t)
+				defer MigrationDown(ctx, tt.args.config, t)
+
+				db, err := sqlfactory.NewDatabase(ctx, withReplicas(tt.args.config, sql.Routing{Balancing: balancing}))
+				if err != nil {
+					t.Errorf("NewDatabase() error = %v", err)
+					return
+				}
+				defer func() {
+					if err := db.Close(ctx); err != nil {
+						t.Errorf("Close() error = %v", err)
+					}
+				}()
+
+				// the ping of the unreachable replica fails, it is taken out of rotation
+				if err := db.Ping(ctx); err != nil {
+					t.Errorf("Ping() error = %v", err)
+					return
+				}
+
+				user := &records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123", IsActive: 1}
+				if err := db.Insert(ctx, user); err != nil {
+					t.Errorf("Insert() error = %v", err)
+					return
+				}
+				for range 4 {
+					if err := db.GetByID(ctx, &records.User{Id: user.Id}); err != nil {
+						t.Errorf("GetByID() error = %v, want the read to run on the healthy replica", err)
+					}
+				}
+				if err := db.GetByID(ctx, &records.User{Id: user.Id}, sql.Options{UsePrimaryDB: true, PreparedName: "get_user_primary"}); err != nil {
+					t.Errorf("GetByID() on the primary error = %v", err)
+				}
+
+				users := &records.Users{}
+				filter := &sql.Filter{Condition: &sql.Condition{Field: "email", Operator: sql.EQ, Value: sql.NewIndexedValue(0)}}
+				if err := db.Get(ctx, filter, []any{user.Email}, users, sql.Options{PreparedName: "get_users_replica"}); err != nil {
+					t.Errorf("Get() error = %v", err)
+				}
+			})
+		}
+	}
+}
+
+func TestReplicas_InvalidRouting(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := sqlfactory.NewDatabase(context.Background(), withReplicas(tt.args.config, sql.Routing{Balancing: "Random"})); err != sql.ErrInvalidConfig {
+				t.Errorf("NewDatabase() error = %v, want %v", err, sql.ErrInvalidConfig)
+			}
+		})
+	}
+}
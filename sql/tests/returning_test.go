@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/sqlfactory"
+	"github.com/gofreego/database/sql/tests/records"
+)
+
+func TestUpdateAndDeleteReturning(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			MigrationUP(ctx, tt.args.config, t)
+			defer MigrationDown(ctx, tt.args.config, t)
+
+			db, err := sqlfactory.NewDatabase(ctx, tt.args.config)
+			if err != nil {
+				t.Errorf("NewDatabase() error = %v", err)
+				return
+			}
+			defer func() {
+				if err := db.Close(ctx); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			}()
+			users := []sql.Record{
+				&records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123", IsActive: 1},
+				&records.User{Name: "Jane Doe", Email: "jane.doe@example.com", PasswordHash: "password123", IsActive: 1},
+			}
+			if _, err := db.InsertMany(ctx, users); err != nil {
+				t.Errorf("InsertMany() error = %v", err)
+				return
+			}
+			condition := sql.NewCondition("email", sql.EQ, sql.NewIndexedValue(0))
+
+			updated := &records.Users{}
+			rowsAffected, err := db.UpdateReturning(ctx, sql.NewUpdates().Add("score", sql.NewValue(10)), condition, []any{"john.doe@example.com"}, updated)
+			if err != nil {
+				t.Errorf("UpdateReturning() error = %v", err)
+				return
+			}
+			if rowsAffected != 1 || len(updated.Users) != 1 || updated.Users[0].Score != 10 {
+				t.Errorf("UpdateReturning() = %d, %+v, want the user with the updated score", rowsAffected, updated.Users)
+				return
+			}
+
+			deleted := &records.Users{}
+			rowsAffected, err = db.DeleteReturning(ctx, condition, []any{"jane.doe@example.com"}, deleted)
+			if err != nil {
+				t.Errorf("DeleteReturning() error = %v", err)
+				return
+			}
+			if rowsAffected != 1 || len(deleted.Users) != 1 || deleted.Users[0].Name != "Jane Doe" {
+				t.Errorf("DeleteReturning() = %d, %+v, want the deleted user", rowsAffected, deleted.Users)
+			}
+		})
+	}
+}
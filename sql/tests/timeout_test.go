@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/sqlfactory"
+	"github.com/gofreego/database/sql/tests/records"
+)
+
+func TestTimeout(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			MigrationUP(ctx, tt.args.config, t)
+			defer MigrationDown(ctx, tt.args.config, t)
+
+			db, err := sqlfactory.NewDatabase(ctx, tt.args.config)
+			if err != nil {
+				t.Errorf("NewDatabase() error = %v", err)
+				return
+			}
+			defer func() {
+				if err := db.Close(ctx); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			}()
+
+			user := &records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123", IsActive: 1}
+			if err := db.Insert(ctx, user, sql.Options{Timeout: 5000}); err != nil {
+				t.Errorf("Insert() error = %v", err)
+				return
+			}
+			if err := db.GetByID(ctx, &records.User{Id: user.Id}, sql.Options{Timeout: 5000}); err != nil {
+				t.Errorf("GetByID() error = %v", err)
+			}
+
+			// the deadline is of the whole transaction, the operations after it fail and the transaction is rolled back
+			late := &records.User{Name: "Jane Doe", Email: "jane.doe@example.com", PasswordHash: "password123", IsActive: 1}
+			err = db.RunInTransaction(ctx, func(ctx context.Context, tx sql.Tx) error {
+				time.Sleep(300 * time.Millisecond)
+				return tx.Insert(ctx, late)
+			}, sql.Options{Timeout: 200})
+			var sqlErr *sql.Error
+			if !errors.As(err, &sqlErr) || !sqlErr.IsTimeout() {
+				t.Errorf("RunInTransaction() error = %v, want a timeout error", err)
+			}
+
+			tx, err := db.BeginTransaction(ctx, sql.Options{Timeout: 200})
+			if err != nil {
+				t.Errorf("BeginTransaction() error = %v", err)
+				return
+			}
+			defer tx.Close(ctx)
+			time.Sleep(300 * time.Millisecond)
+			if err := tx.Insert(ctx, late); err == nil {
+				t.Errorf("Insert() after the deadline of the transaction succeeded, want an error")
+			}
+			if err := db.GetByID(ctx, &records.User{Id: late.Id}); err != sql.ErrNoRecordFound {
+				t.Errorf("GetByID() error = %v, want %v", err, sql.ErrNoRecordFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/sqlfactory"
+	"github.com/gofreego/database/sql/tests/records"
+)
+
+func TestRunInTransaction(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			MigrationUP(ctx, tt.args.config, t)
+			defer MigrationDown(ctx, tt.args.config, t)
+
+			db, err := sqlfactory.NewDatabase(ctx, tt.args.config)
+			if err != nil {
+				t.Errorf("NewDatabase() error = %v", err)
+				return
+			}
+			defer func() {
+				if err := db.Close(ctx); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			}()
+
+			committed := &records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123", IsActive: 1}
+			err = db.RunInTransaction(ctx, func(ctx context.Context, tx sql.Tx) error {
+				return db.Insert(ctx, committed, sql.Options{Transaction: tx})
+			})
+			if err != nil {
+				t.Errorf("RunInTransaction() error = %v", err)
+				return
+			}
+			if err := db.GetByID(ctx, &records.User{Id: committed.Id}); err != nil {
+				t.Errorf("GetByID() error = %v, want the committed user", err)
+			}
+
+			errRollback := errors.New("rollback")
+			rolledBack := &records.User{Name: "Jane Doe", Email: "jane.doe@example.com", PasswordHash: "password123", IsActive: 1}
+			err = db.RunInTransaction(ctx, func(ctx context.Context, tx sql.Tx) error {
+				if err := db.Insert(ctx, rolledBack, sql.Options{Transaction: tx}); err != nil {
+					return err
+				}
+				return errRollback
+			})
+			if !errors.Is(err, errRollback) {
+				t.Errorf("RunInTransaction() error = %v, want %v", err, errRollback)
+			}
+			if err := db.GetByID(ctx, &records.User{Id: rolledBack.Id}); err != sql.ErrNoRecordFound {
+				t.Errorf("GetByID() error = %v, want %v", err, sql.ErrNoRecordFound)
+			}
+
+			panicked := &records.User{Name: "Jim Doe", Email: "jim.doe@example.com", PasswordHash: "password123", IsActive: 1}
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("RunInTransaction() did not propagate the panic")
+					}
+				}()
+				_ = db.RunInTransaction(ctx, func(ctx context.Context, tx sql.Tx) error {
+					if err := db.Insert(ctx, panicked, sql.Options{Transaction: tx}); err != nil {
+						return err
+					}
+					panic("fn panicked")
+				})
+			}()
+			if err := db.GetByID(ctx, &records.User{Id: panicked.Id}); err != sql.ErrNoRecordFound {
+				t.Errorf("GetByID() error = %v, want %v", err, sql.ErrNoRecordFound)
+			}
+		})
+	}
+}
+
+func TestTransactionOptions(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			MigrationUP(ctx, tt.args.config, t)
+			defer MigrationDown(ctx, tt.args.config, t)
+
+			db, err := sqlfactory.NewDatabase(ctx, tt.args.config)
+			if err != nil {
+				t.Errorf("NewDatabase() error = %v", err)
+				return
+			}
+			defer func() {
+				if err := db.Close(ctx); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			}()
+
+			user := &records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123", IsActive: 1}
+			err = db.RunInTransaction(ctx, func(ctx context.Context, tx sql.Tx) error {
+				return db.Insert(ctx, user, sql.Options{Transaction: tx})
+			}, sql.Options{TxOptions: &sql.TxOptions{Isolation: sql.Serializable}})
+			if err != nil {
+				t.Errorf("RunInTransaction() error = %v", err)
+				return
+			}
+
+			readOnly := &sql.TxOptions{Isolation: sql.RepeatableRead, ReadOnly: true}
+			err = db.RunInTransaction(ctx, func(ctx context.Context, tx sql.Tx) error {
+				return db.Insert(ctx, &records.User{Name: "Jane Doe", Email: "jane.doe@example.com", PasswordHash: "password123"}, sql.Options{Transaction: tx})
+			}, sql.Options{TxOptions: readOnly})
+			if err == nil {
+				t.Errorf("RunInTransaction() error = nil, want an error writing in a read-only transaction")
+			}
+			if tt.args.config.Name == sqlfactory.MSSQL {
+				var sqlErr *sql.Error
+				if !errors.As(err, &sqlErr) || !sqlErr.IsQueryError() {
+					t.Errorf("RunInTransaction() error = %v, want an invalid query error", err)
+				}
+			}
+		})
+	}
+}
+
+func TestNestedRunInTransaction(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			MigrationUP(ctx, tt.args.config, t)
+			defer MigrationDown(ctx, tt.args.config, t)
+
+			db, err := sqlfactory.NewDatabase(ctx, tt.args.config)
+			if err != nil {
+				t.Errorf("NewDatabase() error = %v", err)
+				return
+			}
+			defer func() {
+				if err := db.Close(ctx); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			}()
+
+			kept := &records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123", IsActive: 1}
+			rolledBack := &records.User{Name: "Jane Doe", Email: "jane.doe@example.com", PasswordHash: "password123", IsActive: 1}
+			errRollback := errors.New("rollback")
+			err = db.RunInTransaction(ctx, func(ctx context.Context, tx sql.Tx) error {
+				if err := db.Insert(ctx, kept, sql.Options{Transaction: tx}); err != nil {
+					return err
+				}
+				// the nested call runs in a savepoint, its failure only rolls back its own insert
+				err := db.RunInTransaction(ctx, func(ctx context.Context, tx sql.Tx) error {
+					if err := db.Insert(ctx, rolledBack, sql.Options{Transaction: tx}); err != nil {
+						return err
+					}
+					return errRollback
+				}, sql.Options{Transaction: tx})
+				if !errors.Is(err, errRollback) {
+					t.Errorf("nested RunInTransaction() error = %v, want %v", err, errRollback)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Errorf("RunInTransaction() error = %v", err)
+				return
+			}
+			if err := db.GetByID(ctx, &records.User{Id: kept.Id}); err != nil {
+				t.Errorf("GetByID() error = %v, want the user of the outer transaction", err)
+			}
+			if err := db.GetByID(ctx, &records.User{Id: rolledBack.Id}); err != sql.ErrNoRecordFound {
+				t.Errorf("GetByID() error = %v, want %v", err, sql.ErrNoRecordFound)
+			}
+		})
+	}
+}
+
+func TestTransactionHandle(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			MigrationUP(ctx, tt.args.config, t)
+			defer MigrationDown(ctx, tt.args.config, t)
+
+			db, err := sqlfactory.NewDatabase(ctx, tt.args.config)
+			if err != nil {
+				t.Errorf("NewDatabase() error = %v", err)
+				return
+			}
+			defer func() {
+				if err := db.Close(ctx); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			}()
+
+			// the statement is prepared on the database, then bound to the transactions
+			prepared := sql.Options{PreparedName: "tx_insert_user"}
+			if err := db.Insert(ctx, &records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123", IsActive: 1}, prepared); err != nil {
+				t.Errorf("Insert() error = %v", err)
+				return
+			}
+
+			tx, err := db.BeginTransaction(ctx)
+			if err != nil {
+				t.Errorf("BeginTransaction() error = %v", err)
+				return
+			}
+			rolledBack := &records.User{Name: "Jane Doe", Email: "jane.doe@example.com", PasswordHash: "password123", IsActive: 1}
+			if err := tx.Insert(ctx, rolledBack, prepared); err != nil {
+				t.Errorf("Insert() in the transaction error = %v", err)
+				return
+			}
+			if err := tx.GetByID(ctx, &records.User{Id: rolledBack.Id}); err != nil {
+				t.Errorf("GetByID() in the transaction error = %v, want the inserted user", err)
+			}
+			// closing the handle rolls back the transaction, not the database
+			if err := tx.Close(ctx); err != nil {
+				t.Errorf("Close() of the transaction error = %v", err)
+			}
+			if err := db.GetByID(ctx, &records.User{Id: rolledBack.Id}); err != sql.ErrNoRecordFound {
+				t.Errorf("GetByID() error = %v, want %v", err, sql.ErrNoRecordFound)
+			}
+
+			tx, err = db.BeginTransaction(ctx)
+			if err != nil {
+				t.Errorf("BeginTransaction() error = %v", err)
+				return
+			}
+			committed := &records.User{Name: "Jim Doe", Email: "jim.doe@example.com", PasswordHash: "password123", IsActive: 1}
+			if err := tx.Insert(ctx, committed, prepared); err != nil {
+				t.Errorf("Insert() in the transaction error = %v", err)
+				return
+			}
+			if err := tx.Commit(); err != nil {
+				t.Errorf("Commit() error = %v", err)
+				return
+			}
+			if err := tx.Close(ctx); err != nil {
+				t.Errorf("Close() of the committed transaction error = %v", err)
+			}
+			if err := db.GetByID(ctx, &records.User{Id: committed.Id}); err != nil {
+				t.Errorf("GetByID() error = %v, want the committed user", err)
+			}
+		})
+	}
+}
+
+func TestContextTransaction(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			MigrationUP(ctx, tt.args.config, t)
+			defer MigrationDown(ctx, tt.args.config, t)
+
+			db, err := sqlfactory.NewDatabase(ctx, tt.args.config)
+			if err != nil {
+				t.Errorf("NewDatabase() error = %v", err)
+				return
+			}
+			defer func() {
+				if err := db.Close(ctx); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			}()
+
+			user := &records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123", IsActive: 1}
+			errRollback := errors.New("rollback")
+			err = db.RunInTransaction(ctx, func(ctx context.Context, tx sql.Tx) error {
+				// no transaction is passed, the insert runs in the transaction of the context
+				if err := db.Insert(ctx, user); err != nil {
+					return err
+				}
+				return errRollback
+			})
+			if !errors.Is(err, errRollback) {
+				t.Errorf("RunInTransaction() error = %v, want %v", err, errRollback)
+			}
+			if err := db.GetByID(ctx, &records.User{Id: user.Id}); err != sql.ErrNoRecordFound {
+				t.Errorf("GetByID() error = %v, want %v", err, sql.ErrNoRecordFound)
+			}
+
+			tx, err := db.BeginTransaction(ctx)
+			if err != nil {
+				t.Errorf("BeginTransaction() error = %v", err)
+				return
+			}
+			defer tx.Close(ctx)
+			other, err := db.BeginTransaction(ctx)
+			if err != nil {
+				t.Errorf("BeginTransaction() error = %v", err)
+				return
+			}
+			defer other.Close(ctx)
+			err = db.Insert(sql.WithTransaction(ctx, tx), user, sql.Options{Transaction: other})
+			var sqlErr *sql.Error
+			if !errors.As(err, &sqlErr) || !sqlErr.IsQueryError() {
+				t.Errorf("Insert() error = %v, want an invalid query error for conflicting transactions", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofreego/database/sql"
+	"github.com/gofreego/database/sql/sqlfactory"
+	"github.com/gofreego/database/sql/tests/records"
+)
+
+func TestUpsert(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			MigrationUP(ctx, tt.args.config, t)
+			defer MigrationDown(ctx, tt.args.config, t)
+
+			db, err := sqlfactory.NewDatabase(ctx, tt.args.config)
+			if err != nil {
+				t.Errorf("NewDatabase() error = %v", err)
+				return
+			}
+			defer func() {
+				if err := db.Close(ctx); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			}()
+			newer := sql.NewCondition("updated_at", sql.LT, sql.NewColumnValue(sql.ExcludedColumn("updated_at")))
+			options := sql.Options{Conflict: &sql.Conflict{Columns: []string{"email"}, Where: newer}}
+
+			// the first upsert inserts the user
+			user := &records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123", UpdatedAt: 1}
+			inserted, err := db.Upsert(ctx, user, options)
+			if err != nil {
+				t.Errorf("Upsert() error = %v", err)
+				return
+			}
+			if !inserted || user.Id == 0 {
+				t.Errorf("Upsert() = %v, id %d, want an inserted user", inserted, user.Id)
+				return
+			}
+			id := user.Id
+
+			// a newer record updates the user
+			user = &records.User{Name: "Jane Doe", Email: "john.doe@example.com", PasswordHash: "password123", UpdatedAt: 2}
+			inserted, err = db.Upsert(ctx, user, options)
+			if err != nil {
+				t.Errorf("Upsert() error = %v", err)
+				return
+			}
+			if inserted || user.Id != id {
+				t.Errorf("Upsert() = %v, id %d, want the user %d updated", inserted, user.Id, id)
+				return
+			}
+
+			// an older record leaves the user unchanged
+			user = &records.User{Name: "Old Name", Email: "john.doe@example.com", PasswordHash: "password123", UpdatedAt: 1}
+			inserted, err = db.Upsert(ctx, user, options)
+			if err != nil {
+				t.Errorf("Upsert() error = %v", err)
+				return
+			}
+			if inserted {
+				t.Errorf("Upsert() = %v, want the user unchanged", inserted)
+				return
+			}
+			got := &records.User{Id: id}
+			if err := db.GetByID(ctx, got); err != nil {
+				t.Errorf("GetByID() error = %v", err)
+				return
+			}
+			if got.Name != "Jane Doe" {
+				t.Errorf("GetByID() name = %s, want Jane Doe", got.Name)
+			}
+		})
+	}
+}
+
+func TestUpsertMany(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			MigrationUP(ctx, tt.args.config, t)
+			defer MigrationDown(ctx, tt.args.config, t)
+
+			db, err := sqlfactory.NewDatabase(ctx, tt.args.config)
+			if err != nil {
+				t.Errorf("NewDatabase() error = %v", err)
+				return
+			}
+			defer func() {
+				if err := db.Close(ctx); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			}()
+			options := sql.Options{Conflict: &sql.Conflict{Columns: []string{"email"}}}
+
+			existing := &records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123"}
+			if err := db.Insert(ctx, existing); err != nil {
+				t.Errorf("Insert() error = %v", err)
+				return
+			}
+
+			// the first user conflicts with the existing user, the second one is new
+			users := []sql.Record{
+				&records.User{Name: "Johnny Doe", Email: "john.doe@example.com", PasswordHash: "password123"},
+				&records.User{Name: "Jane Doe", Email: "jane.doe@example.com", PasswordHash: "password123"},
+			}
+			inserted, updated, err := db.UpsertMany(ctx, users, options)
+			if err != nil {
+				t.Errorf("UpsertMany() error = %v", err)
+				return
+			}
+			if inserted != 1 || updated != 1 {
+				t.Errorf("UpsertMany() = %d inserted, %d updated, want 1 and 1", inserted, updated)
+				return
+			}
+			got := &records.User{Id: existing.Id}
+			if err := db.GetByID(ctx, got); err != nil {
+				t.Errorf("GetByID() error = %v", err)
+				return
+			}
+			if got.Name != "Johnny Doe" {
+				t.Errorf("GetByID() name = %s, want Johnny Doe", got.Name)
+			}
+		})
+	}
+}
+
+func TestUpsertOnID(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			MigrationUP(ctx, tt.args.config, t)
+			defer MigrationDown(ctx, tt.args.config, t)
+
+			db, err := sqlfactory.NewDatabase(ctx, tt.args.config)
+			if err != nil {
+				t.Errorf("NewDatabase() error = %v", err)
+				return
+			}
+			defer func() {
+				if err := db.Close(ctx); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			}()
+
+			existing := &records.User{Name: "John Doe", Email: "john.doe@example.com", PasswordHash: "password123"}
+			if err := db.Insert(ctx, existing); err != nil {
+				t.Errorf("Insert() error = %v", err)
+				return
+			}
+
+			// without conflict options, the record conflicts with the row of its id
+			user := &records.User{Id: existing.Id, Name: "Johnny Doe", Email: "john.doe@example.com", PasswordHash: "password123"}
+			inserted, err := db.Upsert(ctx, user)
+			if err != nil {
+				t.Errorf("Upsert() error = %v", err)
+				return
+			}
+			if inserted || user.Id != existing.Id {
+				t.Errorf("Upsert() = %v, id %d, want the user %d updated", inserted, user.Id, existing.Id)
+				return
+			}
+			got := &records.User{Id: existing.Id}
+			if err := db.GetByID(ctx, got); err != nil {
+				t.Errorf("GetByID() error = %v", err)
+				return
+			}
+			if got.Name != "Johnny Doe" {
+				t.Errorf("GetByID() name = %s, want Johnny Doe", got.Name)
+			}
+			// without an id, the record is inserted with a generated id
+			newUser := &records.User{Name: "Jane Doe", Email: "jane.doe@example.com", PasswordHash: "password123"}
+			inserted, err = db.Upsert(ctx, newUser)
+			if err != nil {
+				t.Errorf("Upsert() error = %v", err)
+				return
+			}
+			if !inserted || newUser.Id == 0 || newUser.Id == existing.Id {
+				t.Errorf("Upsert() = %v, id %d, want a new user inserted", inserted, newUser.Id)
+				return
+			}
+			// the ids are generated by the table, so the inserts that follow do not conflict with the upserted rows
+			next := &records.User{Name: "Jim Doe", Email: "jim.doe@example.com", PasswordHash: "password123"}
+			if err := db.Insert(ctx, next); err != nil {
+				t.Errorf("Insert() error = %v", err)
+				return
+			}
+			users := &records.Users{}
+			if err := db.Get(ctx, &sql.Filter{}, nil, users); err != nil {
+				t.Errorf("Get() error = %v", err)
+				return
+			}
+			if len(users.Users) != 3 {
+				t.Errorf("Get() = %d users, want 3, the upsert must not insert a duplicate", len(users.Users))
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package sql
+
+// Timeouts sets the default timeouts of the operations by type in milliseconds, set in the config of the dialect.
+// Options.Timeout overrides the timeout of an operation, an operation has no deadline if both are 0.
+type Timeouts struct {
+	// Read is the timeout of Get, GetByID, GetByIDs and Explain.
+	Read int64 `yaml:"Read" json:"Read"`
+	// Write is the timeout of Insert, Upsert, Update, SoftDelete and Delete, with their Many, ByID, ByIDs and Returning variants, and of RunSP.
+	Write int64 `yaml:"Write" json:"Write"`
+	// BulkLoad is the timeout of BulkLoad.
+	BulkLoad int64 `yaml:"BulkLoad" json:"BulkLoad"`
+	// Transaction is the timeout of the transactions of BeginTransaction and RunInTransaction,
+	// the transaction is rolled back if it is not committed before its deadline.
+	Transaction int64 `yaml:"Transaction" json:"Transaction"`
+}
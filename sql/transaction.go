@@ -0,0 +1,88 @@
+package sql
+
+import (
+	"context"
+	"strings"
+)
+
+// IsolationLevel represents the isolation level of a transaction, see TxOptions.
+type IsolationLevel int
+
+const (
+	DefaultIsolation IsolationLevel = iota // The default isolation level of the database
+	ReadCommitted                          // Every query sees the rows committed before it started
+	RepeatableRead                         // Every query sees the rows committed before the first query of the transaction
+	Serializable                           // The transactions behave as if they ran one after another
+	Snapshot                               // MSSQL only, every query sees the snapshot of the rows taken when the transaction started
+)
+
+// String returns the string representation of the isolation level.
+func (l IsolationLevel) String() string {
+	switch l {
+	case DefaultIsolation:
+		return "DEFAULT"
+	case ReadCommitted:
+		return "READ COMMITTED"
+	case RepeatableRead:
+		return "REPEATABLE READ"
+	case Serializable:
+		return "SERIALIZABLE"
+	case Snapshot:
+		return "SNAPSHOT"
+	default:
+		return ""
+	}
+}
+
+// TxOptions configures the transactions begun by BeginTransaction and RunInTransaction.
+// The options are validated against what the database supports when the transaction begins.
+type TxOptions struct {
+	// Isolation is the isolation level of the transaction, the database's default if not set.
+	// Snapshot is only supported by MSSQL, where it must be enabled on the database with ALLOW_SNAPSHOT_ISOLATION.
+	Isolation IsolationLevel
+	// ReadOnly makes the transaction fail on writes, not supported by MSSQL.
+	ReadOnly bool
+	// Deferrable makes a serializable read-only transaction wait for a snapshot that cannot fail on serialization,
+	// PostgreSQL only.
+	Deferrable bool
+}
+
+// Validate checks if the isolation level is known.
+// Returns an error if the options are invalid.
+func (o *TxOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	if o.Isolation.String() == "" {
+		return NewInvalidQueryError("invalid transaction options: unknown isolation level %d", o.Isolation)
+	}
+	return nil
+}
+
+// ValidateSavepoint validates the name of a savepoint, an identifier that cannot be qualified.
+func ValidateSavepoint(name string) error {
+	if err := ValidateIdentifier(name); err != nil {
+		return err
+	}
+	if strings.Contains(name, ".") {
+		return NewInvalidQueryError("invalid savepoint: %q cannot be qualified", name)
+	}
+	return nil
+}
+
+// transactionKey is the context key of the transaction set by WithTransaction
+type transactionKey struct{}
+
+// WithTransaction returns a copy of the context carrying the transaction.
+// The operations called with the context run in the transaction when Options.Transaction is not set,
+// they fail if Options.Transaction is set to another transaction.
+// RunInTransaction passes the context carrying its transaction to its function.
+func WithTransaction(ctx context.Context, tx Transaction) context.Context {
+	return context.WithValue(ctx, transactionKey{}, tx)
+}
+
+// TransactionFromContext returns the transaction carried by the context, nil if none, see WithTransaction.
+func TransactionFromContext(ctx context.Context) Transaction {
+	tx, _ := ctx.Value(transactionKey{}).(Transaction)
+	return tx
+}
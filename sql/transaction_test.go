@@ -0,0 +1,62 @@
+package sql
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTxOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *TxOptions
+		wantErr bool
+	}{
+		{"nil options", nil, false},
+		{"default isolation", &TxOptions{ReadOnly: true}, false},
+		{"snapshot", &TxOptions{Isolation: Snapshot}, false},
+		{"unknown isolation", &TxOptions{Isolation: IsolationLevel(42)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.options.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSavepoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		savepoint string
+		wantErr   bool
+	}{
+		{"name", "sp_1", false},
+		{"qualified name", "tx.sp_1", true},
+		{"empty name", "", true},
+		{"expression", "sp; ROLLBACK", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateSavepoint(tt.savepoint); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSavepoint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithTransaction(t *testing.T) {
+	ctx := context.Background()
+	if tx := TransactionFromContext(ctx); tx != nil {
+		t.Errorf("TransactionFromContext() = %v, want nil", tx)
+	}
+	var tx Transaction = &transactionStub{}
+	if got := TransactionFromContext(WithTransaction(ctx, tx)); got != tx {
+		t.Errorf("TransactionFromContext() = %v, want %v", got, tx)
+	}
+}
+
+// transactionStub is a transaction carried by a context in the tests
+type transactionStub struct {
+	Transaction
+}